@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DefaultReadCacheTTL is how long a cached read is reused when not configured.
+const DefaultReadCacheTTL = 30 * time.Second
+
+// WithReadCache sets how long the results of cached reads are reused. A ttl of 0 disables the cache.
+func WithReadCache(ttl time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.readCacheTTL = ttl
+	}
+}
+
+// readCache holds the results of cluster-wide list requests shared by many resources, e.g. roles or access zones.
+// Any request that may change the cluster clears it, so a cached result never outlives a write of the client.
+type readCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// generation changes on every clear, so that a load started before a write is not cached after it.
+	generation uint64
+}
+
+// cacheEntry is a cached result, or a load in progress shared by concurrent callers.
+type cacheEntry struct {
+	value   interface{}
+	err     error
+	expires time.Time
+	loaded  chan struct{}
+}
+
+func newReadCache(ttl time.Duration) *readCache {
+	return &readCache{ttl: ttl, entries: map[string]*cacheEntry{}}
+}
+
+// get returns the cached result of key, or loads it. Concurrent callers of a key share a single load.
+// Failed loads are not cached.
+func (c *readCache) get(ctx context.Context, key string, load func(context.Context) (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && entry.expires.IsZero() {
+		c.mu.Unlock()
+		select {
+		case <-entry.loaded:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if entry.err != nil {
+			// the load of another caller failed, e.g. it was cancelled, so load again
+			return c.get(ctx, key, load)
+		}
+		return entry.value, nil
+	}
+	if ok && time.Now().Before(entry.expires) {
+		c.mu.Unlock()
+		tflog.Debug(ctx, "Using cached PowerScale API response", map[string]interface{}{"key": key})
+		return entry.value, nil
+	}
+	entry = &cacheEntry{loaded: make(chan struct{})}
+	c.entries[key] = entry
+	generation := c.generation
+	c.mu.Unlock()
+
+	entry.value, entry.err = load(ctx)
+
+	c.mu.Lock()
+	if entry.err == nil && generation == c.generation {
+		entry.expires = time.Now().Add(c.ttl)
+	} else if c.entries[key] == entry {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(entry.loaded)
+	return entry.value, entry.err
+}
+
+// clear drops all the cached results.
+func (c *readCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	for key, entry := range c.entries {
+		// loads in progress are kept for their waiters, and not cached when they complete
+		if !entry.expires.IsZero() {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// CacheTransport clears the read cache of the client on every request that may change the cluster.
+type CacheTransport struct {
+	http.RoundTripper
+	cache *readCache
+}
+
+// RoundTrip clears the cache before and after a write, so that reads concurrent with it are not cached.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead || strings.HasSuffix(req.URL.Path, SessionEndpoint) {
+		return t.RoundTripper.RoundTrip(req)
+	}
+	t.cache.clear()
+	defer t.cache.clear()
+	return t.RoundTripper.RoundTrip(req)
+}
+
+// Cached returns the result of load for key, reusing it for the TTL of the read cache of the client
+// until a request of the client changes the cluster. Keys should start with the path of the listed objects,
+// e.g. "auth/roles?zone=System". load is called directly when the cache is disabled.
+func Cached[T any](ctx context.Context, c *Client, key string, load func(context.Context) (T, error)) (T, error) {
+	if c.cache == nil {
+		return load(ctx)
+	}
+	value, err := c.cache.get(ctx, key, func(ctx context.Context) (interface{}, error) {
+		return load(ctx)
+	})
+	typed, ok := value.(T)
+	if err != nil || !ok {
+		var zero T
+		return zero, err
+	}
+	return typed, nil
+}
@@ -18,11 +18,11 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	powerscale "dell/powerscale-go-client"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -42,8 +42,6 @@ const (
 	SessionAuthType = 1
 )
 
-var mutex sync.Mutex
-
 // AuthContextKey define own type for context key to avoid collisions between packages using context.
 type AuthContextKey string
 
@@ -52,15 +50,16 @@ type Client struct {
 	PscaleOpenAPIClient *powerscale.APIClient
 	onefsVersion        *OnefsVersion
 	mu                  sync.Mutex
+	cache               *readCache
 }
 
 // GetOnefsVersion get OneFS version.
-func (c *Client) GetOnefsVersion() (*OnefsVersion, error) {
+// The version is cached once read, a failed or cancelled read is retried on the next call.
+func (c *Client) GetOnefsVersion(ctx context.Context) (*OnefsVersion, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	if c.onefsVersion == nil {
-		c.onefsVersion = &OnefsVersion{}
-		config, _, err := c.PscaleOpenAPIClient.ClusterApi.GetClusterv3ClusterConfig(context.Background()).Execute()
+		config, _, err := c.PscaleOpenAPIClient.ClusterApi.GetClusterv3ClusterConfig(ctx).Execute()
 		if err != nil {
 			return nil, err
 		}
@@ -79,7 +78,7 @@ func (c *Client) GetOnefsVersion() (*OnefsVersion, error) {
 			if err != nil {
 				return nil, err
 			}
-			c.onefsVersion = &OnefsVersion{major, minor, patch}
+			c.onefsVersion = &OnefsVersion{Major: major, Minor: minor, Patch: patch, Release: config.OnefsVersion.Release}
 		} else {
 			return nil, errors.New("Unable to parse OneFS version " + config.OnefsVersion.Release)
 		}
@@ -93,12 +92,14 @@ func (c *Client) GetOnefsVersion() (*OnefsVersion, error) {
 func (c *Client) SetOnefsVersion(major, minor, patch int) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.onefsVersion = &OnefsVersion{major, minor, patch}
+	c.onefsVersion = &OnefsVersion{Major: major, Minor: minor, Patch: patch}
 }
 
 // OnefsVersion present OneFS release version.
 type OnefsVersion struct {
 	Major, Minor, Patch int
+	// Release is the full release reported by the cluster, e.g. 9.4.0.17.
+	Release string
 }
 
 func (v OnefsVersion) IsEqualTo(version string) bool {
@@ -164,18 +165,72 @@ func (v OnefsVersion) String() string {
 	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
 }
 
+// ReleaseString returns the full release reported by the cluster, or the formatted version when unknown.
+func (v OnefsVersion) ReleaseString() string {
+	if len(v.Release) > 0 {
+		return v.Release
+	}
+	return v.String()
+}
+
+// ClientOption customizes the client built by NewClient and NewOpenAPIClient.
+type ClientOption func(*clientOptions)
+
+// clientOptions holds the optional settings of the client.
+type clientOptions struct {
+	maxRetries        int
+	retryMaxWait      time.Duration
+	tls               TLSOptions
+	fallbackEndpoints []string
+	trace             bool
+	maxConcurrent     int
+	requestsPerSecond float64
+	readCacheTTL      time.Duration
+	cache             *readCache
+}
+
+func newClientOptions(opts []ClientOption) *clientOptions {
+	options := &clientOptions{
+		maxRetries:   DefaultMaxRetries,
+		retryMaxWait: DefaultRetryMaxWait,
+		readCacheTTL: DefaultReadCacheTTL,
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithRetry sets how many times a request failing with a transient error is retried,
+// and the maximum wait between two attempts. A maxRetries of 0 disables retries.
+func WithRetry(maxRetries int, maxWait time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.maxRetries = maxRetries
+		if maxWait > 0 {
+			o.retryMaxWait = maxWait
+		}
+	}
+}
+
 // NewClient returns the client.
-func NewClient(endpoint string,
+// The context bounds the login and the verification of the endpoints, not the lifetime of the client.
+func NewClient(ctx context.Context, endpoint string,
 	insecure bool,
-	user string, pass string, authType, timeout int64) (*Client, error) {
+	user string, pass string, authType, timeout int64, opts ...ClientOption) (*Client, error) {
+	var cache *readCache
+	if ttl := newClientOptions(opts).readCacheTTL; ttl > 0 {
+		cache = newReadCache(ttl)
+		opts = append(opts, func(o *clientOptions) { o.cache = cache })
+	}
 	openAPIClient, err := NewOpenAPIClient(
-		context.Background(),
+		ctx,
 		endpoint,
 		insecure,
 		user,
 		pass,
 		authType,
 		timeout,
+		opts...,
 	)
 	if err != nil {
 		return nil, err
@@ -183,13 +238,76 @@ func NewClient(endpoint string,
 
 	client := Client{
 		PscaleOpenAPIClient: openAPIClient,
+		cache:               cache,
+	}
+	if failover := findFailoverTransport(openAPIClient.GetConfig().HTTPClient.Transport); failover != nil {
+		if err := client.verifyEndpoints(ctx, failover); err != nil {
+			return nil, err
+		}
 	}
 
 	return &client, nil
 }
 
+// verifyEndpoints checks that all the endpoints belong to the same cluster.
+// Endpoints that cannot be reached are excluded from failover, as their cluster cannot be verified.
+func (c *Client) verifyEndpoints(ctx context.Context, failover *FailoverTransport) error {
+	var guid, reference string
+	var lastErr error
+	for index, endpoint := range failover.Endpoints() {
+		config, _, err := c.PscaleOpenAPIClient.ClusterApi.GetClusterv3ClusterConfig(WithEndpoint(ctx, index)).Execute()
+		if err != nil {
+			tflog.Warn(ctx, "PowerScale endpoint cannot be verified and will not be used for failover", map[string]interface{}{
+				"endpoint": endpoint,
+				"error":    err.Error(),
+			})
+			failover.SetUsable(index, false)
+			lastErr = err
+			continue
+		}
+		if len(guid) == 0 {
+			guid, reference = config.GetGuid(), endpoint
+			continue
+		}
+		if config.GetGuid() != guid {
+			return fmt.Errorf("endpoint %s belongs to cluster %s while endpoint %s belongs to cluster %s, all endpoints must belong to the same cluster",
+				endpoint, config.GetGuid(), reference, guid)
+		}
+	}
+	if len(guid) == 0 {
+		return fmt.Errorf("none of the endpoints %s could be reached: %w", strings.Join(failover.Endpoints(), ", "), lastErr)
+	}
+	tflog.Info(ctx, "Verified PowerScale endpoints", map[string]interface{}{
+		"cluster_guid": guid,
+		"active":       failover.ActiveEndpoint(),
+	})
+	return nil
+}
+
+// findFailoverTransport returns the failover transport of a transport chain, if any.
+func findFailoverTransport(rt http.RoundTripper) *FailoverTransport {
+	for rt != nil {
+		switch tr := rt.(type) {
+		case *FailoverTransport:
+			return tr
+		case *TokenTransport:
+			rt = tr.RoundTripper
+		case *RetryTransport:
+			rt = tr.RoundTripper
+		case *LimitTransport:
+			rt = tr.RoundTripper
+		case *CacheTransport:
+			rt = tr.RoundTripper
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
 // NewOpenAPIClient returns the OpenApi Client.
-func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user string, pass string, authType int64, timeout int64) (*powerscale.APIClient, error) {
+func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user string, pass string, authType int64, timeout int64, opts ...ClientOption) (*powerscale.APIClient, error) {
+	options := newClientOptions(opts)
 	// Setup a User-Agent for your API client (replace the provider name for yours):
 	userAgent := "terraform-powerscale-provider/1.0.0"
 	jar, err := cookiejar.New(nil)
@@ -202,40 +320,44 @@ func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user
 		Jar:     jar,
 	}
 
-	var transport *http.Transport
-	if insecure {
-		transport = &http.Transport{
-			// This is done intentionally if the user sets the skipVerify to true
-			/* #nosec */
-			TLSClientConfig: &tls.Config{
-				MinVersion: tls.VersionTLS12,
-				/* #nosec */
-				InsecureSkipVerify: true,
-			},
-			MaxIdleConns:        100,
-			MaxIdleConnsPerHost: 30,
-			MaxConnsPerHost:     10,
-			IdleConnTimeout:     90 * time.Second,
-		}
-	} else {
-		// Loading system certs by default if insecure is set to false
-		// TODO: Check if we need to remove references to UseCerts from the code
-		pool, err := x509.SystemCertPool()
+	tlsConfig, err := NewTLSConfig(insecure, options.tls)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 30,
+		MaxConnsPerHost:     10,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	if options.maxConcurrent > transport.MaxConnsPerHost {
+		transport.MaxConnsPerHost = options.maxConcurrent
+	}
+
+	// Transient failures are retried below the session handling, so a retried request keeps its session,
+	// and above the failover, so that every attempt tries all the endpoints.
+	// Traces are taken closest to the wire, so that every attempt is logged with the endpoint it was sent to.
+	var roundTripper http.RoundTripper = transport
+	if options.trace {
+		roundTripper = NewTraceTransport(transport, pass)
+	}
+	if len(options.fallbackEndpoints) > 0 {
+		failover, err := NewFailoverTransport(roundTripper, append([]string{endpoint}, options.fallbackEndpoints...))
 		if err != nil {
-			errSysCerts := errors.New("unable to initialize cert pool from system")
-			return nil, errSysCerts
-		}
-		transport = &http.Transport{
-			TLSClientConfig: &tls.Config{
-				MinVersion:         tls.VersionTLS12,
-				RootCAs:            pool,
-				InsecureSkipVerify: false,
-			},
-			MaxIdleConns:        100,
-			MaxIdleConnsPerHost: 30,
-			MaxConnsPerHost:     10,
-			IdleConnTimeout:     90 * time.Second,
+			return nil, err
 		}
+		roundTripper = failover
+	}
+	// Each attempt of a retried request waits for the rate limits, and a waiting retry does not hold a slot.
+	if options.maxConcurrent > 0 || options.requestsPerSecond > 0 {
+		roundTripper = NewLimitTransport(roundTripper, options.maxConcurrent, options.requestsPerSecond)
+	}
+	if options.maxRetries > 0 {
+		roundTripper = NewRetryTransport(roundTripper, options.maxRetries, options.retryMaxWait)
+	}
+	if options.cache != nil {
+		roundTripper = &CacheTransport{RoundTripper: roundTripper, cache: options.cache}
 	}
 
 	cfg := powerscale.Configuration{
@@ -255,15 +377,20 @@ func NewOpenAPIClient(ctx context.Context, endpoint string, insecure bool, user
 	//fmt.Printf("config %+v header %+v\n", cfg, cfg.DefaultHeader)
 
 	if authType == BasicAuthType {
-		httpclient.Transport = transport
+		httpclient.Transport = roundTripper
 		basicAuth(user, pass, &cfg)
 	} else if authType == SessionAuthType {
 		ctx = context.WithValue(ctx, AuthContextKey(AuthType), SessionAuthType)
-		httpclient.Transport = &TokenTransport{Ctx: ctx, Username: user, Password: pass, RoundTripper: transport}
-		err := sessionAuth(ctx, user, pass, &cfg)
+		host, err := cfg.ServerURLWithContext(ctx, "")
 		if err != nil {
 			return nil, err
 		}
+		session := GetSession(host, user, pass)
+		httpclient.Transport = &TokenTransport{Ctx: ctx, Username: user, Password: pass, RoundTripper: roundTripper, Session: session}
+		// Validate the credentials, reusing the session of another provider instance while it is valid.
+		if _, err := session.Headers(ctx, &cfg); err != nil {
+			return nil, err
+		}
 	} else {
 		return nil, errors.New("Auth type is not valid. Should be 0 or 1. ")
 	}
@@ -291,40 +418,6 @@ func getHeaders() map[string]string {
 
 }
 
-func sessionAuth(ctx context.Context, user string, pass string, cfg *powerscale.Configuration) error {
-	mutex.Lock()
-	defer mutex.Unlock()
-	host, err := cfg.ServerURLWithContext(ctx, "")
-	if err != nil {
-		return err
-	}
-	resp, err := RequestSession(host, user, pass, cfg)
-	if err != nil {
-		return err
-	}
-	if resp == nil {
-		return errors.New("authentication failed. empty response")
-	}
-	tflog.Debug(ctx, fmt.Sprintf("response code: %d, response body: %s", resp.StatusCode, resp.Body))
-	if resp.Body == nil || resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("authentication failed. response code: %d", resp.StatusCode)
-	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			tflog.Error(ctx, fmt.Sprintf("Error closing HTTP response: %s", err.Error()))
-		}
-	}()
-	isisessid := getCookie(resp.Cookies(), "isisessid")
-	isicsrf := getCookie(resp.Cookies(), "isicsrf")
-	if len(isisessid) == 0 || len(isicsrf) == 0 {
-		return errors.New("authentication failed. isisessid or isicsrf cookie invalid")
-	}
-	cfg.AddDefaultHeader("Cookie", fmt.Sprintf("isisessid=%s", isisessid))
-	cfg.AddDefaultHeader("X-CSRF-Token", isicsrf)
-	cfg.AddDefaultHeader("Referer", host)
-	return nil
-}
-
 func getCookie(cookies []*http.Cookie, cookieName string) string {
 	for _, cookie := range cookies {
 		if strings.EqualFold(cookie.Name, cookieName) {
@@ -334,11 +427,18 @@ func getCookie(cookies []*http.Cookie, cookieName string) string {
 	return ""
 }
 
-func RequestSession(host string, user string, pass string, cfg *powerscale.Configuration) (*http.Response, error) {
+func RequestSession(ctx context.Context, host string, user string, pass string, cfg *powerscale.Configuration) (*http.Response, error) {
 	sessionUrl := concatUrl(host, SessionEndpoint)
-	json := fmt.Sprintf(`{"username":"%s", "password":"%s", "services":["platform", "namespace"]}`, user, pass)
+	body, err := json.Marshal(map[string]interface{}{
+		"username": user,
+		"password": pass,
+		"services": []string{"platform", "namespace"},
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	request, err := http.NewRequest("POST", sessionUrl, strings.NewReader(json))
+	request, err := http.NewRequestWithContext(ctx, "POST", sessionUrl, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -353,19 +453,29 @@ func concatUrl(endpoint string, s string) string {
 	return fmt.Sprintf("%s/%s", endpoint, s)
 }
 
+// TokenTransport authenticates requests with the shared PAPI session of the client.
 type TokenTransport struct {
 	http.RoundTripper
 	Ctx      context.Context
 	Username string
 	Password string
 	Client   *powerscale.APIClient
+	Session  *Session
+	once     sync.Once
 }
 
+// RoundTrip adds the session headers to the request, and logs in again once if PAPI rejects the session.
 func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	resp, err := t.RoundTripper.RoundTrip(req)
-	if t.Ctx.Value(AuthContextKey(AuthType)) != SessionAuthType || req.URL.Path == SessionEndpoint {
-		return resp, err
+	if t.Ctx.Value(AuthContextKey(AuthType)) != SessionAuthType || strings.HasSuffix(req.URL.Path, SessionEndpoint) {
+		return t.RoundTripper.RoundTrip(req)
 	}
+	config := t.Client.GetConfig()
+	session := t.session(req)
+	headers, err := session.Headers(req.Context(), config)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.RoundTripper.RoundTrip(withHeaders(req.Clone(req.Context()), headers)) // per RoundTrip contract
 	if err != nil {
 		return resp, err
 	}
@@ -373,20 +483,33 @@ func (t *TokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		return nil, fmt.Errorf("got empty response for request [%s]", req.URL.Path)
 	}
 	if resp.StatusCode == http.StatusUnauthorized {
-		config := t.Client.GetConfig()
-		err := sessionAuth(t.Ctx, t.Username, t.Password, config)
+		drainBody(resp)
+		headers, err = session.Refresh(req.Context(), config, headers)
 		if err != nil {
 			return nil, err
 		}
-		newReq := req.Clone(req.Context()) // per RoundTrip contract
-		for key, value := range config.DefaultHeader {
-			newReq.Header.Set(key, value)
-		}
-		trip, err := t.RoundTripper.RoundTrip(newReq)
+		newReq, err := rewindRequest(req)
 		if err != nil {
 			return nil, err
 		}
-		return trip, nil
+		return t.RoundTripper.RoundTrip(withHeaders(newReq, headers))
 	}
-	return resp, err
+	return resp, nil
+}
+
+// session returns the session of the transport, looking it up from the request when the transport was built without one.
+func (t *TokenTransport) session(req *http.Request) *Session {
+	t.once.Do(func() {
+		if t.Session == nil {
+			t.Session = GetSession(fmt.Sprintf("%s://%s", req.URL.Scheme, req.URL.Host), t.Username, t.Password)
+		}
+	})
+	return t.Session
+}
+
+func withHeaders(req *http.Request, headers map[string]string) *http.Request {
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	return req
 }
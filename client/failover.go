@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// endpointContextKey pins a request to one endpoint of a FailoverTransport.
+type endpointContextKey struct{}
+
+// WithEndpoint returns a context whose requests are only sent to the endpoint at the given index, without failover.
+func WithEndpoint(ctx context.Context, index int) context.Context {
+	return context.WithValue(ctx, endpointContextKey{}, index)
+}
+
+// WithFallbackEndpoints sets endpoints of the same cluster, e.g. node IPs behind a SmartConnect name,
+// that requests fail over to when the configured endpoint is unavailable.
+func WithFallbackEndpoints(endpoints []string) ClientOption {
+	return func(o *clientOptions) {
+		o.fallbackEndpoints = endpoints
+	}
+}
+
+// FailoverTransport sends requests to the active endpoint of a cluster,
+// and switches to the next usable endpoint when the active one cannot be reached or answers 503.
+type FailoverTransport struct {
+	http.RoundTripper
+	mu        sync.Mutex
+	endpoints []*url.URL
+	usable    []bool
+	active    int
+}
+
+// NewFailoverTransport returns a transport failing over between the given endpoints, in order.
+func NewFailoverTransport(rt http.RoundTripper, endpoints []string) (*FailoverTransport, error) {
+	t := &FailoverTransport{RoundTripper: rt}
+	for _, endpoint := range endpoints {
+		parsed, err := url.Parse(strings.TrimSpace(endpoint))
+		if err != nil || len(parsed.Scheme) == 0 || len(parsed.Host) == 0 {
+			return nil, fmt.Errorf("invalid endpoint %q, expected the form https://host:port", endpoint)
+		}
+		t.endpoints = append(t.endpoints, parsed)
+		t.usable = append(t.usable, true)
+	}
+	if len(t.endpoints) == 0 {
+		return nil, errors.New("at least one endpoint is required")
+	}
+	return t, nil
+}
+
+// Endpoints returns the endpoints of the transport.
+func (t *FailoverTransport) Endpoints() []string {
+	endpoints := make([]string, len(t.endpoints))
+	for i, endpoint := range t.endpoints {
+		endpoints[i] = endpoint.String()
+	}
+	return endpoints
+}
+
+// ActiveEndpoint returns the endpoint requests are currently sent to.
+func (t *FailoverTransport) ActiveEndpoint() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.endpoints[t.active].String()
+}
+
+// SetUsable includes or excludes an endpoint from failover.
+func (t *FailoverTransport) SetUsable(index int, usable bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.usable[index] = usable
+	if !usable && t.active == index {
+		for i := range t.endpoints {
+			if t.usable[i] {
+				t.active = i
+				break
+			}
+		}
+	}
+}
+
+// RoundTrip sends the request to the active endpoint, trying the other usable endpoints in turn when it is unavailable.
+func (t *FailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if index, ok := req.Context().Value(endpointContextKey{}).(int); ok && index >= 0 && index < len(t.endpoints) {
+		return t.RoundTripper.RoundTrip(t.rewrite(req.Clone(req.Context()), index))
+	}
+
+	t.mu.Lock()
+	start := t.active
+	t.mu.Unlock()
+
+	var resp *http.Response
+	var err error
+	tried := 0
+	for i := 0; i < len(t.endpoints); i++ {
+		index := (start + i) % len(t.endpoints)
+		if !t.isUsable(index) {
+			continue
+		}
+		attemptReq := req.Clone(req.Context())
+		if tried > 0 {
+			if resp != nil {
+				drainBody(resp)
+			}
+			if attemptReq, err = rewindRequest(req); err != nil {
+				return nil, err
+			}
+		}
+		tried++
+		resp, err = t.RoundTripper.RoundTrip(t.rewrite(attemptReq, index))
+		if !t.unavailable(req, resp, err) {
+			t.activate(req.Context(), index)
+			return resp, err
+		}
+		fields := map[string]interface{}{
+			"endpoint": t.endpoints[index].String(),
+			"method":   req.Method,
+			"path":     req.URL.Path,
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+		}
+		tflog.Warn(req.Context(), "PowerScale endpoint unavailable", fields)
+		if req.Body != nil && req.GetBody == nil {
+			break
+		}
+	}
+	return resp, err
+}
+
+// unavailable reports whether the endpoint did not process the request, so it can be sent to another endpoint.
+func (t *FailoverTransport) unavailable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return isDialError(err) || (isIdempotent(req.Method) && isTransientNetworkError(err))
+	}
+	return resp.StatusCode == http.StatusServiceUnavailable
+}
+
+func (t *FailoverTransport) isUsable(index int) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.usable[index]
+}
+
+func (t *FailoverTransport) activate(ctx context.Context, index int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.active == index {
+		return
+	}
+	tflog.Warn(ctx, "PowerScale active endpoint changed", map[string]interface{}{
+		"previous": t.endpoints[t.active].String(),
+		"active":   t.endpoints[index].String(),
+	})
+	t.active = index
+}
+
+// rewrite points the request to the endpoint at the given index.
+func (t *FailoverTransport) rewrite(req *http.Request, index int) *http.Request {
+	req.URL.Scheme = t.endpoints[index].Scheme
+	req.URL.Host = t.endpoints[index].Host
+	req.Host = ""
+	return req
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit bounds the requests the client sends to the cluster:
+// maxConcurrent requests in flight at once, and requestsPerSecond requests per second, spaced evenly.
+// A limit of 0 disables it.
+func WithRateLimit(maxConcurrent int, requestsPerSecond float64) ClientOption {
+	return func(o *clientOptions) {
+		o.maxConcurrent = maxConcurrent
+		o.requestsPerSecond = requestsPerSecond
+	}
+}
+
+// LimitTransport queues requests so that the cluster is not sent more than a number of requests at once or per second.
+// It is shared by all the requests of a client, and every attempt of a retried request goes through it.
+type LimitTransport struct {
+	http.RoundTripper
+	slots   chan struct{}
+	limiter *rate.Limiter
+}
+
+// NewLimitTransport wraps the given transport with the limits, a limit of 0 disables it.
+func NewLimitTransport(rt http.RoundTripper, maxConcurrent int, requestsPerSecond float64) *LimitTransport {
+	t := &LimitTransport{RoundTripper: rt}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	}
+	return t
+}
+
+// RoundTrip waits for a free slot and for the rate limit, then sends the request.
+// The wait is logged at debug level, and ends early when the request context is done.
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+	queued := false
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		default:
+			queued = true
+			select {
+			case t.slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		defer func() { <-t.slots }()
+	}
+
+	if t.limiter != nil {
+		reservation := t.limiter.Reserve()
+		if delay := reservation.Delay(); delay > 0 {
+			queued = true
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				reservation.Cancel()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	if queued {
+		tflog.Debug(ctx, "PowerScale API request queued by the client rate limits", map[string]interface{}{
+			"method": req.Method,
+			"path":   req.URL.Path,
+			"wait":   time.Since(start).String(),
+		})
+	}
+	return t.RoundTripper.RoundTrip(req)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// APIError is the error of a PAPI request answered with an error status.
+// Like the errors of the OpenAPI client, it exposes the response body for helper.GetErrorString.
+type APIError struct {
+	StatusCode int
+	Status     string
+	body       []byte
+}
+
+// Error returns the HTTP status of the response.
+func (e *APIError) Error() string {
+	return e.Status
+}
+
+// Body returns the body of the error response.
+func (e *APIError) Body() []byte {
+	return e.body
+}
+
+// Request sends a request to an arbitrary PAPI path, e.g. /platform/1/protocols/hdfs/settings,
+// through the same authentication, retries and failover as the OpenAPI client.
+// It returns the body and status of a successful response, and an *APIError for an error status.
+func (c *Client) Request(ctx context.Context, method, path string, query url.Values, body []byte) ([]byte, int, error) {
+	cfg := c.PscaleOpenAPIClient.GetConfig()
+	if len(cfg.Servers) == 0 {
+		return nil, 0, errors.New("the client has no endpoint")
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	target, err := url.Parse(strings.TrimSuffix(cfg.Servers[0].URL, "/") + path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid path %s: %w", path, err)
+	}
+	if len(query) > 0 {
+		target.RawQuery = query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, target.String(), reader)
+	if err != nil {
+		return nil, 0, err
+	}
+	for key, value := range cfg.DefaultHeader {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("User-Agent", cfg.UserAgent)
+
+	resp, err := cfg.HTTPClient.Do(request)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.StatusCode, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, resp.StatusCode, &APIError{StatusCode: resp.StatusCode, Status: resp.Status, body: respBody}
+	}
+	return respBody, resp.StatusCode, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried when not configured.
+	DefaultMaxRetries = 3
+	// DefaultRetryMaxWait is the upper bound of the wait between two attempts when not configured.
+	DefaultRetryMaxWait = 30 * time.Second
+	// retryMinWait is the wait before the first retry, doubled on every following attempt.
+	retryMinWait = 1 * time.Second
+)
+
+// RetryTransport retries requests that failed because of a transient PAPI or network failure.
+// Idempotent methods are retried on connection errors and on 429, 502, 503 and 504 responses.
+// POST and PATCH requests are only retried when the cluster never processed them:
+// the connection could not be established, or PAPI answered 429 or 503.
+type RetryTransport struct {
+	http.RoundTripper
+	MaxRetries int
+	MaxWait    time.Duration
+}
+
+// NewRetryTransport wraps the given transport with the retry logic.
+func NewRetryTransport(rt http.RoundTripper, maxRetries int, maxWait time.Duration) *RetryTransport {
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+	return &RetryTransport{RoundTripper: rt, MaxRetries: maxRetries, MaxWait: maxWait}
+}
+
+// RoundTrip sends the request and retries it with exponential backoff and jitter.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			var err error
+			if attemptReq, err = rewindRequest(req); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := t.RoundTripper.RoundTrip(attemptReq)
+		// requests pinned to an endpoint probe that endpoint, and are not retried
+		_, pinned := ctx.Value(endpointContextKey{}).(int)
+		if pinned || attempt >= t.MaxRetries || !shouldRetry(req.Method, resp, err) {
+			return resp, err
+		}
+		if req.Body != nil && req.GetBody == nil {
+			// the body has been consumed and cannot be sent again
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			drainBody(resp)
+		}
+		tflog.Warn(ctx, "Retrying PowerScale API request after transient failure", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the wait before the next attempt, honouring a Retry-After header sent by PAPI.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, t.MaxWait)
+		}
+	}
+	wait := retryMinWait << attempt
+	if wait <= 0 || wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+	// equal jitter keeps half of the exponential wait and spreads retries of parallel operations over the other half
+	// #nosec G404 -- jitter does not need a cryptographically secure source
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// shouldRetry decides whether a request that produced the given response or error can be sent again.
+func shouldRetry(method string, resp *http.Response, err error) bool {
+	idempotent := isIdempotent(method)
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		if isDialError(err) {
+			return true
+		}
+		return idempotent && isTransientNetworkError(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isDialError reports whether the request failed before a connection to the cluster was established.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.Temporary()
+}
+
+// isTransientNetworkError reports errors caused by a node dropping the connection, e.g. during a reboot.
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// rewindRequest returns a copy of the request with a fresh body for another attempt.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	newReq := req.Clone(req.Context())
+	if req.Body == nil || req.GetBody == nil {
+		return newReq, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("unable to rewind body of request [%s]: %w", req.URL.Path, err)
+	}
+	newReq.Body = body
+	return newReq, nil
+}
+
+// drainBody discards the rest of a response body so the connection can be reused.
+func drainBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	_ = resp.Body.Close()
+}
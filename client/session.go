@@ -0,0 +1,326 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	powerscale "dell/powerscale-go-client"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// sessionRefreshMargin is how long before its expiry a session is renewed.
+	sessionRefreshMargin = time.Minute
+	// defaultSessionAbsoluteTimeout and defaultSessionInactiveTimeout are the OneFS defaults,
+	// used when the session creation response does not report the timeouts.
+	defaultSessionAbsoluteTimeout = 4 * time.Hour
+	defaultSessionInactiveTimeout = 15 * time.Minute
+	// logoutTimeout bounds each logout, as the plugin process is killed shortly after shutdown.
+	logoutTimeout = 2 * time.Second
+)
+
+// sessionManager shares PAPI sessions between all the provider instances of the plugin process.
+// The manager lock only guards the registry, each session has its own lock,
+// so logins to different endpoints or users do not wait for each other.
+type sessionManager struct {
+	mu       sync.Mutex
+	sessions map[string]*Session
+}
+
+var sessions = &sessionManager{sessions: map[string]*Session{}}
+
+// Session is a PAPI session shared by every client of the same endpoint and credentials.
+type Session struct {
+	mu              sync.Mutex
+	host            string
+	username        string
+	password        string
+	id              string
+	csrf            string
+	createdAt       time.Time
+	lastUsed        time.Time
+	absoluteTimeout time.Duration
+	inactiveTimeout time.Duration
+	transport       http.RoundTripper
+	userAgent       string
+}
+
+// sessionTimeouts is the part of the session creation response describing its lifetime.
+type sessionTimeouts struct {
+	TimeoutAbsolute int64 `json:"timeout_absolute"`
+	TimeoutInactive int64 `json:"timeout_inactive"`
+}
+
+// GetSession returns the session shared for the endpoint and credentials, registering it on first use.
+// The password is part of the key, so a client with wrong credentials never reuses a valid session.
+func GetSession(host, user, pass string) *Session {
+	sum := sha256.Sum256([]byte(pass))
+	key := strings.Join([]string{strings.TrimSuffix(host, "/"), user, hex.EncodeToString(sum[:])}, "|")
+
+	sessions.mu.Lock()
+	defer sessions.mu.Unlock()
+	session, ok := sessions.sessions[key]
+	if !ok {
+		session = &Session{host: host, username: user, password: pass}
+		sessions.sessions[key] = session
+	}
+	return session
+}
+
+// CloseSessions logs out of every session opened by the plugin process.
+// It is called when the plugin shuts down, so that sessions do not linger on the cluster until they time out.
+func CloseSessions(ctx context.Context) {
+	sessions.mu.Lock()
+	open := make([]*Session, 0, len(sessions.sessions))
+	for _, session := range sessions.sessions {
+		open = append(open, session)
+	}
+	sessions.sessions = map[string]*Session{}
+	sessions.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, session := range open {
+		wg.Add(1)
+		go func(session *Session) {
+			defer wg.Done()
+			logoutCtx, cancel := context.WithTimeout(ctx, logoutTimeout)
+			defer cancel()
+			if err := session.Logout(logoutCtx); err != nil {
+				tflog.Warn(ctx, "Unable to log out of PowerScale session", map[string]interface{}{
+					"host":     session.host,
+					"username": session.username,
+					"error":    err.Error(),
+				})
+			}
+		}(session)
+	}
+	wg.Wait()
+}
+
+// Headers returns the headers authenticating a request with the session.
+// It logs in first when there is no session yet or when the session is about to expire.
+func (s *Session) Headers(ctx context.Context, cfg *powerscale.Configuration) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if s.expiring(now) {
+		if err := s.login(ctx, cfg); err != nil {
+			return nil, err
+		}
+	}
+	s.lastUsed = now
+	return s.headers(), nil
+}
+
+// Refresh logs in again after PAPI rejected the session with the given headers,
+// unless a concurrent request has already replaced it.
+func (s *Session) Refresh(ctx context.Context, cfg *powerscale.Configuration, rejected map[string]string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.id) == 0 || s.headers()["Cookie"] == rejected["Cookie"] {
+		if err := s.login(ctx, cfg); err != nil {
+			return nil, err
+		}
+	}
+	s.lastUsed = time.Now()
+	return s.headers(), nil
+}
+
+// Logout deletes the session on the cluster.
+func (s *Session) Logout(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.id) == 0 || s.transport == nil {
+		return nil
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, concatUrl(s.host, SessionEndpoint), nil)
+	if err != nil {
+		return err
+	}
+	for key, value := range s.headers() {
+		request.Header.Set(key, value)
+	}
+	request.Header.Set("User-Agent", s.userAgent)
+	s.id, s.csrf = "", ""
+
+	resp, err := (&http.Client{Transport: s.transport}).Do(request)
+	if err != nil {
+		return err
+	}
+	drainBody(resp)
+	// an expired session is already gone
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusUnauthorized {
+		return fmt.Errorf("logout failed. response code: %d", resp.StatusCode)
+	}
+	tflog.Debug(ctx, "Logged out of PowerScale session", map[string]interface{}{
+		"host":     s.host,
+		"username": s.username,
+	})
+	return nil
+}
+
+// expiring reports whether the session must be renewed before it is used.
+func (s *Session) expiring(now time.Time) bool {
+	if len(s.id) == 0 {
+		return true
+	}
+	return now.After(s.createdAt.Add(s.absoluteTimeout-sessionRefreshMargin)) ||
+		now.After(s.lastUsed.Add(s.inactiveTimeout-sessionRefreshMargin))
+}
+
+func (s *Session) headers() map[string]string {
+	return map[string]string{
+		"Cookie":       fmt.Sprintf("isisessid=%s", s.id),
+		"X-CSRF-Token": s.csrf,
+		"Referer":      s.host,
+	}
+}
+
+// login creates a new session. The caller must hold the session lock.
+func (s *Session) login(ctx context.Context, cfg *powerscale.Configuration) error {
+	resp, err := RequestSession(ctx, s.host, s.username, s.password, cfg)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errors.New("authentication failed. empty response")
+	}
+	tflog.Debug(ctx, "PowerScale session creation response", map[string]interface{}{
+		"host":        s.host,
+		"username":    s.username,
+		"status_code": resp.StatusCode,
+	})
+	if resp.Body == nil || resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("authentication failed. response code: %d", resp.StatusCode)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Error closing HTTP response: %s", err.Error()))
+		}
+	}()
+	isisessid := getCookie(resp.Cookies(), "isisessid")
+	isicsrf := getCookie(resp.Cookies(), "isicsrf")
+	if len(isisessid) == 0 || len(isicsrf) == 0 {
+		return errors.New("authentication failed. isisessid or isicsrf cookie invalid")
+	}
+
+	timeouts := sessionTimeouts{}
+	if body, err := io.ReadAll(resp.Body); err == nil {
+		_ = json.Unmarshal(body, &timeouts)
+	}
+	s.absoluteTimeout = defaultSessionAbsoluteTimeout
+	if timeouts.TimeoutAbsolute > 0 {
+		s.absoluteTimeout = time.Duration(timeouts.TimeoutAbsolute) * time.Second
+	}
+	s.inactiveTimeout = defaultSessionInactiveTimeout
+	if timeouts.TimeoutInactive > 0 {
+		s.inactiveTimeout = time.Duration(timeouts.TimeoutInactive) * time.Second
+	}
+
+	s.id, s.csrf = isisessid, isicsrf
+	s.createdAt = time.Now()
+	s.lastUsed = s.createdAt
+	s.userAgent = cfg.UserAgent
+	s.transport = cfg.HTTPClient.Transport
+	if tr, ok := s.transport.(*TokenTransport); ok {
+		s.transport = tr.RoundTripper
+	}
+	tflog.Info(ctx, "Created PowerScale session", map[string]interface{}{
+		"host":             s.host,
+		"username":         s.username,
+		"timeout_absolute": s.absoluteTimeout.String(),
+		"timeout_inactive": s.inactiveTimeout.String(),
+	})
+	return nil
+}
+
+// SessionToken is a PAPI session created for a consumer other than the provider, e.g. a script or another tool.
+// It is not shared with the clients of the provider, and stays valid until it is deleted or times out.
+type SessionToken struct {
+	ID              string
+	CSRFToken       string
+	Username        string
+	CreatedAt       time.Time
+	TimeoutAbsolute time.Duration
+	TimeoutInactive time.Duration
+}
+
+// CreateSessionToken creates a PAPI session for the given credentials.
+// The session cookies are not stored in the cookie jar of the client, so they never authenticate provider requests.
+func (c *Client) CreateSessionToken(ctx context.Context, username, password string) (*SessionToken, error) {
+	cfg, host, err := c.sessionConfig()
+	if err != nil {
+		return nil, err
+	}
+	session := &Session{host: host, username: username, password: password}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if err := session.login(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return &SessionToken{
+		ID:              session.id,
+		CSRFToken:       session.csrf,
+		Username:        username,
+		CreatedAt:       session.createdAt,
+		TimeoutAbsolute: session.absoluteTimeout,
+		TimeoutInactive: session.inactiveTimeout,
+	}, nil
+}
+
+// DeleteSessionToken deletes a session created with CreateSessionToken. A session that has already expired is ignored.
+func (c *Client) DeleteSessionToken(ctx context.Context, token SessionToken) error {
+	cfg, host, err := c.sessionConfig()
+	if err != nil {
+		return err
+	}
+	transport := cfg.HTTPClient.Transport
+	if tr, ok := transport.(*TokenTransport); ok {
+		transport = tr.RoundTripper
+	}
+	session := &Session{
+		host:      host,
+		username:  token.Username,
+		id:        token.ID,
+		csrf:      token.CSRFToken,
+		transport: transport,
+		userAgent: cfg.UserAgent,
+	}
+	return session.Logout(ctx)
+}
+
+// sessionConfig returns a copy of the client configuration without cookie jar, and the endpoint of the client.
+func (c *Client) sessionConfig() (*powerscale.Configuration, string, error) {
+	cfg := *c.PscaleOpenAPIClient.GetConfig()
+	if len(cfg.Servers) == 0 {
+		return nil, "", errors.New("the client has no endpoint")
+	}
+	cfg.HTTPClient = &http.Client{Transport: cfg.HTTPClient.Transport, Timeout: cfg.HTTPClient.Timeout}
+	return &cfg, cfg.Servers[0].URL, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// TLSOptions holds the TLS settings used to connect to the cluster.
+// Certificates and keys are either PEM encoded content or the path of a PEM file.
+type TLSOptions struct {
+	// CACertificate is a bundle of CA certificates trusted in addition to the system pool.
+	CACertificate string
+	// CertificateFingerprint is the SHA-256 fingerprint of the expected server certificate.
+	// When set, the server certificate must match it and the CA chain is not verified.
+	CertificateFingerprint string
+	// ServerName overrides the name used to verify the server certificate, e.g. when connecting by IP.
+	ServerName string
+	// ClientCertificate and ClientKey are presented to the cluster for mutual TLS.
+	ClientCertificate string
+	ClientKey         string
+}
+
+// WithTLS sets the TLS options of the client.
+func WithTLS(tlsOptions TLSOptions) ClientOption {
+	return func(o *clientOptions) {
+		o.tls = tlsOptions
+	}
+}
+
+// NewTLSConfig builds the TLS configuration of the client transport.
+func NewTLSConfig(insecure bool, opts TLSOptions) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: opts.ServerName,
+	}
+
+	if len(opts.ClientCertificate) > 0 || len(opts.ClientKey) > 0 {
+		if len(opts.ClientCertificate) == 0 || len(opts.ClientKey) == 0 {
+			return nil, errors.New("client certificate and client key must be set together")
+		}
+		certPEM, err := readPEM(opts.ClientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+		keyPEM, err := readPEM(opts.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %w", err)
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	if insecure {
+		// This is done intentionally if the user sets the skipVerify to true
+		/* #nosec */
+		config.InsecureSkipVerify = true
+		return config, nil
+	}
+
+	if len(opts.CertificateFingerprint) > 0 {
+		fingerprint, err := normalizeFingerprint(opts.CertificateFingerprint)
+		if err != nil {
+			return nil, err
+		}
+		// The pinned certificate replaces the CA chain verification, which allows self-signed cluster certificates.
+		/* #nosec */
+		config.InsecureSkipVerify = true
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("server did not present a certificate")
+			}
+			sum := sha256.Sum256(state.PeerCertificates[0].Raw)
+			if actual := hex.EncodeToString(sum[:]); actual != fingerprint {
+				return fmt.Errorf("server certificate fingerprint %s does not match the pinned fingerprint %s", actual, fingerprint)
+			}
+			return nil
+		}
+		return config, nil
+	}
+
+	// Loading system certs by default if insecure is set to false
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, errors.New("unable to initialize cert pool from system")
+	}
+	if len(opts.CACertificate) > 0 {
+		caPEM, err := readPEM(opts.CACertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid PEM certificate found in CA certificate")
+		}
+	}
+	config.RootCAs = pool
+	return config, nil
+}
+
+// readPEM returns the PEM content of the value, reading it from a file unless it is inline PEM.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	// #nosec G304 -- the certificate location is supplied by the user on purpose
+	return os.ReadFile(value)
+}
+
+// normalizeFingerprint accepts a hex SHA-256 fingerprint with or without colons and returns it in lower case.
+func normalizeFingerprint(fingerprint string) (string, error) {
+	normalized := strings.TrimPrefix(strings.ToLower(strings.TrimSpace(fingerprint)), "sha256:")
+	normalized = strings.NewReplacer(":", "", " ", "").Replace(normalized)
+	if decoded, err := hex.DecodeString(normalized); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid SHA-256 certificate fingerprint %q", fingerprint)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// TraceSubsystem is the tflog subsystem of the HTTP traces.
+	// Its level can be set apart from the provider logs with the TF_LOG_PROVIDER_POWERSCALE_HTTP environment variable.
+	TraceSubsystem = "http"
+	// redacted replaces the secrets in the traces, as the values masked by tflog.
+	redacted = "***"
+	// maxTracedBody bounds the size of a body written to the traces.
+	maxTracedBody = 64 << 10
+)
+
+// redactedHeaders are the headers carrying credentials.
+var redactedHeaders = map[string]bool{
+	"Authorization": true,
+	"X-Csrf-Token":  true,
+}
+
+// sessionCookieRegex matches the values of the PAPI session cookies.
+var sessionCookieRegex = regexp.MustCompile(`(?i)((?:isisessid|isicsrf)=)[^;\s,]*`)
+
+// secretFields are the substrings of the JSON field names whose values are redacted,
+// e.g. password, bind_password, secret_key of S3 keys or snmp_v3_priv_password.
+var secretFields = []string{"password", "secret", "passphrase", "private_key", "community"}
+
+// WithTrace logs every request sent to the cluster, with its status, latency and JSON bodies, secrets redacted.
+func WithTrace(enabled bool) ClientOption {
+	return func(o *clientOptions) {
+		o.trace = enabled
+	}
+}
+
+// TraceTransport logs the requests going through it to the http subsystem at debug level.
+// Credentials, session cookies, CSRF tokens and secret fields of the JSON bodies are redacted.
+type TraceTransport struct {
+	http.RoundTripper
+	// Secrets are masked wherever they appear in the traces, e.g. the provider password.
+	Secrets []string
+}
+
+// NewTraceTransport wraps the given transport with the tracing.
+func NewTraceTransport(rt http.RoundTripper, secrets ...string) *TraceTransport {
+	return &TraceTransport{RoundTripper: rt, Secrets: secrets}
+}
+
+// RoundTrip sends the request and logs it along with its response.
+func (t *TraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), TraceSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_POWERSCALE", TraceSubsystem), tflog.WithRootFields())
+	for _, secret := range t.Secrets {
+		if len(secret) > 0 {
+			ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, TraceSubsystem, secret)
+		}
+	}
+
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             req.URL.String(),
+		"request_headers": redactHeaders(req.Header),
+	}
+	if req.Body != nil && req.GetBody != nil && isJSON(req.Header.Get("Content-Type")) {
+		if body, err := req.GetBody(); err == nil {
+			content, _ := io.ReadAll(io.LimitReader(body, maxTracedBody+1))
+			_ = body.Close()
+			fields["request_body"] = redactBody(content)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.RoundTripper.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, TraceSubsystem, "PowerScale API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["response_headers"] = redactHeaders(resp.Header)
+	if resp.Body != nil && isJSON(resp.Header.Get("Content-Type")) {
+		content, readErr := io.ReadAll(io.LimitReader(resp.Body, maxTracedBody+1))
+		// the rest of the body is still read by the caller
+		resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(content), resp.Body), Closer: resp.Body}
+		if readErr == nil {
+			fields["response_body"] = redactBody(content)
+		}
+	}
+	tflog.SubsystemDebug(ctx, TraceSubsystem, "PowerScale API request", fields)
+	return resp, nil
+}
+
+// readCloser reads from a reader and closes the original body.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// redactHeaders returns the headers with the credentials and session cookies redacted.
+func redactHeaders(header http.Header) map[string]string {
+	redactedHeader := make(map[string]string, len(header))
+	for name, values := range header {
+		value := strings.Join(values, ", ")
+		switch {
+		case redactedHeaders[http.CanonicalHeaderKey(name)]:
+			value = redacted
+		case strings.EqualFold(name, "Cookie") || strings.EqualFold(name, "Set-Cookie"):
+			value = sessionCookieRegex.ReplaceAllString(value, "${1}"+redacted)
+		}
+		redactedHeader[name] = value
+	}
+	return redactedHeader
+}
+
+// redactBody returns a JSON body with the values of its secret fields redacted.
+func redactBody(content []byte) string {
+	if len(content) > maxTracedBody {
+		return fmt.Sprintf("<%d+ bytes, not traced>", maxTracedBody)
+	}
+	if len(content) == 0 {
+		return ""
+	}
+	var body interface{}
+	if err := json.Unmarshal(content, &body); err != nil {
+		return fmt.Sprintf("<%d bytes, invalid JSON>", len(content))
+	}
+	var redactedBody strings.Builder
+	encoder := json.NewEncoder(&redactedBody)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(body)); err != nil {
+		return fmt.Sprintf("<%d bytes>", len(content))
+	}
+	return strings.TrimSuffix(redactedBody.String(), "\n")
+}
+
+func redactValue(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typed {
+			if isSecretField(key) {
+				typed[key] = redacted
+			} else {
+				typed[key] = redactValue(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range typed {
+			typed[i] = redactValue(nested)
+		}
+	}
+	return value
+}
+
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range secretFields {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
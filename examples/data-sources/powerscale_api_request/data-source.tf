@@ -0,0 +1,34 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# This datasource reads PAPI endpoints that the provider has no dedicated datasource for.
+
+# Reads the HDFS settings of an access zone
+data "powerscale_api_request" "hdfs_settings" {
+  path = "/platform/1/protocols/hdfs/settings"
+
+  # Query parameters of the request
+  query = {
+    zone = "System"
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The response body can be decoded with jsondecode
+output "powerscale_hdfs_settings" {
+  value = jsondecode(data.powerscale_api_request.hdfs_settings.response_body).settings
+}
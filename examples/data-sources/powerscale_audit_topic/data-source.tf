@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Audit topics are the protocol and configuration auditing of the PowerScale array.
+
+# Returns all the audit topics of the PowerScale array, and whether their auditing is enabled
+data "powerscale_audit_topic" "all" {
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_audit_topic.all
+output "powerscale_audit_topic_all" {
+  value = data.powerscale_audit_topic.all
+}
+
+# Returns the audit topics matching the filter
+data "powerscale_audit_topic" "example" {
+  filter {
+    # Optional
+    ids = ["protocol"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_audit_topic.example
+output "powerscale_audit_topic_example" {
+  value = data.powerscale_audit_topic.example
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Event groups gather the related events raised by the cluster.
+
+# Returns all the event group occurrences of the PowerScale array
+data "powerscale_event_group" "all" {
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_event_group.all
+output "powerscale_event_group_all" {
+  value = data.powerscale_event_group.all
+}
+
+# Returns the event group occurrences matching the filter
+data "powerscale_event_group" "example" {
+  filter {
+    # Optional
+    severities = ["emergency", "critical"]
+    resolved   = false
+    begin      = 1704067200
+    #  end = 1735689600
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_event_group.example
+output "powerscale_event_group_example" {
+  value = data.powerscale_event_group.example
+}
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# The Job Engine runs the cluster maintenance jobs, e.g. TreeDelete, SmartPools or FSAnalyze.
+
+# Returns the active jobs of the PowerScale Job Engine
+data "powerscale_job" "all" {
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_job.all
+output "powerscale_job_all" {
+  value = data.powerscale_job.all
+}
+
+# Returns the running jobs of the given types
+data "powerscale_job" "running" {
+  filter {
+    state = "running"
+    types = ["SmartPools", "FSAnalyze"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_job.running
+output "powerscale_job_running" {
+  value = data.powerscale_job.running
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# The Job Engine runs the cluster maintenance jobs, e.g. TreeDelete, SmartPools or FSAnalyze.
+
+# Returns the reports of the phases of a job
+data "powerscale_job_report" "example" {
+  filter {
+    job_id = 42
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_job_report.example
+output "powerscale_job_report_example" {
+  value = data.powerscale_job_report.example
+}
+
+# Returns the reports of the FSAnalyze jobs since the given time, in seconds since the epoch
+data "powerscale_job_report" "fsanalyze" {
+  filter {
+    job_type = "FSAnalyze"
+    begin    = 1735689600
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_job_report.fsanalyze
+output "powerscale_job_report_fsanalyze" {
+  value = data.powerscale_job_report.fsanalyze
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# The Job Engine runs the cluster maintenance jobs, e.g. TreeDelete, SmartPools or FSAnalyze.
+
+# Returns the job types of the PowerScale Job Engine with their settings
+data "powerscale_job_type" "all" {
+  filter {
+    # Optional, lists the hidden job types as well
+    show_all = true
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_job_type.all
+output "powerscale_job_type_all" {
+  value = data.powerscale_job_type.all
+}
+
+# Returns the given job types
+data "powerscale_job_type" "example" {
+  filter {
+    ids = ["SmartPools", "FSAnalyze"]
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_job_type.example
+output "powerscale_job_type_example" {
+  value = data.powerscale_job_type.example
+}
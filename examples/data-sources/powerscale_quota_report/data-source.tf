@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Quota reports hold the usage of the quotas at the time they were generated.
+
+# Returns all the quota reports of the PowerScale array
+data "powerscale_quota_report" "all" {
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_quota_report.all
+output "powerscale_quota_report_all" {
+  value = data.powerscale_quota_report.all
+}
+
+resource "powerscale_quota_report" "example" {
+}
+
+# Returns a quota report and the usage of the directory quotas in it
+data "powerscale_quota_report" "example" {
+  filter {
+    # Optional
+    ids       = [powerscale_quota_report.example.id]
+    generated = "manual"
+    #  type = "summary"
+  }
+
+  # Optional, the quotas are only read when set
+  quota_filter {
+    # Required
+    report_id = powerscale_quota_report.example.id
+
+    # Optional, as in the powerscale_quota data source
+    type = "directory"
+    #  path = "/ifs/example_quota"
+    #  recurse_path_children = true
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_quota_report.example
+output "powerscale_quota_report_example" {
+  value = data.powerscale_quota_report.example
+}
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# SmartLock domains protect the committed files from changes until their retention date.
+
+# Returns all the SmartLock domains of the PowerScale array, and the state of the compliance clock
+data "powerscale_worm_domain" "all" {
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_worm_domain.all
+output "powerscale_worm_domain_all" {
+  value = data.powerscale_worm_domain.all
+}
+
+# Returns the SmartLock domains matching the filter
+data "powerscale_worm_domain" "example" {
+  filter {
+    # Optional
+    paths = ["/ifs/archive"]
+    type  = "enterprise"
+  }
+}
+
+# Output value of above block by executing 'terraform output' command
+# The user can use the fetched information by the variable data.powerscale_worm_domain.example
+output "powerscale_worm_domain_example" {
+  value = data.powerscale_worm_domain.example
+}
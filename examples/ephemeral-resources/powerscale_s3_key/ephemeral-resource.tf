@@ -0,0 +1,44 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available from Terraform 1.10.
+# Reads the existing S3 key of the user without rotating it, PowerScale does not return its secret key.
+ephemeral "powerscale_s3_key" "existing" {
+  user = "tf_user"
+  zone = "System"
+}
+
+# Generates an S3 key for the user without storing it in the state or plan.
+# With generate = true, a new secret key is generated every time the ephemeral resource is opened, i.e. in each plan and apply,
+# and the previous secret key remains valid for existing_key_expiry_time minutes.
+ephemeral "powerscale_s3_key" "skm" {
+  user                     = "tf_user"
+  zone                     = "System"
+  generate                 = true
+  existing_key_expiry_time = 10
+}
+
+# The key can be passed to write-only attributes or to provider configurations, e.g. to store it in Vault.
+resource "vault_kv_secret_v2" "s3_key" {
+  mount = "secret"
+  name  = "powerscale/tf_user"
+  data_json_wo = jsonencode({
+    access_key = ephemeral.powerscale_s3_key.skm.access_id
+    secret_key = ephemeral.powerscale_s3_key.skm.secret_key
+  })
+  data_json_wo_version = 1
+}
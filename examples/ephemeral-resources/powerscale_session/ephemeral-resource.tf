@@ -0,0 +1,37 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available from Terraform 1.10.
+# Creates a PAPI session on the endpoint of the provider without storing its token in the state or plan.
+# The session is deleted at the end of the Terraform run.
+ephemeral "powerscale_session" "session" {
+  username = "tf_user"
+  password = var.session_password
+}
+
+# Requests are authenticated with the Cookie and X-CSRF-Token headers of the session,
+# e.g. in provisioners, which accept ephemeral values.
+resource "terraform_data" "cluster_config" {
+  provisioner "local-exec" {
+    command = "curl -k -H \"Cookie: $COOKIE\" -H \"X-CSRF-Token: $CSRF_TOKEN\" -H \"Referer: $ENDPOINT\" $ENDPOINT/platform/1/cluster/config"
+    environment = {
+      ENDPOINT   = var.endpoint
+      COOKIE     = ephemeral.powerscale_session.session.cookie
+      CSRF_TOKEN = ephemeral.powerscale_session.session.csrf_token
+    }
+  }
+}
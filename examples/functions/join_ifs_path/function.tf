@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available from Terraform 1.8.
+# Builds normalized OneFS paths, e.g. for the path attributes of shares, exports and quotas.
+locals {
+  # "/ifs/data/home"
+  home = provider::powerscale::join_ifs_path("/ifs/data/", "home/")
+  # "/ifs/data/projects/tfacc"
+  project = provider::powerscale::join_ifs_path("data", "projects", "tfacc")
+}
+
+resource "powerscale_smb_share" "home" {
+  name = "home"
+  path = local.home
+}
@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available from Terraform 1.8.
+# Converts human-readable sizes to bytes, e.g. for quota thresholds.
+resource "powerscale_quota" "quota" {
+  path = "/ifs/tfacc_quota"
+  type = "directory"
+  # hard is 10737418240, soft 8589934592 and advisory 8053063680 bytes
+  thresholds = {
+    hard       = provider::powerscale::parse_size("10G")
+    soft       = provider::powerscale::parse_size("8 GiB")
+    soft_grace = 86400
+    advisory   = provider::powerscale::parse_size("7.5G")
+  }
+}
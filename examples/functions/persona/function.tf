@@ -0,0 +1,27 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available from Terraform 1.8.
+# Builds persona identifiers, e.g. for owners, ACL trustees and role members.
+locals {
+  # "UID:1000"
+  owner = provider::powerscale::persona("uid", "1000")
+  # "SID:S-1-5-32-544"
+  administrators = provider::powerscale::persona("sid", "S-1-5-32-544")
+  # "USER:admin"
+  admin = provider::powerscale::persona("user", "admin")
+}
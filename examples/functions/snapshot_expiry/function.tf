@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available from Terraform 1.8.
+# Computes snapshot expiries, 1 Day, 1 Week or 1 Month after the given time, or 0 for Never.
+locals {
+  # 1714644000
+  expiry = provider::powerscale::snapshot_expiry("2024-05-01T10:00:00Z", "1 Day")
+}
+
+# The expiry of a snapshot created in this run, fixed once the snapshot exists.
+resource "terraform_data" "snapshot_expiry" {
+  input = provider::powerscale::snapshot_expiry(plantimestamp(), "1 Week")
+  lifecycle {
+    ignore_changes = [input]
+  }
+}
@@ -0,0 +1,30 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available from Terraform 1.14, with terraform query.
+# Lists all the file pool policies, except the default policy.
+list "powerscale_filepool_policy" "all" {
+  provider = powerscale
+}
+
+# Lists the file pool policies with the given names.
+list "powerscale_filepool_policy" "by_name" {
+  provider = powerscale
+  config {
+    names = ["example_filepool_policy"]
+  }
+}
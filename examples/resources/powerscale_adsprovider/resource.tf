@@ -26,6 +26,10 @@ resource "powerscale_adsprovider" "ads_test" {
   #   User should have join permission
   user     = "admin"
   password = "password"
+  #   Alternatively, with Terraform 1.11 or later, the password can be set without storing it in the state.
+  #   Change password_wo_version to send the password again.
+  #   password_wo         = "password"
+  #   password_wo_version = 1
 
   #   Optional query parameters
   #   scope = "effective"
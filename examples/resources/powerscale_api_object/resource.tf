@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# This resource manages objects of PAPI endpoints that the provider has no dedicated resource for.
+# Bodies are JSON objects, usually built with jsonencode. Only the fields set in body are checked for drift.
+# Changing path, create_method, id_attribute or query recreates the object.
+
+# An object of a collection: created with POST at path, then read, updated and deleted at path/{id},
+# where {id} is the id field of the creation response.
+resource "powerscale_api_object" "hdfs_rack" {
+  path = "/platform/1/protocols/hdfs/racks"
+  query = {
+    zone = "System"
+  }
+  body = jsonencode({
+    name = "/rack1"
+    client_ip_ranges = [
+      {
+        low  = "10.10.10.1"
+        high = "10.10.10.10"
+      }
+    ]
+  })
+}
+
+# A settings object: updated with PUT at path and left as is on destroy.
+resource "powerscale_api_object" "hdfs_settings" {
+  path          = "/platform/1/protocols/hdfs/settings"
+  create_method = "PUT"
+  delete_method = "NONE"
+  query = {
+    zone = "System"
+  }
+  body = jsonencode({
+    service = true
+  })
+}
+
+# The object as read from PAPI can be decoded with jsondecode.
+output "hdfs_rack" {
+  value = jsondecode(powerscale_api_object.hdfs_rack.response)
+}
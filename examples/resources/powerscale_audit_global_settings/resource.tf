@@ -0,0 +1,38 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load audit global settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load audit global settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting audit global settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale audit global settings enable the protocol and configuration auditing, and forward the protocol audit events to the CEE servers.
+resource "powerscale_audit_global_settings" "example" {
+  # Optional fields both for creating and updating
+  #  protocol_auditing_enabled = true
+  #  config_auditing_enabled = true
+  #  config_syslog_enabled = false
+  #  audited_zones = ["System"]
+  #  cee_server_uris = ["http://cee.example.com:12228/cee"]
+  #  hostname = "cluster.example.com"
+  #  retention_period = 180
+  #  auto_purging_enabled = false
+}
+
+# After the execution of above resource block, audit global settings would have been cached in terraform state file, or
+# audit global settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
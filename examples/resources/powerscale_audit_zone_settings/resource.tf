@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Available actions: Create, Update, Delete and Import.
+# If resource arguments are omitted, `terraform apply` will load audit zone settings from PowerScale, and save to terraform state file.
+# If any resource arguments are specified, `terraform apply` will try to load audit zone settings (if not loaded) and update the settings.
+# `terraform destroy` will delete the resource from terraform state file rather than deleting audit zone settings from PowerScale.
+# For more information, Please check the terraform state file.
+
+# PowerScale audit zone settings select the protocol events audited in an access zone, and their forwarding to syslog.
+# The events are audited once the access zone is in the audited_zones of the powerscale_audit_global_settings resource.
+resource "powerscale_audit_zone_settings" "example" {
+
+  # Required field both for creating and updating
+  zone = "System"
+
+  # Optional fields both for creating and updating
+  #  audit_success = ["create", "delete", "rename", "set_security"]
+  #  audit_failure = ["all"]
+  #  syslog_forwarding_enabled = true
+  #  syslog_audit_events = ["delete", "set_security"]
+}
+
+# After the execution of above resource block, audit zone settings would have been cached in terraform state file, or
+# audit zone settings would have been updated on PowerScale.
+# For more information, Please check the terraform state file.
@@ -31,6 +31,13 @@ resource "powerscale_cluster_snmp" "example_snmp_settings" {
   # read_only_community ="Public"
   # snmp_v1_v2c_access = true
   # snmp_v3_auth_protocol = "SHA"
+  # snmp_v3_access = true
+  # With Terraform 1.11 or later, the SNMPv3 passwords can be set without storing them in the state.
+  # Change the versions to send the passwords again.
+  # snmp_v3_password_wo              = "snmp_v3_password"
+  # snmp_v3_password_wo_version      = 1
+  # snmp_v3_priv_password_wo         = "snmp_v3_priv_password"
+  # snmp_v3_priv_password_wo_version = 1
 }
 # After the execution of above resource block, Cluster SNMP Settings would have been cached in terraform state file, or
 # SNMP Settings would have been updated on PowerScale.
@@ -0,0 +1,39 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file will create the alert condition on the PowerScale array with the attributes set in the config.
+# The name cannot be updated, changing it creates a new alert condition.
+
+resource "powerscale_event_channel" "email" {
+  name      = "storage_team"
+  type      = "smtp"
+  address   = ["storage@example.com"]
+  smtp_host = "smtp.example.com"
+}
+
+resource "powerscale_event_alert_condition" "example" {
+  # Required
+  name      = "critical_events"
+  condition = "NEW"
+
+  # Optional
+  categories = ["all"]
+  severities = ["emergency", "critical"]
+  channels   = [powerscale_event_channel.email.name]
+  #  interval = 3600 # for the ONGOING condition
+  #  limit = 10 # for the NEW EVENTS condition
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file will create the event channels on the PowerScale array with the attributes set in the config.
+# The name and type cannot be updated, changing them creates a new channel.
+
+# Channel sending the alerts by email
+resource "powerscale_event_channel" "email" {
+  # Required
+  name = "storage_team"
+  type = "smtp"
+
+  # Optional
+  address   = ["storage@example.com"]
+  send_as   = "cluster@example.com"
+  subject   = "PowerScale alert"
+  smtp_host = "smtp.example.com"
+  smtp_port = 25
+  #  smtp_use_auth = true
+  #  smtp_username = "alerts"
+  #  smtp_password = "password"
+  # Or, with Terraform 1.11 or later, send the password without storing it in the state
+  #  smtp_password_wo         = "password"
+  #  smtp_password_wo_version = 1
+  #  smtp_security = "starttls"
+  batch = "SEVERITY"
+  #  batch_period = 300
+  #  enabled = true
+  #  allowed_nodes = [1, 2]
+  #  excluded_nodes = [3]
+}
+
+# Channel sending the alerts as SNMP traps
+resource "powerscale_event_channel" "snmp" {
+  # Required
+  name = "monitoring"
+  type = "snmp"
+
+  # Optional
+  host      = "snmp.example.com"
+  community = "public"
+}
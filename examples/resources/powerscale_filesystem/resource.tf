@@ -53,5 +53,12 @@ resource "powerscale_filesystem" "file_system_test" {
   */
 
   # access_control = "0777"
+
+  # Optional : creates a SmartLock (WORM) domain on the directory, which must be empty. Adding it creates the domain in place, removing it replaces the FileSystem.
+  # The retention settings can be updated, see the powerscale_worm_domain resource for their format.
+  # worm_domain = {
+  #   type              = "enterprise"
+  #   default_retention = "P1Y"
+  # }
 }
 # After the execution of above resource block, a PowerScale FileSystem(Namespace directory) would have been created at PowerScale array. You can also verify the changes made in terraform state file.
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file, a TreeDelete job deletes the directory on the PowerScale array, and the apply waits for the job to end.
+# Changing the type, paths, allow_dup or parameters starts a new job.
+# The policy and priority of a job that has not ended yet can be updated.
+# On destroy, a job that has not ended yet is cancelled, or paused, as set by delete_action.
+
+resource "powerscale_job" "tree_delete" {
+  # Required
+  type = "TreeDelete"
+
+  # Optional
+  paths = ["/ifs/data/old_project"]
+  # policy = "LOW"
+  # priority = 4
+  # allow_dup = false
+  # wait_for_completion = true
+  # delete_action = "cancel"
+}
+
+# Job type parameters can be set as a JSON object, e.g. for a PermissionRepair job
+resource "powerscale_job" "permission_repair" {
+  type  = "PermissionRepair"
+  paths = ["/ifs/data/project"]
+  parameters = jsonencode({
+    prepair_params = {
+      mode     = "clone"
+      template = "/ifs/data/template"
+    }
+  })
+
+  # Do not wait for the job, and leave it running on destroy
+  wait_for_completion = false
+  delete_action       = "none"
+
+  timeouts {
+    create = "2h"
+  }
+}
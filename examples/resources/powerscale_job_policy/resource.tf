@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file will create the job impact policy on the PowerScale array with the attributes set in the config.
+# The name cannot be updated, changing it creates a new policy.
+
+resource "powerscale_job_policy" "example" {
+  # Required
+  name = "business_hours"
+  intervals = [
+    {
+      begin  = "Monday 08:00"
+      end    = "Friday 18:00"
+      impact = "Low"
+    },
+    {
+      begin  = "Friday 18:00"
+      end    = "Monday 08:00"
+      impact = "High"
+    },
+  ]
+
+  # Optional
+  description = "Low impact during business hours"
+}
+
+# The policy can be used by the jobs and job types
+resource "powerscale_job_type_settings" "smartpools" {
+  type   = "SmartPools"
+  policy = powerscale_job_policy.example.name
+}
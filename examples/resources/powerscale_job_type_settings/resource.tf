@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file will update the settings of the job type on the PowerScale array with the attributes set in the config.
+# The settings not set in the config are left unchanged.
+# Destroying the resource only removes it from the Terraform state, the job type keeps its settings.
+
+resource "powerscale_job_type_settings" "example" {
+  # Required
+  type = "FSAnalyze"
+
+  # Optional
+  enabled  = true
+  priority = 6
+  policy   = "LOW"
+  schedule = "every Saturday at 10:00 PM"
+}
@@ -43,6 +43,10 @@ resource "powerscale_ldap_provider" "example_ldap_provider" {
   balance_servers = true
   # Specifies the distinguished name for binding to the LDAP server.
   bind_dn = ""
+  # Specifies the password for the bind_dn, without storing it in the state. Requires Terraform 1.11 or later.
+  # bind_password_wo = "password"
+  # Change bind_password_wo_version to send the bind password again.
+  # bind_password_wo_version = 1
   # Specifies which bind mechanism to use when connecting to an LDAP server. The only supported option is the 'simple' value.
   bind_mechanism = "simple"
   # Specifies the timeout in seconds when binding to an LDAP server. Value should between 1 - 3600.
@@ -17,5 +17,7 @@
 # terraform import powerscale_networkpool.pool_test groupnet_name.subnet_name.pool_name
 # Example:
 terraform import powerscale_networkpool.pool_test groupnet0.subnet0.pool_test
+# Example, with the JSON form:
+terraform import powerscale_networkpool.pool_test '{"groupnet":"groupnet0","subnet":"subnet0","name":"pool_test"}'
 # after running this command, populate the name field and other required parameters in the config file to start managing this resource.
 # Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file will create the notification rule on the quota with the attributes set in the config.
+# The quota ID, threshold and condition cannot be updated, changing them creates a new notification rule.
+# A quota with custom notification rules no longer uses the default rules of powerscale_quota_settings.
+
+resource "powerscale_quota" "example" {
+  path              = "/ifs/example_quota"
+  type              = "directory"
+  include_snapshots = false
+  zone              = "System"
+  thresholds = {
+    hard = 10737418240
+  }
+}
+
+resource "powerscale_quota_notification" "example" {
+  # Required
+  quota_id  = powerscale_quota.example.id
+  threshold = "hard"
+  condition = "exceeded"
+
+  # Optional
+  action_alert         = true
+  action_email_owner   = true
+  action_email_address = "storage@example.com"
+  holdoff              = 600
+  schedule             = "every day at 9:00"
+  #  email_template = "/ifs/home/admin/quota_email_template.txt"
+}
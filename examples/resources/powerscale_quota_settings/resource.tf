@@ -0,0 +1,51 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file will update the quota settings on the PowerScale array with the sections set in the config.
+# Only the sections set in the config are managed. The default notification rules and mappings of the array not in the config are deleted.
+# Note that the quota settings are the native functionality of PowerScale, deleting the resource only removes it from the Terraform state.
+
+resource "powerscale_quota_settings" "example" {
+  # Optional
+  reports = {
+    schedule      = "every day at 2:00"
+    scheduled_dir = "/ifs/.isilon/smartquotas/reports"
+    manual_retain = 10
+  }
+
+  # Optional, the default notification rules of the quotas without custom notification rules
+  notifications = [
+    {
+      threshold          = "hard"
+      condition          = "exceeded"
+      action_email_owner = true
+    },
+    {
+      threshold = "soft"
+      condition = "violated"
+      schedule  = "every day at 9:00"
+    },
+  ]
+
+  # Optional, the email domains of the owners notified by email
+  mappings = [
+    {
+      domain  = "CORP.EXAMPLE.COM"
+      mapping = "example.com"
+    },
+  ]
+}
@@ -19,5 +19,7 @@
 terraform import powerscale_smb_share.share_example example_share
 # Example 2:
 terraform import powerscale_smb_share.share_example zone_id:example_share
+# Example 3, with the JSON form:
+terraform import powerscale_smb_share.share_example '{"zone":"zone_id","id":"example_share"}'
 # after running this command, populate the name field and other required parameters in the config file to start managing this resource.
 # Note: running "terraform show" after importing shows the current config/state of the resource. You can copy/paste that config to make it easier to manage the resource.
@@ -0,0 +1,25 @@
+# Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+# Licensed under the Mozilla Public License Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+
+#     http://mozilla.org/MPL/2.0/
+
+
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# The command is
+
+# The command is
+# terraform import powerscale_snapshot_restore.example <SnapRevert job ID>
+# Example:
+terraform import powerscale_snapshot_restore.example 42
+# Only succeeded snapshot reverts can be imported, copy and clone operations leave no job behind and are rejected.
+# The snaprevert domain is rebuilt from the path of the snapshot, the import fails when a later DomainMark job deleted it.
+# after running this command, populate the snaprevert_params field in the config file to start managing this resource.
+# Note: running "terraform show" after importing shows the current config/state of the resource.
@@ -21,6 +21,12 @@ resource "powerscale_snapshot_restore" "test" {
     allow_dup   = true
     snapshot_id = "snapshot_id"
   }
+
+  # Optional, time to wait for the snaprevert jobs before failing with their last observed state and progress
+  timeouts {
+    create = "2h"
+    delete = "30m"
+  }
 }
 
 # terraform destroy will delete the snaprevert domain if restore is done using snapshot revert.
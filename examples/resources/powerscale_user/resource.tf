@@ -38,6 +38,10 @@ resource "powerscale_user" "testUser" {
   # Optional parameters when creating and updating. 
   # uid      = 11000
   # password = "testPassword"
+  # Alternatively, with Terraform 1.11 or later, the password can be set without storing it in the state.
+  # Change password_wo_version to send the password again.
+  # password_wo         = "testPassword"
+  # password_wo_version = 1
   # roles    = ["SystemAdmin"]
   # enabled = false
   # unlock = false
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+
+# Available actions: Create, Update, Delete and Import.
+# After `terraform apply` of this example file will create the SmartLock domain on the PowerScale array, with its root directory when it does not exist.
+# The path and type cannot be updated, changing them creates a new domain.
+# Destroying the resource deletes the root directory of the domain, and is refused when the domain contains committed files.
+
+resource "powerscale_worm_domain" "example" {
+  # Required
+  path = "/ifs/archive"
+  type = "enterprise"
+
+  # Optional, the retention periods are ISO 8601 durations, or forever, use_min or use_max for the default retention
+  default_retention = "P1Y"
+  min_retention     = "P30D"
+  max_retention     = "P7Y"
+
+  # Optional, commits the files one hour after their last modification
+  autocommit_offset = 3600
+
+  # Optional, on, off or disabled. disabled is permanent.
+  privileged_delete = "off"
+
+  # Optional, protects all the committed files until this date, in seconds since the epoch
+  # override_date = 1893456000
+}
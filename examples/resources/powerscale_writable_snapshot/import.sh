@@ -14,7 +14,7 @@
 # limitations under the License.
 
 # The command is
-# terraform import powerscale_writable_snapshot.writablesnap <path of the Snapshot>
+# terraform import powerscale_writable_snapshot.writablesnap <dst_path of the writable snapshot>
 # Example:
 terraform import powerscale_writable_snapshot.writablesnap "/ifs/abcd"
 # after running this command, populate the name field and other required parameters in the config file to start managing this resource.
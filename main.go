@@ -21,7 +21,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/exporter"
 	"terraform-provider-powerscale/powerscale/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -50,6 +53,16 @@ var (
 )
 
 func main() {
+	// "export" writes the configuration of an existing cluster instead of serving the provider.
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		err := exporter.Run(context.Background(), os.Args[2:])
+		client.CloseSessions(context.Background())
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -62,6 +75,9 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Terraform stops the plugin once it is done with it, log out of the PAPI sessions opened meanwhile.
+	client.CloseSessions(context.Background())
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -492,4 +492,139 @@ const (
 
 	// ReadSyncIQReplicationJobErrorMessage specifies error details occurred while reading SyncIQ jobs.
 	ReadSyncIQReplicationJobErrorMessage = "Could not read SyncIQ jobs "
+
+	// APIRequestErrorMsg specifies error details occurred while sending a PAPI request.
+	APIRequestErrorMsg = "Could not send PAPI request "
+
+	// CreateAPIObjectErrorMsg specifies error details occurred while creating API object.
+	CreateAPIObjectErrorMsg = "Could not create API object "
+
+	// ReadAPIObjectErrorMsg specifies error details occurred while reading API object.
+	ReadAPIObjectErrorMsg = "Could not read API object "
+
+	// UpdateAPIObjectErrorMsg specifies error details occurred while updating API object.
+	UpdateAPIObjectErrorMsg = "Could not update API object "
+
+	// DeleteAPIObjectErrorMsg specifies error details occurred while deleting API object.
+	DeleteAPIObjectErrorMsg = "Could not delete API object "
+
+	// ReadOnefsVersionErrorMsg specifies error details occurred while reading the OneFS version.
+	ReadOnefsVersionErrorMsg = "Could not read the OneFS version "
+
+	// CreateJobErrorMsg specifies error details occurred while starting a job.
+	CreateJobErrorMsg = "Could not start job "
+
+	// ReadJobErrorMsg specifies error details occurred while reading a job.
+	ReadJobErrorMsg = "Could not read job "
+
+	// UpdateJobErrorMsg specifies error details occurred while updating a job.
+	UpdateJobErrorMsg = "Could not update job "
+
+	// DeleteJobErrorMsg specifies error details occurred while cancelling or pausing a job.
+	DeleteJobErrorMsg = "Could not stop job "
+
+	// CreateJobPolicyErrorMsg specifies error details occurred while creating a job impact policy.
+	CreateJobPolicyErrorMsg = "Could not create job impact policy "
+
+	// ReadJobPolicyErrorMsg specifies error details occurred while reading a job impact policy.
+	ReadJobPolicyErrorMsg = "Could not read job impact policy "
+
+	// UpdateJobPolicyErrorMsg specifies error details occurred while updating a job impact policy.
+	UpdateJobPolicyErrorMsg = "Could not update job impact policy "
+
+	// DeleteJobPolicyErrorMsg specifies error details occurred while deleting a job impact policy.
+	DeleteJobPolicyErrorMsg = "Could not delete job impact policy "
+
+	// ReadJobTypeErrorMsg specifies error details occurred while reading job types.
+	ReadJobTypeErrorMsg = "Could not read job types "
+
+	// UpdateJobTypeErrorMsg specifies error details occurred while updating job type settings.
+	UpdateJobTypeErrorMsg = "Could not update job type settings "
+
+	// ReadJobReportErrorMsg specifies error details occurred while reading job reports.
+	ReadJobReportErrorMsg = "Could not read job reports "
+
+	// CreateWormDomainErrorMsg specifies error details occurred while creating a SmartLock domain.
+	CreateWormDomainErrorMsg = "Could not create SmartLock domain "
+
+	// ReadWormDomainErrorMsg specifies error details occurred while reading SmartLock domains.
+	ReadWormDomainErrorMsg = "Could not read SmartLock domains "
+
+	// UpdateWormDomainErrorMsg specifies error details occurred while updating a SmartLock domain.
+	UpdateWormDomainErrorMsg = "Could not update SmartLock domain "
+
+	// DeleteWormDomainErrorMsg specifies error details occurred while deleting a SmartLock domain.
+	DeleteWormDomainErrorMsg = "Could not delete SmartLock domain "
+
+	// ReadWormSettingsErrorMsg specifies error details occurred while reading the SmartLock settings.
+	ReadWormSettingsErrorMsg = "Could not read SmartLock settings "
+
+	// ReadAuditGlobalSettingsErrorMsg specifies error details occurred while reading the audit global settings.
+	ReadAuditGlobalSettingsErrorMsg = "Could not read audit global settings "
+
+	// UpdateAuditGlobalSettingsErrorMsg specifies error details occurred while updating the audit global settings.
+	UpdateAuditGlobalSettingsErrorMsg = "Could not update audit global settings "
+
+	// ReadAuditZoneSettingsErrorMsg specifies error details occurred while reading the audit settings of an access zone.
+	ReadAuditZoneSettingsErrorMsg = "Could not read audit zone settings "
+
+	// UpdateAuditZoneSettingsErrorMsg specifies error details occurred while updating the audit settings of an access zone.
+	UpdateAuditZoneSettingsErrorMsg = "Could not update audit zone settings "
+
+	// ReadAuditTopicErrorMsg specifies error details occurred while reading the audit topics.
+	ReadAuditTopicErrorMsg = "Could not read audit topics "
+
+	// CreateEventChannelErrorMsg specifies error details occurred while creating an event channel.
+	CreateEventChannelErrorMsg = "Could not create event channel "
+
+	// ReadEventChannelErrorMsg specifies error details occurred while reading an event channel.
+	ReadEventChannelErrorMsg = "Could not read event channel "
+
+	// UpdateEventChannelErrorMsg specifies error details occurred while updating an event channel.
+	UpdateEventChannelErrorMsg = "Could not update event channel "
+
+	// DeleteEventChannelErrorMsg specifies error details occurred while deleting an event channel.
+	DeleteEventChannelErrorMsg = "Could not delete event channel "
+
+	// CreateEventAlertConditionErrorMsg specifies error details occurred while creating an alert condition.
+	CreateEventAlertConditionErrorMsg = "Could not create alert condition "
+
+	// ReadEventAlertConditionErrorMsg specifies error details occurred while reading an alert condition.
+	ReadEventAlertConditionErrorMsg = "Could not read alert condition "
+
+	// UpdateEventAlertConditionErrorMsg specifies error details occurred while updating an alert condition.
+	UpdateEventAlertConditionErrorMsg = "Could not update alert condition "
+
+	// DeleteEventAlertConditionErrorMsg specifies error details occurred while deleting an alert condition.
+	DeleteEventAlertConditionErrorMsg = "Could not delete alert condition "
+
+	// ReadEventGroupErrorMsg specifies error details occurred while reading the event group occurrences.
+	ReadEventGroupErrorMsg = "Could not read event groups "
+
+	// CreateQuotaNotificationErrorMsg specifies error details occurred while creating a quota notification rule.
+	CreateQuotaNotificationErrorMsg = "Could not create quota notification rule "
+
+	// ReadQuotaNotificationErrorMsg specifies error details occurred while reading a quota notification rule.
+	ReadQuotaNotificationErrorMsg = "Could not read quota notification rule "
+
+	// UpdateQuotaNotificationErrorMsg specifies error details occurred while updating a quota notification rule.
+	UpdateQuotaNotificationErrorMsg = "Could not update quota notification rule "
+
+	// DeleteQuotaNotificationErrorMsg specifies error details occurred while deleting a quota notification rule.
+	DeleteQuotaNotificationErrorMsg = "Could not delete quota notification rule "
+
+	// ReadQuotaSettingsErrorMsg specifies error details occurred while reading the quota settings.
+	ReadQuotaSettingsErrorMsg = "Could not read quota settings "
+
+	// UpdateQuotaSettingsErrorMsg specifies error details occurred while updating the quota settings.
+	UpdateQuotaSettingsErrorMsg = "Could not update quota settings "
+
+	// CreateQuotaReportErrorMsg specifies error details occurred while generating a quota report.
+	CreateQuotaReportErrorMsg = "Could not generate quota report "
+
+	// ReadQuotaReportErrorMsg specifies error details occurred while reading the quota reports.
+	ReadQuotaReportErrorMsg = "Could not read quota reports "
+
+	// DeleteQuotaReportErrorMsg specifies error details occurred while deleting a quota report.
+	DeleteQuotaReportErrorMsg = "Could not delete quota report "
 )
@@ -0,0 +1,327 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package exporter writes the Terraform configuration of the objects of a live cluster,
+// with the import blocks to bring them under management.
+//
+// The objects are imported and read through the resources of the provider, so the
+// configuration holds the same values as the state after a terraform import.
+package exporter
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/provider"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// providerTypeName is the prefix of the resource types of the provider.
+const providerTypeName = "powerscale"
+
+// invalidLabel matches the characters not allowed in the label of a resource block.
+var invalidLabel = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// Options are the options of an export.
+type Options struct {
+	// OutDir is the directory the .tf files are written to, one per resource type.
+	OutDir string
+	// Types are the resource types to export, e.g. smb_share. All the types are exported by default.
+	Types []string
+	// Zones are the access zones to export. All the access zones are exported by default.
+	Zones []string
+	// ConfigFile and Profile select the credentials like the provider attributes of the same name.
+	// The POWERSCALE_* environment variables are used otherwise.
+	ConfigFile string
+	Profile    string
+}
+
+// Run runs the export subcommand with its command line arguments.
+func Run(ctx context.Context, args []string) error {
+	var options Options
+	var exportedTypes, zones string
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.StringVar(&options.OutDir, "out", ".", "directory to write the .tf files to")
+	flags.StringVar(&exportedTypes, "types", "", "comma-separated resource types to export, all by default: "+strings.Join(TypeNames(), ","))
+	flags.StringVar(&zones, "zones", "", "comma-separated access zones to export, all by default")
+	flags.StringVar(&options.ConfigFile, "config-file", "", "configuration file holding the credentials profiles, as the config_file provider attribute")
+	flags.StringVar(&options.Profile, "profile", "", "credentials profile to use, as the profile provider attribute")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s export [options]\n\n", filepath.Base(os.Args[0]))
+		fmt.Fprintln(flags.Output(), "Writes the configuration and the import blocks of the objects of a PowerScale cluster.")
+		fmt.Fprintln(flags.Output(), "The cluster is configured like the provider, with the POWERSCALE_* environment variables or a profile.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+	options.Types = splitList(exportedTypes)
+	options.Zones = splitList(zones)
+	return Export(ctx, options)
+}
+
+// Export writes the configuration of the objects of the cluster.
+// Objects that cannot be imported are logged and left out.
+func Export(ctx context.Context, options Options) error {
+	selected, err := selectTypes(options.Types)
+	if err != nil {
+		return err
+	}
+	pscaleClient, err := newClient(ctx, options)
+	if err != nil {
+		return err
+	}
+	zones, err := selectZones(ctx, pscaleClient, options.Zones)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(options.OutDir, 0o755); err != nil {
+		return err
+	}
+	for _, exportType := range selected {
+		content, err := exportObjects(ctx, pscaleClient, exportType, zones)
+		if err != nil {
+			return err
+		}
+		fileName := filepath.Join(options.OutDir, exportType.name+".tf")
+		if err := os.WriteFile(fileName, content, 0o644); err != nil {
+			return err
+		}
+		log.Printf("Wrote %s", fileName)
+	}
+	return nil
+}
+
+// splitList splits a comma-separated list, ignoring empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// selectTypes returns the types to export, in the order of exportTypes.
+func selectTypes(names []string) ([]exportType, error) {
+	if len(names) == 0 {
+		return exportTypes, nil
+	}
+	var selected []exportType
+	for _, name := range names {
+		name = strings.TrimPrefix(name, providerTypeName+"_")
+		if !slices.Contains(TypeNames(), name) {
+			return nil, fmt.Errorf("unknown resource type %s, expected one of %s", name, strings.Join(TypeNames(), ", "))
+		}
+	}
+	for _, exportType := range exportTypes {
+		if slices.ContainsFunc(names, func(name string) bool { return strings.TrimPrefix(name, providerTypeName+"_") == exportType.name }) {
+			selected = append(selected, exportType)
+		}
+	}
+	return selected, nil
+}
+
+// newClient configures the provider as in a Terraform run, so that the credentials are read from the same sources.
+func newClient(ctx context.Context, options Options) (*client.Client, error) {
+	pscaleProvider := provider.New("export")()
+	var schemaResp tfprovider.SchemaResponse
+	pscaleProvider.Schema(ctx, tfprovider.SchemaRequest{}, &schemaResp)
+
+	config := nullObject(ctx, schemaResp.Schema.Type().TerraformType(ctx))
+	fields := map[string]tftypes.Value{}
+	if err := config.As(&fields); err != nil {
+		return nil, err
+	}
+	if options.ConfigFile != "" {
+		fields["config_file"] = tftypes.NewValue(tftypes.String, options.ConfigFile)
+	}
+	if options.Profile != "" {
+		fields["profile"] = tftypes.NewValue(tftypes.String, options.Profile)
+	}
+
+	var configureResp tfprovider.ConfigureResponse
+	pscaleProvider.Configure(ctx, tfprovider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(config.Type(), fields)},
+	}, &configureResp)
+	if err := diagnosticsError(configureResp.Diagnostics); err != nil {
+		return nil, err
+	}
+	pscaleClient, ok := configureResp.ResourceData.(*client.Client)
+	if !ok {
+		return nil, errors.New("the provider did not configure a client")
+	}
+	return pscaleClient, nil
+}
+
+// selectZones returns the access zones to export, sorted by name.
+func selectZones(ctx context.Context, pscaleClient *client.Client, names []string) ([]zone, error) {
+	result, err := helper.GetAllAccessZones(ctx, pscaleClient)
+	if err != nil {
+		return nil, fmt.Errorf("access zones: %s", helper.GetErrorString(err, ""))
+	}
+	var zones []zone
+	for _, accessZone := range result.Zones {
+		if len(names) == 0 || slices.Contains(names, accessZone.GetName()) {
+			zones = append(zones, zone{name: accessZone.GetName(), path: accessZone.GetPath()})
+		}
+	}
+	for _, name := range names {
+		if !slices.ContainsFunc(zones, func(z zone) bool { return z.name == name }) {
+			return nil, fmt.Errorf("access zone %s not found", name)
+		}
+	}
+	sort.Slice(zones, func(i, j int) bool { return zones[i].name < zones[j].name })
+	return zones, nil
+}
+
+// exportObjects returns the content of the file of a resource type.
+// Objects are sorted by label, so that the same cluster always gives the same file.
+func exportObjects(ctx context.Context, pscaleClient *client.Client, exportType exportType, zones []zone) ([]byte, error) {
+	typeName := providerTypeName + "_" + exportType.name
+	objects, err := exportType.list(ctx, pscaleClient, zones)
+	if err != nil {
+		return nil, err
+	}
+	labels := labelObjects(objects)
+	sort.SliceStable(objects, func(i, j int) bool { return labels[objects[i].id] < labels[objects[j].id] })
+
+	file := hclwrite.NewEmptyFile()
+	for _, object := range objects {
+		resourceSchema, state, err := importObject(ctx, pscaleClient, exportType.resource(), object.id)
+		if err != nil {
+			log.Printf("Skipping %s %s: %s", typeName, object.id, err.Error())
+			continue
+		}
+		if err := writeImport(file.Body(), typeName, labels[object.id], object.id, resourceSchema, state); err != nil {
+			log.Printf("Skipping %s %s: %s", typeName, object.id, err.Error())
+		}
+	}
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// labelObjects returns the labels of the resource blocks of the objects, by import ID.
+// Labels are made of the lower case letters, digits and underscores of the names; duplicates are numbered.
+func labelObjects(objects []object) map[string]string {
+	sorted := slices.Clone(objects)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].id < sorted[j].id })
+	labels := map[string]string{}
+	used := map[string]bool{}
+	for _, object := range sorted {
+		base := strings.Trim(invalidLabel.ReplaceAllString(strings.ToLower(object.name), "_"), "_")
+		if base == "" || (base[0] >= '0' && base[0] <= '9') {
+			base = "r_" + base
+		}
+		label := base
+		for i := 2; used[label]; i++ {
+			label = fmt.Sprintf("%s_%d", base, i)
+		}
+		used[label] = true
+		labels[object.id] = label
+	}
+	return labels
+}
+
+// importObject imports an object and reads it, as terraform import does, and returns its state.
+func importObject(ctx context.Context, pscaleClient *client.Client, r resource.Resource, id string) (schema.Schema, tftypes.Value, error) {
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		var configureResp resource.ConfigureResponse
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: pscaleClient}, &configureResp)
+		if err := diagnosticsError(configureResp.Diagnostics); err != nil {
+			return schema.Schema{}, tftypes.Value{}, err
+		}
+	}
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	importable, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		return schema.Schema{}, tftypes.Value{}, errors.New("the resource does not support import")
+	}
+	// resources with an identity set it on import and read
+	var identity *tfsdk.ResourceIdentity
+	if identifiable, ok := r.(resource.ResourceWithIdentity); ok {
+		var identityResp resource.IdentitySchemaResponse
+		identifiable.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+		identity = &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), nil),
+		}
+	}
+
+	importResp := resource.ImportStateResponse{
+		State:    tfsdk.State{Schema: schemaResp.Schema, Raw: nullObject(ctx, schemaResp.Schema.Type().TerraformType(ctx))},
+		Identity: identity,
+	}
+	importable.ImportState(ctx, resource.ImportStateRequest{ID: id}, &importResp)
+	if err := diagnosticsError(importResp.Diagnostics); err != nil {
+		return schema.Schema{}, tftypes.Value{}, err
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State, Identity: importResp.Identity}, &readResp)
+	if err := diagnosticsError(readResp.Diagnostics); err != nil {
+		return schema.Schema{}, tftypes.Value{}, err
+	}
+	if readResp.State.Raw.IsNull() {
+		return schema.Schema{}, tftypes.Value{}, errors.New("the object was not found")
+	}
+	return schemaResp.Schema, readResp.State.Raw, nil
+}
+
+// nullObject returns an object of the given type with null attributes.
+func nullObject(ctx context.Context, objectType tftypes.Type) tftypes.Value {
+	attributeTypes := objectType.(tftypes.Object).AttributeTypes
+	fields := make(map[string]tftypes.Value, len(attributeTypes))
+	for name, attributeType := range attributeTypes {
+		fields[name] = tftypes.NewValue(attributeType, nil)
+	}
+	return tftypes.NewValue(objectType, fields)
+}
+
+// diagnosticsError returns the error diagnostics as an error, or nil.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, diagnostic := range diags.Errors() {
+		messages = append(messages, strings.TrimSpace(diagnostic.Summary()+": "+diagnostic.Detail()))
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(messages, "; "))
+}
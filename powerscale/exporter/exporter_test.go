@@ -0,0 +1,175 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"terraform-provider-powerscale/powerscale/simulator"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteImport(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          schema.StringAttribute{Computed: true},
+			"name":        schema.StringAttribute{Required: true},
+			"description": schema.StringAttribute{Optional: true},
+			"password":    schema.StringAttribute{Optional: true, Sensitive: true},
+			"size":        schema.Int64Attribute{Optional: true, Computed: true},
+			"hosts":       schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			"permissions": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"trustee": schema.StringAttribute{Required: true},
+					"sid":     schema.StringAttribute{Computed: true},
+				}},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rules": schema.SetNestedBlock{NestedObject: schema.NestedBlockObject{Attributes: map[string]schema.Attribute{
+				"path": schema.StringAttribute{Required: true},
+			}}},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	permissionType := objectType.AttributeTypes["permissions"].(tftypes.List).ElementType
+	ruleType := objectType.AttributeTypes["rules"].(tftypes.Set).ElementType
+	state := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, "share1"),
+		"name":        tftypes.NewValue(tftypes.String, "share ${1}"),
+		"description": tftypes.NewValue(tftypes.String, nil),
+		"password":    tftypes.NewValue(tftypes.String, "secret"),
+		"size":        tftypes.NewValue(tftypes.Number, 1024),
+		"hosts": tftypes.NewValue(objectType.AttributeTypes["hosts"], []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "b"),
+			tftypes.NewValue(tftypes.String, "a"),
+		}),
+		"permissions": tftypes.NewValue(objectType.AttributeTypes["permissions"], []tftypes.Value{
+			tftypes.NewValue(permissionType, map[string]tftypes.Value{
+				"trustee": tftypes.NewValue(tftypes.String, "Everyone"),
+				"sid":     tftypes.NewValue(tftypes.String, "SID:S-1-1-0"),
+			}),
+		}),
+		"rules": tftypes.NewValue(objectType.AttributeTypes["rules"], []tftypes.Value{
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{"path": tftypes.NewValue(tftypes.String, "/ifs/z")}),
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{"path": tftypes.NewValue(tftypes.String, "/ifs/a")}),
+		}),
+	})
+
+	file := hclwrite.NewEmptyFile()
+	assert.Nil(t, writeImport(file.Body(), "powerscale_test", "share1", "System:share1", testSchema, state))
+	assert.Equal(t, `import {
+  to = powerscale_test.share1
+  id = "System:share1"
+}
+
+resource "powerscale_test" "share1" {
+  hosts = ["a", "b"]
+  name  = "share $${1}"
+  permissions = [{
+    trustee = "Everyone"
+  }]
+  size = 1024
+  rules {
+    path = "/ifs/a"
+  }
+  rules {
+    path = "/ifs/z"
+  }
+}
+
+`, string(hclwrite.Format(file.Bytes())))
+}
+
+func TestLabelObjects(t *testing.T) {
+	labels := labelObjects([]object{
+		{id: "System:Share-1", name: "Share-1"},
+		{id: "tfacc:share_1", name: "share_1"},
+		{id: "42", name: "42"},
+		{id: "---", name: "---"},
+	})
+	assert.Equal(t, map[string]string{
+		"System:Share-1": "share_1",
+		"tfacc:share_1":  "share_1_2",
+		"42":             "r_42",
+		"---":            "r_",
+	}, labels)
+}
+
+func TestSelectTypes(t *testing.T) {
+	selected, err := selectTypes([]string{"powerscale_user", "accesszone"})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(selected))
+	assert.Equal(t, "accesszone", selected[0].name)
+	assert.Equal(t, "user", selected[1].name)
+
+	_, err = selectTypes([]string{"unknown"})
+	assert.ErrorContains(t, err, "unknown resource type unknown")
+}
+
+func TestExport(t *testing.T) {
+	papiSimulator := simulator.New()
+	defer papiSimulator.Close()
+	t.Setenv("POWERSCALE_ENDPOINT", papiSimulator.Endpoint())
+	t.Setenv("POWERSCALE_USERNAME", simulator.DefaultUsername)
+	t.Setenv("POWERSCALE_PASSWORD", simulator.DefaultPassword)
+	t.Setenv("POWERSCALE_INSECURE", "true")
+	t.Setenv("POWERSCALE_AUTH_TYPE", "1")
+	assert.Nil(t, papiSimulator.Seed("zones", "", map[string]interface{}{"name": "tfacc", "path": "/ifs/tfacc"}))
+	assert.Nil(t, papiSimulator.Seed("protocols/smb/shares", "tfacc",
+		map[string]interface{}{"name": "tfacc_share", "path": "/ifs/tfacc/share"},
+		map[string]interface{}{"name": "Tfacc-Share", "path": "/ifs/tfacc/other"},
+	))
+
+	ctx := context.Background()
+	options := Options{OutDir: t.TempDir(), Types: []string{"accesszone", "smb_share"}, Zones: []string{"tfacc"}}
+	assert.Nil(t, Export(ctx, options))
+	zones, err := os.ReadFile(filepath.Join(options.OutDir, "accesszone.tf"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(zones), "to = powerscale_accesszone.tfacc")
+	assert.NotContains(t, string(zones), "powerscale_accesszone.system")
+	shares, err := os.ReadFile(filepath.Join(options.OutDir, "smb_share.tf"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(shares), `id = "tfacc:tfacc_share"`)
+	assert.Contains(t, string(shares), `resource "powerscale_smb_share" "tfacc_tfacc_share_2"`)
+	assert.Contains(t, string(shares), `path = "/ifs/tfacc/share"`)
+
+	// the same cluster gives the same files
+	assert.Nil(t, Export(ctx, options))
+	again, err := os.ReadFile(filepath.Join(options.OutDir, "smb_share.tf"))
+	assert.Nil(t, err)
+	assert.Equal(t, string(shares), string(again))
+
+	err = Export(ctx, Options{OutDir: t.TempDir(), Zones: []string{"unknown"}})
+	assert.ErrorContains(t, err, "access zone unknown not found")
+}
+
+func TestRunFlags(t *testing.T) {
+	err := Run(context.Background(), []string{"-types", "unknown"})
+	assert.ErrorContains(t, err, "unknown resource type")
+	assert.NotNil(t, Run(context.Background(), []string{"-invalid"}))
+}
@@ -0,0 +1,265 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// configurable returns whether an attribute is written to the configuration.
+// Read-only attributes are left out, and so are secrets, which must not end up in generated files.
+func configurable(attribute schema.Attribute) bool {
+	return (attribute.IsRequired() || attribute.IsOptional()) &&
+		!attribute.IsSensitive() && !attribute.IsWriteOnly() && attribute.GetDeprecationMessage() == ""
+}
+
+// writeImport appends the import block and the resource block of an object to body.
+func writeImport(body *hclwrite.Body, typeName, label, id string, resourceSchema schema.Schema, state tftypes.Value) error {
+	importBlock := body.AppendNewBlock("import", nil).Body()
+	importBlock.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: typeName}, hcl.TraverseAttr{Name: label}})
+	importBlock.SetAttributeValue("id", cty.StringVal(id))
+	body.AppendNewline()
+
+	resourceBlock := body.AppendNewBlock("resource", []string{typeName, label}).Body()
+	if err := writeBody(resourceBlock, resourceSchema.Attributes, resourceSchema.Blocks, state); err != nil {
+		return fmt.Errorf("%s.%s: %w", typeName, label, err)
+	}
+	body.AppendNewline()
+	return nil
+}
+
+// writeBody writes the configurable attributes and the blocks of an object to body, in alphabetical order.
+func writeBody(body *hclwrite.Body, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value) error {
+	fields := map[string]tftypes.Value{}
+	if err := value.As(&fields); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(attributes) {
+		field, ok := fields[name]
+		if !ok || !configurable(attributes[name]) || field.IsNull() || !field.IsFullyKnown() {
+			continue
+		}
+		converted, err := attributeValue(attributes[name], field)
+		if err != nil {
+			return fmt.Errorf("attribute %s: %w", name, err)
+		}
+		body.SetAttributeValue(name, converted)
+	}
+	for _, name := range sortedKeys(blocks) {
+		field, ok := fields[name]
+		if !ok || field.IsNull() || !field.IsFullyKnown() {
+			continue
+		}
+		if err := writeBlocks(body, name, blocks[name], field); err != nil {
+			return fmt.Errorf("block %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// writeBlocks appends the blocks holding the value of a block attribute to body.
+func writeBlocks(body *hclwrite.Body, name string, block schema.Block, value tftypes.Value) error {
+	switch block := block.(type) {
+	case schema.SingleNestedBlock:
+		return writeBody(body.AppendNewBlock(name, nil).Body(), block.Attributes, block.Blocks, value)
+	case schema.ListNestedBlock:
+		return writeNestedBlocks(body, name, block.NestedObject, value, false)
+	case schema.SetNestedBlock:
+		return writeNestedBlocks(body, name, block.NestedObject, value, true)
+	default:
+		return fmt.Errorf("unsupported block type %T", block)
+	}
+}
+
+// writeNestedBlocks appends one block per element of a list or set of blocks to body.
+// The blocks of a set are sorted by their content, as sets have no order.
+func writeNestedBlocks(body *hclwrite.Body, name string, object schema.NestedBlockObject, value tftypes.Value, set bool) error {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return err
+	}
+	nested := make([]*hclwrite.Block, 0, len(elements))
+	for _, element := range elements {
+		block := hclwrite.NewBlock(name, nil)
+		if err := writeBody(block.Body(), object.Attributes, object.Blocks, element); err != nil {
+			return err
+		}
+		nested = append(nested, block)
+	}
+	if set {
+		sort.SliceStable(nested, func(i, j int) bool {
+			return bytes.Compare(nested[i].BuildTokens(nil).Bytes(), nested[j].BuildTokens(nil).Bytes()) < 0
+		})
+	}
+	for _, block := range nested {
+		body.AppendBlock(block)
+	}
+	return nil
+}
+
+// attributeValue converts the value of an attribute, leaving out the read-only attributes of nested attributes.
+func attributeValue(attribute schema.Attribute, value tftypes.Value) (cty.Value, error) {
+	switch attribute := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return nestedValue(attribute.Attributes, value)
+	case schema.ListNestedAttribute:
+		return nestedValues(attribute.NestedObject.Attributes, value, false)
+	case schema.SetNestedAttribute:
+		return nestedValues(attribute.NestedObject.Attributes, value, true)
+	case schema.MapNestedAttribute:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := map[string]cty.Value{}
+		for key, element := range elements {
+			object, err := nestedValue(attribute.NestedObject.Attributes, element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[key] = object
+		}
+		return cty.ObjectVal(converted), nil
+	default:
+		return ctyValue(value)
+	}
+}
+
+// nestedValue converts an object of nested attributes.
+func nestedValue(attributes map[string]schema.Attribute, value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+	fields := map[string]tftypes.Value{}
+	if err := value.As(&fields); err != nil {
+		return cty.NilVal, err
+	}
+	converted := map[string]cty.Value{}
+	for name, field := range fields {
+		if attribute, ok := attributes[name]; !ok || !configurable(attribute) || field.IsNull() || !field.IsFullyKnown() {
+			continue
+		}
+		fieldValue, err := attributeValue(attributes[name], field)
+		if err != nil {
+			return cty.NilVal, fmt.Errorf("attribute %s: %w", name, err)
+		}
+		converted[name] = fieldValue
+	}
+	return cty.ObjectVal(converted), nil
+}
+
+// nestedValues converts a list or set of objects of nested attributes.
+func nestedValues(attributes map[string]schema.Attribute, value tftypes.Value, set bool) (cty.Value, error) {
+	var elements []tftypes.Value
+	if err := value.As(&elements); err != nil {
+		return cty.NilVal, err
+	}
+	converted := make([]cty.Value, 0, len(elements))
+	for _, element := range elements {
+		object, err := nestedValue(attributes, element)
+		if err != nil {
+			return cty.NilVal, err
+		}
+		converted = append(converted, object)
+	}
+	return tuple(converted, set), nil
+}
+
+// ctyValue converts a value of any type.
+func ctyValue(value tftypes.Value) (cty.Value, error) {
+	if value.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+	switch valueType := value.Type(); {
+	case valueType.Is(tftypes.String):
+		var converted string
+		err := value.As(&converted)
+		return cty.StringVal(converted), err
+	case valueType.Is(tftypes.Number):
+		converted := new(big.Float)
+		err := value.As(&converted)
+		return cty.NumberVal(converted), err
+	case valueType.Is(tftypes.Bool):
+		var converted bool
+		err := value.As(&converted)
+		return cty.BoolVal(converted), err
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := make([]cty.Value, 0, len(elements))
+		for _, element := range elements {
+			convertedElement, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted = append(converted, convertedElement)
+		}
+		return tuple(converted, valueType.Is(tftypes.Set{})), nil
+	case valueType.Is(tftypes.Map{}), valueType.Is(tftypes.Object{}):
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return cty.NilVal, err
+		}
+		converted := map[string]cty.Value{}
+		for key, element := range elements {
+			convertedElement, err := ctyValue(element)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			converted[key] = convertedElement
+		}
+		return cty.ObjectVal(converted), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported type %s", valueType)
+	}
+}
+
+// tuple returns the elements as a tuple, written as a list.
+// The elements of a set are sorted by their text, as sets have no order.
+func tuple(elements []cty.Value, set bool) cty.Value {
+	if len(elements) == 0 {
+		return cty.EmptyTupleVal
+	}
+	if set {
+		sort.SliceStable(elements, func(i, j int) bool {
+			return bytes.Compare(hclwrite.TokensForValue(elements[i]).Bytes(), hclwrite.TokensForValue(elements[j]).Bytes()) < 0
+		})
+	}
+	return cty.TupleVal(elements)
+}
+
+// sortedKeys returns the keys of a map in alphabetical order.
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
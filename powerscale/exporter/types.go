@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"terraform-provider-powerscale/powerscale/provider"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zone is an access zone selected for the export.
+type zone struct {
+	name string
+	path string
+}
+
+// object is an object of the cluster to import.
+type object struct {
+	// id is the import ID of the object.
+	id string
+	// name is turned into the label of the resource block.
+	name string
+}
+
+// exportType is a resource type that can be exported.
+type exportType struct {
+	// name is the resource type without the provider prefix.
+	name     string
+	resource func() resource.Resource
+	// list returns the objects of the selected access zones.
+	list func(ctx context.Context, client *client.Client, zones []zone) ([]object, error)
+}
+
+// exportTypes are the resource types that can be exported, in the order they are exported.
+var exportTypes = []exportType{
+	{name: "accesszone", resource: provider.NewAccessZoneResource, list: listAccessZones},
+	{name: "smb_share", resource: provider.NewSmbShareResource, list: listSmbShares},
+	{name: "nfs_export", resource: provider.NewNfsExportResource, list: listNfsExports},
+	{name: "quota", resource: provider.NewQuotaResource, list: listQuotas},
+	{name: "snapshot_schedule", resource: provider.NewSnapshotScheduleResource, list: listSnapshotSchedules},
+	{name: "filepool_policy", resource: provider.NewFilePoolPolicyResource, list: listFilePoolPolicies},
+	{name: "synciq_policy", resource: provider.NewSynciqPolicyResource, list: listSynciqPolicies},
+	{name: "user", resource: provider.NewUserResource, list: listUsers},
+	{name: "user_group", resource: provider.NewUserGroupResource, list: listUserGroups},
+}
+
+// TypeNames returns the names of the resource types that can be exported.
+func TypeNames() []string {
+	names := make([]string, 0, len(exportTypes))
+	for _, exportType := range exportTypes {
+		names = append(names, exportType.name)
+	}
+	return names
+}
+
+// inZones returns whether a path is in the base path of one of the zones.
+func inZones(path string, zones []zone) bool {
+	for _, z := range zones {
+		base := strings.TrimSuffix(z.path, "/")
+		if path == base || strings.HasPrefix(path, base+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// zonedName returns the name of an object of a zone, prefixed by the zone outside of the System zone.
+func zonedName(zoneName, name string) string {
+	if zoneName == "System" {
+		return name
+	}
+	return zoneName + "_" + name
+}
+
+func listAccessZones(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	objects := make([]object, 0, len(zones))
+	for _, z := range zones {
+		objects = append(objects, object{id: z.name, name: z.name})
+	}
+	return objects, nil
+}
+
+func listSmbShares(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	var objects []object
+	for _, z := range zones {
+		shares, err := helper.ListSmbShares(ctx, client, &models.SmbShareDatasourceFilter{Zone: types.StringValue(z.name)})
+		if err != nil {
+			return nil, fmt.Errorf("smb shares of zone %s: %s", z.name, helper.GetErrorString(err, ""))
+		}
+		for _, share := range *shares {
+			objects = append(objects, object{id: z.name + ":" + share.GetId(), name: zonedName(z.name, share.GetName())})
+		}
+	}
+	return objects, nil
+}
+
+func listNfsExports(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	var objects []object
+	for _, z := range zones {
+		exports, err := helper.ListNFSExports(ctx, client, &models.NfsExportDatasourceFilter{Zone: types.StringValue(z.name)})
+		if err != nil {
+			return nil, fmt.Errorf("nfs exports of zone %s: %s", z.name, helper.GetErrorString(err, ""))
+		}
+		for _, export := range *exports {
+			name := fmt.Sprintf("export_%d", export.GetId())
+			if paths := export.GetPaths(); len(paths) > 0 {
+				name = strings.TrimPrefix(paths[0], "/ifs/")
+			}
+			objects = append(objects, object{id: fmt.Sprintf("%s:%d", z.name, export.GetId()), name: zonedName(z.name, name)})
+		}
+	}
+	return objects, nil
+}
+
+func listQuotas(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	quotas, err := helper.ListQuotas(ctx, client, nil)
+	if err != nil {
+		return nil, fmt.Errorf("quotas: %s", helper.GetErrorString(err, ""))
+	}
+	var objects []object
+	for _, quota := range quotas {
+		if inZones(quota.GetPath(), zones) {
+			objects = append(objects, object{id: quota.GetId(), name: quota.GetType() + "_" + strings.TrimPrefix(quota.GetPath(), "/ifs/")})
+		}
+	}
+	return objects, nil
+}
+
+func listSnapshotSchedules(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	schedules, err := helper.ListSnapshotSchedules(ctx, client, nil)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot schedules: %s", helper.GetErrorString(err, ""))
+	}
+	var objects []object
+	for _, schedule := range schedules {
+		if inZones(schedule.GetPath(), zones) {
+			objects = append(objects, object{id: fmt.Sprint(schedule.GetId()), name: schedule.GetName()})
+		}
+	}
+	return objects, nil
+}
+
+// listFilePoolPolicies returns the file pool policies, which are not bound to a zone, except the default policy.
+func listFilePoolPolicies(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	policies, err := helper.GetAllFilePoolPolicies(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("file pool policies: %s", err.Error())
+	}
+	objects := make([]object, 0, len(policies))
+	for _, policy := range policies {
+		objects = append(objects, object{id: policy.GetName(), name: policy.GetName()})
+	}
+	return objects, nil
+}
+
+func listSynciqPolicies(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	policies, err := helper.GetAllSyncIQPolicies(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("SyncIQ policies: %s", helper.GetErrorString(err, ""))
+	}
+	var objects []object
+	for _, policy := range policies.Policies {
+		if inZones(policy.GetSourceRootPath(), zones) {
+			objects = append(objects, object{id: policy.GetName(), name: policy.GetName()})
+		}
+	}
+	return objects, nil
+}
+
+// listUsers returns the users of the local provider of the zones, which the user resource manages.
+func listUsers(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	var objects []object
+	for _, z := range zones {
+		users, err := helper.GetUsersWithFilter(ctx, client, &models.UserFilterType{
+			Zone:     types.StringValue(z.name),
+			Provider: types.StringValue("lsa-local-provider:" + z.name),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("users of zone %s: %s", z.name, err.Error())
+		}
+		for _, user := range users {
+			objects = append(objects, object{id: z.name + ":" + user.GetName(), name: zonedName(z.name, user.GetName())})
+		}
+	}
+	return objects, nil
+}
+
+// listUserGroups returns the groups of the local provider of the zones, which the user group resource manages.
+func listUserGroups(ctx context.Context, client *client.Client, zones []zone) ([]object, error) {
+	var objects []object
+	for _, z := range zones {
+		groups, err := helper.GetUserGroupsWithFilter(ctx, client, &models.UserGroupFilterType{
+			Zone:     types.StringValue(z.name),
+			Provider: types.StringValue("lsa-local-provider:" + z.name),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("user groups of zone %s: %s", z.name, err.Error())
+		}
+		for _, group := range groups {
+			objects = append(objects, object{id: z.name + ":" + group.GetName(), name: zonedName(z.name, group.GetName())})
+		}
+	}
+	return objects, nil
+}
@@ -92,9 +92,19 @@ func AuthAccessKeyObjectMapper(access powerscale.V1AuthAccessAccessItemFileGroup
 }
 
 // GetAllAccessZones returns the full list of access zones.
-func GetAllAccessZones(ctx context.Context, client *client.Client) (*powerscale.V3Zones, error) {
-	result, _, err := client.PscaleOpenAPIClient.ZonesApi.ListZonesv3Zones(ctx).Execute()
-	return result, err
+// The zones are listed once for all the resources of a run, until the client changes the cluster.
+func GetAllAccessZones(ctx context.Context, powerscaleClient *client.Client) (*powerscale.V3Zones, error) {
+	result, err := client.Cached(ctx, powerscaleClient, "zones", func(ctx context.Context) (*powerscale.V3Zones, error) {
+		result, _, err := powerscaleClient.PscaleOpenAPIClient.ZonesApi.ListZonesv3Zones(ctx).Execute()
+		return result, err
+	})
+	if err != nil || result == nil {
+		return result, err
+	}
+	// callers own the returned zones
+	zones := *result
+	zones.Zones = append([]powerscale.V3ZoneExtended(nil), result.Zones...)
+	return &zones, nil
 }
 
 // CreateAccessZones Creates an Access Zone.
@@ -0,0 +1,299 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// APIObjectIDPlaceholder is replaced by the object ID in the paths of an API object.
+	APIObjectIDPlaceholder = "{id}"
+	// APIObjectNoDelete is the delete method of API objects that are left on the cluster on destroy, e.g. settings.
+	APIObjectNoDelete = "NONE"
+)
+
+// SendAPIRequest sends a request to an arbitrary PAPI path with the query parameters of the given map.
+func SendAPIRequest(ctx context.Context, client *client.Client, method, path string, query types.Map, body string) ([]byte, int, error) {
+	values := url.Values{}
+	for key, value := range query.Elements() {
+		if str, ok := value.(types.String); ok {
+			values.Set(key, str.ValueString())
+		}
+	}
+	var payload []byte
+	if len(body) > 0 {
+		payload = []byte(body)
+	}
+	return client.Request(ctx, method, path, values, payload)
+}
+
+// ExpandAPIObjectPath replaces the ID placeholder of an API object path.
+func ExpandAPIObjectPath(path, id string) string {
+	return strings.ReplaceAll(path, APIObjectIDPlaceholder, url.PathEscape(id))
+}
+
+// ValidateJSONObject returns an error when the value is not a JSON object.
+func ValidateJSONObject(value string) error {
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(value), &object); err != nil {
+		return fmt.Errorf("invalid JSON object: %w", err)
+	}
+	return nil
+}
+
+// ExtractAPIObject returns the object of a PAPI GET response.
+// PAPI wraps a single object in a list named after its collection, e.g. {"shares": [{...}]}, and settings in {"settings": {...}}.
+func ExtractAPIObject(body []byte) (map[string]interface{}, error) {
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("invalid JSON response: %w", err)
+	}
+	if settings, ok := response["settings"].(map[string]interface{}); ok && len(response) == 1 {
+		return settings, nil
+	}
+	var wrapped []map[string]interface{}
+	for _, value := range response {
+		if list, ok := value.([]interface{}); ok && len(list) == 1 {
+			if object, ok := list[0].(map[string]interface{}); ok {
+				wrapped = append(wrapped, object)
+			}
+		}
+	}
+	if len(wrapped) == 1 {
+		return wrapped[0], nil
+	}
+	return response, nil
+}
+
+// APIObjectDrift compares the configured body of an API object with the object read from PAPI.
+// Only the configured fields are compared, and nested objects may hold more fields than configured,
+// so that the fields computed by the cluster do not show as drift. Fields PAPI does not return, e.g. passwords, are ignored.
+// It returns the configured body with the remote value of the drifted fields, and whether there was any drift.
+func APIObjectDrift(configured string, remote map[string]interface{}) (string, bool, error) {
+	var body map[string]interface{}
+	if err := json.Unmarshal([]byte(configured), &body); err != nil {
+		return configured, false, fmt.Errorf("invalid JSON body: %w", err)
+	}
+	drifted := false
+	for field, value := range body {
+		remoteValue, ok := remote[field]
+		if !ok || jsonContains(value, remoteValue) {
+			continue
+		}
+		body[field] = remoteValue
+		drifted = true
+	}
+	if !drifted {
+		return configured, false, nil
+	}
+	updated, err := json.Marshal(body)
+	if err != nil {
+		return configured, false, err
+	}
+	return string(updated), true, nil
+}
+
+// jsonContains reports whether the remote JSON value matches the configured one,
+// allowing remote objects to hold more fields than configured.
+func jsonContains(configured, remote interface{}) bool {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for field, value := range c {
+			if !jsonContains(value, r[field]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok || len(r) != len(c) {
+			return false
+		}
+		for i := range c {
+			if !jsonContains(c[i], r[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(configured, remote)
+}
+
+// CreateAPIObject creates an API object and fills the paths left to their defaults.
+func CreateAPIObject(ctx context.Context, client *client.Client, plan *models.APIObjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	respBody, _, err := SendAPIRequest(ctx, client, plan.CreateMethod.ValueString(), plan.Path.ValueString(), plan.Query, plan.Body.ValueString())
+	if err != nil {
+		diags.AddError("Error creating API object", GetErrorString(err, constants.CreateAPIObjectErrorMsg+"with error: "))
+		return diags
+	}
+
+	// collections answer a creation with the ID of the object, settings with no content
+	id := ""
+	if len(respBody) > 0 {
+		var response map[string]interface{}
+		if err := json.Unmarshal(respBody, &response); err == nil {
+			if value, ok := response[plan.IDAttribute.ValueString()]; ok && value != nil {
+				id = fmt.Sprint(value)
+				if number, ok := value.(float64); ok {
+					id = fmt.Sprintf("%.0f", number)
+				}
+			}
+		}
+	}
+	if len(id) == 0 {
+		plan.ID = plan.Path
+		if plan.ReadPath.IsUnknown() {
+			plan.ReadPath = plan.Path
+		}
+	} else {
+		plan.ID = types.StringValue(id)
+		if plan.ReadPath.IsUnknown() {
+			plan.ReadPath = types.StringValue(strings.TrimSuffix(plan.Path.ValueString(), "/") + "/" + APIObjectIDPlaceholder)
+		}
+	}
+	if plan.UpdatePath.IsUnknown() {
+		plan.UpdatePath = plan.ReadPath
+	}
+	if plan.DeletePath.IsUnknown() {
+		plan.DeletePath = plan.ReadPath
+	}
+	return diags
+}
+
+// ReadAPIObject reads an API object into the state, detecting the drift of its configured body.
+// It returns false when the object does not exist anymore.
+func ReadAPIObject(ctx context.Context, client *client.Client, state *models.APIObjectResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	readPath := ExpandAPIObjectPath(state.ReadPath.ValueString(), state.ID.ValueString())
+	respBody, status, err := SendAPIRequest(ctx, client, http.MethodGet, readPath, state.Query, "")
+	if err != nil {
+		if status == http.StatusNotFound {
+			return false, diags
+		}
+		diags.AddError("Error reading API object", GetErrorString(err, constants.ReadAPIObjectErrorMsg+"with error: "))
+		return true, diags
+	}
+	remote, err := ExtractAPIObject(respBody)
+	if err != nil {
+		diags.AddError("Error reading API object", constants.ReadAPIObjectErrorMsg+"with error: "+err.Error())
+		return true, diags
+	}
+	response, err := json.Marshal(remote)
+	if err != nil {
+		diags.AddError("Error reading API object", constants.ReadAPIObjectErrorMsg+"with error: "+err.Error())
+		return true, diags
+	}
+	state.Response = types.StringValue(string(response))
+
+	body, _, err := APIObjectDrift(state.Body.ValueString(), remote)
+	if err != nil {
+		diags.AddError("Error reading API object", constants.ReadAPIObjectErrorMsg+"with error: "+err.Error())
+		return true, diags
+	}
+	state.Body = types.StringValue(body)
+	return true, diags
+}
+
+// UpdateAPIObject sends the planned body of an API object.
+func UpdateAPIObject(ctx context.Context, client *client.Client, plan *models.APIObjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	updatePath := ExpandAPIObjectPath(plan.UpdatePath.ValueString(), plan.ID.ValueString())
+	if _, _, err := SendAPIRequest(ctx, client, plan.UpdateMethod.ValueString(), updatePath, plan.Query, plan.Body.ValueString()); err != nil {
+		diags.AddError("Error updating API object", GetErrorString(err, constants.UpdateAPIObjectErrorMsg+"with error: "))
+	}
+	return diags
+}
+
+// DeleteAPIObject deletes an API object, unless its delete method is NONE.
+func DeleteAPIObject(ctx context.Context, client *client.Client, state *models.APIObjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.DeleteMethod.ValueString() == APIObjectNoDelete {
+		return diags
+	}
+	deletePath := ExpandAPIObjectPath(state.DeletePath.ValueString(), state.ID.ValueString())
+	_, status, err := SendAPIRequest(ctx, client, state.DeleteMethod.ValueString(), deletePath, state.Query, state.DeleteBody.ValueString())
+	if err != nil && status != http.StatusNotFound {
+		diags.AddError("Error deleting API object", GetErrorString(err, constants.DeleteAPIObjectErrorMsg+"with error: "))
+	}
+	return diags
+}
+
+// ImportAPIObject fills the state of an API object imported by its read path, which may hold query parameters.
+func ImportAPIObject(importID string, state *models.APIObjectResourceModel) error {
+	parsed, err := url.Parse(importID)
+	if err != nil || !strings.HasPrefix(parsed.Path, "/") {
+		return fmt.Errorf("expected the path of the object, e.g. /platform/1/protocols/smb/shares/share?zone=zone, got %q", importID)
+	}
+	// the last segment is the ID, split before unescaping as IDs may contain slashes, e.g. NFS aliases
+	readPath := strings.TrimSuffix(parsed.EscapedPath(), "/")
+	index := strings.LastIndex(readPath, "/")
+	id, err := url.PathUnescape(readPath[index+1:])
+	if err != nil || len(id) == 0 {
+		return fmt.Errorf("expected the path of the object, e.g. /platform/1/protocols/smb/shares/share?zone=zone, got %q", importID)
+	}
+	collection, err := url.PathUnescape(readPath[:index])
+	if err != nil {
+		return fmt.Errorf("invalid path %q: %w", importID, err)
+	}
+	queryValue := types.MapNull(types.StringType)
+	if values := parsed.Query(); len(values) > 0 {
+		query := map[string]attr.Value{}
+		for key := range values {
+			query[key] = types.StringValue(values.Get(key))
+		}
+		var diags diag.Diagnostics
+		if queryValue, diags = types.MapValue(types.StringType, query); diags.HasError() {
+			return errors.New("invalid query parameters")
+		}
+	}
+
+	state.ID = types.StringValue(id)
+	state.Path = types.StringValue(collection)
+	state.CreateMethod = types.StringValue(http.MethodPost)
+	state.IDAttribute = types.StringValue("id")
+	state.ReadPath = types.StringValue(collection + "/" + APIObjectIDPlaceholder)
+	state.UpdatePath = state.ReadPath
+	state.UpdateMethod = types.StringValue(http.MethodPut)
+	state.DeletePath = state.ReadPath
+	state.DeleteMethod = types.StringValue(http.MethodDelete)
+	state.Query = queryValue
+	state.Body = types.StringValue("{}")
+	state.DeleteBody = types.StringNull()
+	state.Response = types.StringNull()
+	return nil
+}
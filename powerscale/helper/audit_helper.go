@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuditEvents are the protocol events that can be audited in an access zone.
+var AuditEvents = []string{
+	"all", "close", "create", "delete", "get_security", "logoff", "logon", "read", "rename", "set_security", "tree_connect", "write",
+}
+
+// GetAuditGlobalSettings returns the cluster wide audit settings.
+func GetAuditGlobalSettings(ctx context.Context, client *client.Client) (*powerscale.V12SettingsGlobalSettings, error) {
+	response, _, err := client.PscaleOpenAPIClient.AuditApi.GetAuditv12SettingsGlobal(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	settings := response.GetSettings()
+	return &settings, nil
+}
+
+// UpdateAuditGlobalSettings updates the cluster wide audit settings set in the plan.
+func UpdateAuditGlobalSettings(ctx context.Context, client *client.Client, plan models.AuditGlobalSettingsResourceModel) error {
+	body := powerscale.V12SettingsGlobalExtended{}
+	changed := false
+	if !plan.ProtocolAuditingEnabled.IsNull() && !plan.ProtocolAuditingEnabled.IsUnknown() {
+		body.SetProtocolAuditingEnabled(plan.ProtocolAuditingEnabled.ValueBool())
+		changed = true
+	}
+	if !plan.ConfigAuditingEnabled.IsNull() && !plan.ConfigAuditingEnabled.IsUnknown() {
+		body.SetConfigAuditingEnabled(plan.ConfigAuditingEnabled.ValueBool())
+		changed = true
+	}
+	if !plan.ConfigSyslogEnabled.IsNull() && !plan.ConfigSyslogEnabled.IsUnknown() {
+		body.SetConfigSyslogEnabled(plan.ConfigSyslogEnabled.ValueBool())
+		changed = true
+	}
+	if values, ok := knownStrings(ctx, plan.AuditedZones); ok {
+		body.SetAuditedZones(values)
+		changed = true
+	}
+	if values, ok := knownStrings(ctx, plan.CeeServerURIs); ok {
+		body.SetCeeServerUris(values)
+		changed = true
+	}
+	if !plan.Hostname.IsNull() && !plan.Hostname.IsUnknown() {
+		body.SetHostname(plan.Hostname.ValueString())
+		changed = true
+	}
+	if !plan.RetentionPeriod.IsNull() && !plan.RetentionPeriod.IsUnknown() {
+		body.SetRetentionPeriod(int32(plan.RetentionPeriod.ValueInt64()))
+		changed = true
+	}
+	if !plan.AutoPurgingEnabled.IsNull() && !plan.AutoPurgingEnabled.IsUnknown() {
+		body.SetAutoPurgingEnabled(plan.AutoPurgingEnabled.ValueBool())
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	_, err := client.PscaleOpenAPIClient.AuditApi.UpdateAuditv12SettingsGlobal(ctx).V12SettingsGlobal(body).Execute()
+	return err
+}
+
+// UpdateAuditGlobalSettingsState copies the cluster wide audit settings into the resource state.
+func UpdateAuditGlobalSettingsState(ctx context.Context, settings *powerscale.V12SettingsGlobalSettings, state *models.AuditGlobalSettingsResourceModel) diag.Diagnostics {
+	var diags, setDiags diag.Diagnostics
+	state.ID = types.StringValue("audit_global_settings")
+	state.ProtocolAuditingEnabled = types.BoolValue(settings.GetProtocolAuditingEnabled())
+	state.ConfigAuditingEnabled = types.BoolValue(settings.GetConfigAuditingEnabled())
+	state.ConfigSyslogEnabled = types.BoolValue(settings.GetConfigSyslogEnabled())
+	state.AuditedZones, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.GetAuditedZones()))
+	diags.Append(setDiags...)
+	state.CeeServerURIs, setDiags = types.ListValueFrom(ctx, types.StringType, nonNilStrings(settings.GetCeeServerUris()))
+	diags.Append(setDiags...)
+	state.Hostname = types.StringValue(settings.GetHostname())
+	state.RetentionPeriod = types.Int64Value(int64(settings.GetRetentionPeriod()))
+	state.AutoPurgingEnabled = types.BoolValue(settings.GetAutoPurgingEnabled())
+	return diags
+}
+
+// GetAuditZoneSettings returns the audit settings of an access zone.
+func GetAuditZoneSettings(ctx context.Context, client *client.Client, zone string) (*powerscale.V1AuditSettingsSettings, error) {
+	getParam := client.PscaleOpenAPIClient.AuditApi.GetAuditv1AuditSettings(ctx)
+	if len(zone) > 0 {
+		getParam = getParam.Zone(zone)
+	}
+	response, _, err := getParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	settings := response.GetSettings()
+	return &settings, nil
+}
+
+// UpdateAuditZoneSettings updates the audit settings of the access zone set in the plan.
+func UpdateAuditZoneSettings(ctx context.Context, client *client.Client, plan models.AuditZoneSettingsResourceModel) error {
+	body := powerscale.V1AuditSettingsExtended{}
+	changed := false
+	if values, ok := knownStrings(ctx, plan.AuditSuccess); ok {
+		body.SetAuditSuccess(values)
+		changed = true
+	}
+	if values, ok := knownStrings(ctx, plan.AuditFailure); ok {
+		body.SetAuditFailure(values)
+		changed = true
+	}
+	if !plan.SyslogForwardingEnabled.IsNull() && !plan.SyslogForwardingEnabled.IsUnknown() {
+		body.SetSyslogForwardingEnabled(plan.SyslogForwardingEnabled.ValueBool())
+		changed = true
+	}
+	if values, ok := knownStrings(ctx, plan.SyslogAuditEvents); ok {
+		body.SetSyslogAuditEvents(values)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	updateParam := client.PscaleOpenAPIClient.AuditApi.UpdateAuditv1AuditSettings(ctx)
+	if zone := plan.Zone.ValueString(); len(zone) > 0 {
+		updateParam = updateParam.Zone(zone)
+	}
+	_, err := updateParam.V1AuditSettings(body).Execute()
+	return err
+}
+
+// UpdateAuditZoneSettingsState copies the audit settings of an access zone into the resource state.
+func UpdateAuditZoneSettingsState(ctx context.Context, zone string, settings *powerscale.V1AuditSettingsSettings, state *models.AuditZoneSettingsResourceModel) diag.Diagnostics {
+	var diags, setDiags diag.Diagnostics
+	state.ID = types.StringValue(zone)
+	state.Zone = types.StringValue(zone)
+	state.AuditSuccess, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.GetAuditSuccess()))
+	diags.Append(setDiags...)
+	state.AuditFailure, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.GetAuditFailure()))
+	diags.Append(setDiags...)
+	state.SyslogForwardingEnabled = types.BoolValue(settings.GetSyslogForwardingEnabled())
+	state.SyslogAuditEvents, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(settings.GetSyslogAuditEvents()))
+	diags.Append(setDiags...)
+	return diags
+}
+
+// ListAuditTopics returns the audit topics.
+func ListAuditTopics(ctx context.Context, client *client.Client) ([]powerscale.V1AuditTopic, error) {
+	response, _, err := client.PscaleOpenAPIClient.AuditApi.ListAuditv1AuditTopics(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return response.GetTopics(), nil
+}
+
+// AuditTopicEnabled returns whether the auditing of a topic is enabled by the global settings.
+func AuditTopicEnabled(topic string, settings *powerscale.V12SettingsGlobalSettings) bool {
+	switch topic {
+	case "protocol":
+		return settings.GetProtocolAuditingEnabled()
+	case "config":
+		return settings.GetConfigAuditingEnabled()
+	}
+	return false
+}
+
+// knownStrings returns the strings of a known list or set value.
+func knownStrings(ctx context.Context, value interface {
+	IsNull() bool
+	IsUnknown() bool
+	ElementsAs(context.Context, interface{}, bool) diag.Diagnostics
+}) ([]string, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, false
+	}
+	values := []string{}
+	if diags := value.ElementsAs(ctx, &values, false); diags.HasError() {
+		return nil, false
+	}
+	return values, true
+}
+
+// nonNilStrings returns an empty slice for nil, so that the state holds an empty set rather than null.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"math/big"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// sizeRegex matches a size such as 1024, 10G, 1.5TiB or 500 MB.
+var sizeRegex = regexp.MustCompile(`(?i)^(\d+(?:\.\d+)?)\s*([KMGTPE]?)(?:i?B)?$`)
+
+// sidRegex matches a Windows security identifier such as S-1-5-21-1004336348-1177238915-682003330-512.
+var sidRegex = regexp.MustCompile(`^S-1(-\d+)+$`)
+
+// ParseSize returns the number of bytes of a human-readable size, e.g. 10G or 1.5TiB.
+// As in OneFS, units are powers of 1024 whether written K, KB or KiB, and a size without unit is in bytes.
+// Fractions of a byte are rounded down.
+func ParseSize(size string) (int64, error) {
+	matches := sizeRegex.FindStringSubmatch(strings.TrimSpace(size))
+	if matches == nil {
+		return 0, fmt.Errorf("invalid size %q, expected a number optionally followed by a unit such as K, MB, GiB, T or PB", size)
+	}
+	value, ok := new(big.Rat).SetString(matches[1])
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q", size)
+	}
+	exponent := 0
+	if len(matches[2]) > 0 {
+		exponent = strings.Index("KMGTPE", strings.ToUpper(matches[2])) + 1
+	}
+	value.Mul(value, new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), uint(10*exponent))))
+	bytes := new(big.Int).Quo(value.Num(), value.Denom())
+	if !bytes.IsInt64() {
+		return 0, fmt.Errorf("size %q is too large", size)
+	}
+	return bytes.Int64(), nil
+}
+
+// JoinIfsPath joins path elements into a normalized absolute OneFS path, e.g. /ifs/data/home.
+// A relative result is placed under /ifs, and an absolute result must be under /ifs.
+func JoinIfsPath(elements ...string) (string, error) {
+	joined := path.Join(elements...)
+	if !path.IsAbs(joined) {
+		if joined == ".." || strings.HasPrefix(joined, "../") {
+			return "", fmt.Errorf("path %q is outside of /ifs", joined)
+		}
+		// the namespace API addresses directories relative to the root, as GetDirectoryPath does
+		joined = "/" + GetDirectoryPath("ifs", joined)
+	}
+	if joined != "/ifs" && !strings.HasPrefix(joined, "/ifs/") {
+		return "", fmt.Errorf("path %q is outside of /ifs", joined)
+	}
+	return joined, nil
+}
+
+// FormatPersona returns the persona identifier used by PAPI for an identity,
+// e.g. UID:1000, GID:1000, SID:S-1-5-32-544, USER:admin or GROUP:Administrators.
+func FormatPersona(kind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	switch kind = strings.ToUpper(kind); kind {
+	case "UID", "GID":
+		if _, err := strconv.ParseUint(value, 10, 32); err != nil {
+			return "", fmt.Errorf("invalid %s %q, expected a number between 0 and 4294967295", kind, value)
+		}
+	case "SID":
+		value = strings.ToUpper(value)
+		if !sidRegex.MatchString(value) {
+			return "", fmt.Errorf("invalid SID %q, expected a security identifier such as S-1-5-32-544", value)
+		}
+	case "USER", "GROUP":
+		if len(value) == 0 {
+			return "", fmt.Errorf("the %s name must not be empty", strings.ToLower(kind))
+		}
+	default:
+		return "", fmt.Errorf("invalid persona type %q, expected one of uid, gid, sid, user or group", kind)
+	}
+	return kind + ":" + value, nil
+}
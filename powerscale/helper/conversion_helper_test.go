@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSize(t *testing.T) {
+	for size, expected := range map[string]int64{
+		"0":        0,
+		"1024":     1024,
+		"1K":       1024,
+		"10G":      10 * 1024 * 1024 * 1024,
+		"10 GB":    10 * 1024 * 1024 * 1024,
+		"1.5TiB":   1536 * 1024 * 1024 * 1024,
+		"500mb":    500 * 1024 * 1024,
+		"1.1K":     1126,
+		"8191PiB":  8191 << 50,
+		" 2 B ":    2,
+		"1E":       1 << 60,
+		"0.5 KiB":  512,
+		"100.00 M": 100 * 1024 * 1024,
+	} {
+		bytes, err := ParseSize(size)
+		assert.Nil(t, err, size)
+		assert.Equal(t, expected, bytes, size)
+	}
+	for _, size := range []string{"", "G", "-1G", "10X", "1.G", "10 GiBs", "8E"} {
+		_, err := ParseSize(size)
+		assert.NotNil(t, err, size)
+	}
+}
+
+func TestJoinIfsPath(t *testing.T) {
+	for expected, elements := range map[string][]string{
+		"/ifs":                {"/ifs"},
+		"/ifs/data/home":      {"/ifs/data/", "home"},
+		"/ifs/data/home/user": {"/ifs//data", "./home", "user/"},
+		"/ifs/data":           {"data"},
+		"/ifs/tfacc":          {"/ifs/data", "../tfacc"},
+	} {
+		joined, err := JoinIfsPath(elements...)
+		assert.Nil(t, err, elements)
+		assert.Equal(t, expected, joined, elements)
+	}
+	for _, elements := range [][]string{{"/etc"}, {"/ifs", ".."}, {"../data"}, {"/ifsdata"}} {
+		_, err := JoinIfsPath(elements...)
+		assert.NotNil(t, err, elements)
+	}
+}
+
+func TestFormatPersona(t *testing.T) {
+	for expected, args := range map[string][2]string{
+		"UID:1000":           {"uid", "1000"},
+		"GID:0":              {"GID", "0"},
+		"SID:S-1-5-32-544":   {"sid", "s-1-5-32-544"},
+		"USER:admin":         {"user", "admin"},
+		"GROUP:Domain Users": {"group", "Domain Users"},
+	} {
+		persona, err := FormatPersona(args[0], args[1])
+		assert.Nil(t, err, args)
+		assert.Equal(t, expected, persona, args)
+	}
+	for _, args := range [][2]string{{"uid", "-1"}, {"gid", "admin"}, {"sid", "S-1"}, {"user", ""}, {"name", "admin"}} {
+		_, err := FormatPersona(args[0], args[1])
+		assert.NotNil(t, err, args)
+	}
+}
+
+func TestCalculateExpireFrom(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, int64(0), CalculateExpireFrom(from, "Never"))
+	assert.Equal(t, from.Unix()+86400, CalculateExpireFrom(from, "1 Day"))
+	assert.Equal(t, from.AddDate(0, 0, 7).Unix(), CalculateExpireFrom(from, "1 Week"))
+	assert.Equal(t, from.AddDate(0, 0, 30).Unix(), CalculateExpireFrom(from, "1 Month"))
+}
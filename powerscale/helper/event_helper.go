@@ -0,0 +1,371 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EventChannelTypes are the types of the event channels.
+var EventChannelTypes = []string{"connectemc", "heartbeat", "smtp", "snmp", "supportassist"}
+
+// EventSeverities are the severities of the event groups.
+var EventSeverities = []string{"emergency", "critical", "warning", "information"}
+
+// EventAlertConditions are the conditions on the event groups that raise an alert.
+var EventAlertConditions = []string{"NEW", "NEW EVENTS", "ONGOING", "SEVERITY INCREASE", "SEVERITY DECREASE", "RESOLVED"}
+
+// CreateEventChannel creates an event channel and returns its ID.
+func CreateEventChannel(ctx context.Context, client *client.Client, plan models.EventChannelResourceModel) (string, error) {
+	body := powerscale.V16EventChannel{
+		Name: plan.Name.ValueString(),
+		Type: plan.Type.ValueString(),
+	}
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		body.SetEnabled(plan.Enabled.ValueBool())
+	}
+	if values, ok := knownInt32s(ctx, plan.AllowedNodes); ok {
+		body.SetAllowedNodes(values)
+	}
+	if values, ok := knownInt32s(ctx, plan.ExcludedNodes); ok {
+		body.SetExcludedNodes(values)
+	}
+	if parameters, ok := eventChannelParameters(ctx, plan); ok {
+		body.SetParameters(parameters)
+	}
+	created, _, err := client.PscaleOpenAPIClient.EventApi.CreateEventv16EventChannel(ctx).V16EventChannel(body).Execute()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(created.GetId()), nil
+}
+
+// GetEventChannel returns the event channel with the given ID or name.
+func GetEventChannel(ctx context.Context, client *client.Client, channelID string) (*powerscale.V16EventChannelExtended, error) {
+	response, httpResp, err := client.PscaleOpenAPIClient.EventApi.GetEventv16EventChannel(ctx, channelID).Execute()
+	if err != nil {
+		return nil, checkNotFound(httpResp, err)
+	}
+	channels := response.GetChannels()
+	if len(channels) == 0 {
+		return nil, notFoundError{fmt.Errorf("event channel %s not found", channelID)}
+	}
+	return &channels[0], nil
+}
+
+// UpdateEventChannel updates the settings of an event channel set in the plan.
+func UpdateEventChannel(ctx context.Context, client *client.Client, channelID string, plan models.EventChannelResourceModel) error {
+	body := powerscale.V16EventChannelExtendedExtended{}
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		body.SetEnabled(plan.Enabled.ValueBool())
+	}
+	if values, ok := knownInt32s(ctx, plan.AllowedNodes); ok {
+		body.SetAllowedNodes(values)
+	}
+	if values, ok := knownInt32s(ctx, plan.ExcludedNodes); ok {
+		body.SetExcludedNodes(values)
+	}
+	if parameters, ok := eventChannelParameters(ctx, plan); ok {
+		body.SetParameters(parameters)
+	}
+	_, err := client.PscaleOpenAPIClient.EventApi.UpdateEventv16EventChannel(ctx, channelID).V16EventChannel(body).Execute()
+	return err
+}
+
+// DeleteEventChannel deletes an event channel.
+func DeleteEventChannel(ctx context.Context, client *client.Client, channelID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.EventApi.DeleteEventv16EventChannel(ctx, channelID).Execute()
+	return checkNotFound(httpResp, err)
+}
+
+// eventChannelParameters returns the type specific settings of an event channel set in the plan, and whether there is any.
+func eventChannelParameters(ctx context.Context, plan models.EventChannelResourceModel) (powerscale.V16EventChannelParameters, bool) {
+	parameters := powerscale.V16EventChannelParameters{}
+	set := false
+	if values, ok := knownStrings(ctx, plan.Address); ok {
+		parameters.SetAddress(values)
+		set = true
+	}
+	for _, field := range []struct {
+		value types.String
+		set   func(string)
+	}{
+		{plan.SendAs, parameters.SetSendAs},
+		{plan.Subject, parameters.SetSubject},
+		{plan.SMTPHost, parameters.SetSmtpHost},
+		{plan.SMTPUsername, parameters.SetSmtpUsername},
+		{plan.SMTPPassword, parameters.SetSmtpPassword},
+		{plan.SMTPSecurity, parameters.SetSmtpSecurity},
+		{plan.Batch, parameters.SetBatch},
+		{plan.CustomTemplate, parameters.SetCustomTemplate},
+		{plan.Host, parameters.SetHost},
+		{plan.Community, parameters.SetCommunity},
+	} {
+		if !field.value.IsNull() && !field.value.IsUnknown() {
+			field.set(field.value.ValueString())
+			set = true
+		}
+	}
+	if !plan.SMTPPort.IsNull() && !plan.SMTPPort.IsUnknown() {
+		parameters.SetSmtpPort(int32(plan.SMTPPort.ValueInt64()))
+		set = true
+	}
+	if !plan.SMTPUseAuth.IsNull() && !plan.SMTPUseAuth.IsUnknown() {
+		parameters.SetSmtpUseAuth(plan.SMTPUseAuth.ValueBool())
+		set = true
+	}
+	if !plan.BatchPeriod.IsNull() && !plan.BatchPeriod.IsUnknown() {
+		parameters.SetBatchPeriod(int32(plan.BatchPeriod.ValueInt64()))
+		set = true
+	}
+	return parameters, set
+}
+
+// UpdateEventChannelState copies an event channel into the resource state.
+// The SMTP password is kept from the plan, as it is never returned.
+func UpdateEventChannelState(ctx context.Context, channel *powerscale.V16EventChannelExtended, state *models.EventChannelResourceModel) diag.Diagnostics {
+	var diags, setDiags diag.Diagnostics
+	state.ID = types.StringValue(fmt.Sprint(channel.GetId()))
+	state.Name = types.StringValue(channel.GetName())
+	state.Type = types.StringValue(channel.GetType())
+	state.Enabled = types.BoolValue(channel.GetEnabled())
+	state.System = types.BoolValue(channel.GetSystem())
+	state.AllowedNodes, setDiags = types.SetValueFrom(ctx, types.Int64Type, int64s(channel.GetAllowedNodes()))
+	diags.Append(setDiags...)
+	state.ExcludedNodes, setDiags = types.SetValueFrom(ctx, types.Int64Type, int64s(channel.GetExcludedNodes()))
+	diags.Append(setDiags...)
+
+	parameters := channel.GetParameters()
+	state.Address, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(parameters.GetAddress()))
+	diags.Append(setDiags...)
+	state.SendAs = types.StringPointerValue(parameters.SendAs)
+	state.Subject = types.StringPointerValue(parameters.Subject)
+	state.SMTPHost = types.StringPointerValue(parameters.SmtpHost)
+	state.SMTPPort = int64PointerValue(parameters.SmtpPort)
+	state.SMTPUseAuth = types.BoolPointerValue(parameters.SmtpUseAuth)
+	state.SMTPUsername = types.StringPointerValue(parameters.SmtpUsername)
+	state.SMTPSecurity = types.StringPointerValue(parameters.SmtpSecurity)
+	state.Batch = types.StringPointerValue(parameters.Batch)
+	state.BatchPeriod = int64PointerValue(parameters.BatchPeriod)
+	state.CustomTemplate = types.StringPointerValue(parameters.CustomTemplate)
+	state.Host = types.StringPointerValue(parameters.Host)
+	state.Community = types.StringPointerValue(parameters.Community)
+	if state.SMTPPassword.IsUnknown() {
+		state.SMTPPassword = types.StringNull()
+	}
+	return diags
+}
+
+// CreateEventAlertCondition creates an alert condition and returns its ID.
+func CreateEventAlertCondition(ctx context.Context, client *client.Client, plan models.EventAlertConditionResourceModel) (string, error) {
+	body := powerscale.V3EventAlertCondition{
+		Name:      plan.Name.ValueString(),
+		Condition: plan.Condition.ValueString(),
+	}
+	if values, ok := knownStrings(ctx, plan.Categories); ok {
+		body.SetCategories(values)
+	}
+	if values, ok := knownStrings(ctx, plan.Severities); ok {
+		body.SetSeverities(values)
+	}
+	if values, ok := knownStrings(ctx, plan.Channels); ok {
+		body.SetChannels(values)
+	}
+	if !plan.Interval.IsNull() && !plan.Interval.IsUnknown() {
+		body.SetInterval(int32(plan.Interval.ValueInt64()))
+	}
+	if !plan.Limit.IsNull() && !plan.Limit.IsUnknown() {
+		body.SetLimit(int32(plan.Limit.ValueInt64()))
+	}
+	created, _, err := client.PscaleOpenAPIClient.EventApi.CreateEventv3EventAlertCondition(ctx).V3EventAlertCondition(body).Execute()
+	if err != nil {
+		return "", err
+	}
+	if len(created.GetId()) == 0 {
+		return plan.Name.ValueString(), nil
+	}
+	return created.GetId(), nil
+}
+
+// GetEventAlertCondition returns the alert condition with the given name.
+func GetEventAlertCondition(ctx context.Context, client *client.Client, conditionID string) (*powerscale.V3EventAlertConditionExtended, error) {
+	response, httpResp, err := client.PscaleOpenAPIClient.EventApi.GetEventv3EventAlertCondition(ctx, conditionID).Execute()
+	if err != nil {
+		return nil, checkNotFound(httpResp, err)
+	}
+	conditions := response.GetAlertConditions()
+	if len(conditions) == 0 {
+		return nil, notFoundError{fmt.Errorf("alert condition %s not found", conditionID)}
+	}
+	return &conditions[0], nil
+}
+
+// UpdateEventAlertCondition updates the settings of an alert condition set in the plan.
+func UpdateEventAlertCondition(ctx context.Context, client *client.Client, conditionID string, plan models.EventAlertConditionResourceModel) error {
+	body := powerscale.V3EventAlertConditionExtendedExtended{}
+	body.SetCondition(plan.Condition.ValueString())
+	if values, ok := knownStrings(ctx, plan.Categories); ok {
+		body.SetCategories(values)
+	}
+	if values, ok := knownStrings(ctx, plan.Severities); ok {
+		body.SetSeverities(values)
+	}
+	if values, ok := knownStrings(ctx, plan.Channels); ok {
+		body.SetChannels(values)
+	}
+	if !plan.Interval.IsNull() && !plan.Interval.IsUnknown() {
+		body.SetInterval(int32(plan.Interval.ValueInt64()))
+	}
+	if !plan.Limit.IsNull() && !plan.Limit.IsUnknown() {
+		body.SetLimit(int32(plan.Limit.ValueInt64()))
+	}
+	_, err := client.PscaleOpenAPIClient.EventApi.UpdateEventv3EventAlertCondition(ctx, conditionID).V3EventAlertCondition(body).Execute()
+	return err
+}
+
+// DeleteEventAlertCondition deletes an alert condition.
+func DeleteEventAlertCondition(ctx context.Context, client *client.Client, conditionID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.EventApi.DeleteEventv3EventAlertCondition(ctx, conditionID).Execute()
+	return checkNotFound(httpResp, err)
+}
+
+// UpdateEventAlertConditionState copies an alert condition into the resource state.
+func UpdateEventAlertConditionState(ctx context.Context, condition *powerscale.V3EventAlertConditionExtended, state *models.EventAlertConditionResourceModel) diag.Diagnostics {
+	var diags, setDiags diag.Diagnostics
+	state.ID = types.StringValue(condition.GetName())
+	if len(condition.GetId()) > 0 {
+		state.ID = types.StringValue(condition.GetId())
+	}
+	state.Name = types.StringValue(condition.GetName())
+	state.Condition = types.StringValue(condition.GetCondition())
+	state.Categories, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(condition.GetCategories()))
+	diags.Append(setDiags...)
+	state.Severities, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(condition.GetSeverities()))
+	diags.Append(setDiags...)
+	state.Channels, setDiags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(condition.GetChannels()))
+	diags.Append(setDiags...)
+	state.Interval = int64PointerValue(condition.Interval)
+	state.Limit = int64PointerValue(condition.Limit)
+	return diags
+}
+
+// ListEventGroups returns the occurrences of the event groups matching the filter.
+// The resolved state and time window are filtered by PAPI, the severities by the provider.
+func ListEventGroups(ctx context.Context, client *client.Client, filter *models.EventGroupDataSourceFilter) ([]powerscale.V3EventEventgroupOccurrencesEventgroup, error) {
+	listParam := client.PscaleOpenAPIClient.EventApi.GetEventv3EventEventgroupOccurrences(ctx)
+	var severities []string
+	if filter != nil {
+		if !filter.Resolved.IsNull() {
+			listParam = listParam.Resolved(filter.Resolved.ValueBool())
+		}
+		if !filter.Begin.IsNull() {
+			listParam = listParam.Begin(int32(filter.Begin.ValueInt64()))
+		}
+		if !filter.End.IsNull() {
+			listParam = listParam.End(int32(filter.End.ValueInt64()))
+		}
+		for _, severity := range filter.Severities {
+			severities = append(severities, severity.ValueString())
+		}
+	}
+	var groups []powerscale.V3EventEventgroupOccurrencesEventgroup
+	for {
+		response, _, err := listParam.Execute()
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range response.GetEventgroups() {
+			if len(severities) == 0 || slices.Contains(severities, group.GetSeverity()) {
+				groups = append(groups, group)
+			}
+		}
+		resume := response.GetResume()
+		if len(resume) == 0 {
+			return groups, nil
+		}
+		listParam = client.PscaleOpenAPIClient.EventApi.GetEventv3EventEventgroupOccurrences(ctx).Resume(resume)
+	}
+}
+
+// NewEventGroupDataSourceEntity returns the data source entity of an event group occurrence.
+func NewEventGroupDataSourceEntity(ctx context.Context, group powerscale.V3EventEventgroupOccurrencesEventgroup) (models.EventGroupDataSourceEntity, diag.Diagnostics) {
+	var diags, listDiags diag.Diagnostics
+	entity := models.EventGroupDataSourceEntity{
+		ID:          types.StringValue(group.GetId()),
+		Severity:    types.StringValue(group.GetSeverity()),
+		Resolved:    types.BoolValue(group.GetResolved()),
+		ResolveTime: int64PointerValue(group.ResolveTime),
+		TimeNoticed: types.Int64Value(int64(group.GetTimeNoticed())),
+		LastEvent:   types.Int64Value(int64(group.GetLastEvent())),
+		Events:      types.Int64Value(int64(group.GetEvents())),
+		Ignore:      types.BoolValue(group.GetIgnore()),
+		Lnn:         int64PointerValue(group.Lnn),
+	}
+	// each cause is a list of message fragments, read as a single message
+	causes := make([]string, 0, len(group.GetCauses()))
+	for _, cause := range group.GetCauses() {
+		causes = append(causes, strings.Join(cause, " "))
+	}
+	entity.Causes, listDiags = types.ListValueFrom(ctx, types.StringType, causes)
+	diags.Append(listDiags...)
+	entity.Channels, listDiags = types.ListValueFrom(ctx, types.StringType, nonNilStrings(group.GetChannels()))
+	diags.Append(listDiags...)
+	return entity, diags
+}
+
+// knownInt32s returns the numbers of a known set value, as sent to PAPI.
+func knownInt32s(ctx context.Context, value types.Set) ([]int32, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, false
+	}
+	values := []int64{}
+	if diags := value.ElementsAs(ctx, &values, false); diags.HasError() {
+		return nil, false
+	}
+	numbers := make([]int32, 0, len(values))
+	for _, value := range values {
+		numbers = append(numbers, int32(value))
+	}
+	return numbers, true
+}
+
+// int64s returns the numbers read from PAPI as int64, and an empty slice for nil, so that the state holds an empty set rather than null.
+func int64s(values []int32) []int64 {
+	numbers := make([]int64, 0, len(values))
+	for _, value := range values {
+		numbers = append(numbers, int64(value))
+	}
+	return numbers
+}
+
+// int64PointerValue returns the value of an optional number read from PAPI, null when it is not set.
+func int64PointerValue(value *int32) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
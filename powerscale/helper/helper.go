@@ -20,7 +20,6 @@ package helper
 import (
 	"bytes"
 	"context"
-	powerscale "dell/powerscale-go-client"
 	"encoding/json"
 	"fmt"
 	"golang.org/x/net/html"
@@ -277,7 +276,8 @@ func ParseBody(body []byte) (string, error) {
 
 // GetErrorString extracts the error message from an openApi error response.
 func GetErrorString(err error, errStr string) string {
-	err1, ok := err.(*powerscale.GenericOpenAPIError)
+	// errors of the OpenAPI client and of client.Client.Request carry the body of the PAPI response
+	err1, ok := err.(interface{ Body() []byte })
 	message := ""
 	msgStr := ""
 	if ok {
@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ImportIDErrorSummary is the summary of the diagnostics of an invalid import identifier.
+const ImportIDErrorSummary = "Unexpected Import Identifier"
+
+// ImportIDFormat describes the import identifiers of a resource. All the resources accept the same syntax:
+//   - <id> for an object of the default access zone, or of the cluster;
+//   - <zone>:<id> or zone:<zone>/<id> for an object of an access zone;
+//   - <parent>.<parent>.<id> for an object nested under other objects, e.g. groupnet0.subnet0.pool0;
+//   - a JSON object with one key per part, e.g. {"zone":"System","id":"share"}.
+type ImportIDFormat struct {
+	// ID is the key of the identifier of the object, e.g. name.
+	// It is empty for the objects identified by their access zone only, e.g. zone settings.
+	ID string
+	// Parents are the keys of the objects the object is nested under, outermost first, e.g. groupnet and subnet.
+	Parents []string
+	// Zoned objects accept an access zone.
+	Zoned bool
+}
+
+// ImportID is a parsed import identifier.
+type ImportID struct {
+	// ID is the identifier of the object.
+	ID string
+	// Parents are the identifiers of the objects the object is nested under, in the order of ImportIDFormat.Parents.
+	Parents []string
+	// Zone is the access zone of the object, empty for the default access zone.
+	Zone string
+}
+
+// Formats returns the accepted import identifiers, for the diagnostics and the documentation.
+func (f ImportIDFormat) Formats() []string {
+	var plain []string
+	for _, parent := range f.Parents {
+		plain = append(plain, "<"+parent+">")
+	}
+	if f.ID != "" {
+		plain = append(plain, "<"+f.ID+">")
+	}
+	id := strings.Join(plain, ".")
+
+	var formats []string
+	switch {
+	case f.Zoned && f.ID == "":
+		formats = append(formats, "<zone>")
+	case f.Zoned:
+		formats = append(formats, id, "<zone>:"+id, "zone:<zone>/"+id)
+	default:
+		formats = append(formats, id)
+	}
+
+	var keys []string
+	if f.Zoned {
+		keys = append(keys, `"zone":"<zone>"`)
+	}
+	for _, parent := range f.Parents {
+		keys = append(keys, fmt.Sprintf(`"%s":"<%s>"`, parent, parent))
+	}
+	if f.ID != "" {
+		keys = append(keys, fmt.Sprintf(`"%s":"<%s>"`, f.ID, f.ID))
+	}
+	return append(formats, "{"+strings.Join(keys, ",")+"}")
+}
+
+// ParseImportID parses an import identifier, and returns diagnostics listing the accepted formats if it is invalid.
+func ParseImportID(importID string, format ImportIDFormat) (ImportID, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	parsed, err := parseImportID(importID, format)
+	if err != nil {
+		diags.AddError(ImportIDErrorSummary, fmt.Sprintf("%s. Expected import identifier with one of the formats:\n  %s\nGot: %q",
+			err.Error(), strings.Join(format.Formats(), "\n  "), importID))
+	}
+	return parsed, diags
+}
+
+func parseImportID(importID string, format ImportIDFormat) (ImportID, error) {
+	importID = strings.TrimSpace(importID)
+	if strings.HasPrefix(importID, "{") {
+		return parseJSONImportID(importID, format)
+	}
+
+	var parsed ImportID
+	rest := importID
+	if format.Zoned {
+		if format.ID == "" {
+			parsed.Zone, rest = rest, ""
+		} else if qualified, ok := strings.CutPrefix(rest, "zone:"); ok && strings.Index(qualified, "/") > 0 {
+			// zone:<zone>/<id> is the explicit form, for identifiers starting with a slash or holding a colon
+			parsed.Zone, rest, _ = strings.Cut(qualified, "/")
+		} else if zone, id, ok := strings.Cut(rest, ":"); ok {
+			parsed.Zone, rest = zone, id
+		}
+		// an empty zone before the colon stands for the default access zone, as in the identities without zone
+		parsed.Zone = strings.TrimSpace(parsed.Zone)
+		if parsed.Zone == "" && format.ID == "" {
+			return ImportID{}, errors.New("the access zone is empty")
+		}
+	}
+	if format.ID == "" {
+		return parsed, nil
+	}
+
+	parts := []string{rest}
+	if len(format.Parents) > 0 {
+		parts = strings.Split(rest, ".")
+		if len(parts) != len(format.Parents)+1 {
+			return ImportID{}, fmt.Errorf("expected %d parts separated by dots, got %d", len(format.Parents)+1, len(parts))
+		}
+	}
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+		if parts[i] == "" {
+			return ImportID{}, fmt.Errorf("the %s is empty", append(slices.Clone(format.Parents), format.ID)[i])
+		}
+	}
+	parsed.ID = parts[len(parts)-1]
+	if len(format.Parents) > 0 {
+		parsed.Parents = parts[:len(parts)-1]
+	}
+	return parsed, nil
+}
+
+// parseJSONImportID parses an import identifier given as a JSON object.
+func parseJSONImportID(importID string, format ImportIDFormat) (ImportID, error) {
+	var values map[string]string
+	if err := json.Unmarshal([]byte(importID), &values); err != nil {
+		return ImportID{}, fmt.Errorf("invalid JSON object of strings: %s", err.Error())
+	}
+	keys := slices.Clone(format.Parents)
+	if format.ID != "" {
+		keys = append(keys, format.ID)
+	}
+	known := map[string]bool{}
+	for _, key := range keys {
+		known[key] = true
+	}
+	if format.Zoned {
+		known["zone"] = true
+	}
+	for key := range values {
+		if !known[key] {
+			return ImportID{}, fmt.Errorf("unexpected key %s", key)
+		}
+	}
+
+	parsed := ImportID{Zone: strings.TrimSpace(values["zone"])}
+	if format.Zoned && format.ID == "" && parsed.Zone == "" {
+		return ImportID{}, errors.New("the access zone is empty")
+	}
+	var parts []string
+	for _, key := range keys {
+		value := strings.TrimSpace(values[key])
+		if value == "" {
+			return ImportID{}, fmt.Errorf("the %s is empty", key)
+		}
+		parts = append(parts, value)
+	}
+	if format.ID != "" {
+		parsed.ID = parts[len(parts)-1]
+	}
+	if len(format.Parents) > 0 {
+		parsed.Parents = parts[:len(format.Parents)]
+	}
+	return parsed, nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImportID(t *testing.T) {
+	zoned := ImportIDFormat{ID: "id", Zoned: true}
+	zone := ImportIDFormat{Zoned: true}
+	nested := ImportIDFormat{ID: "name", Parents: []string{"groupnet", "subnet"}}
+	plain := ImportIDFormat{ID: "name"}
+	for _, test := range []struct {
+		importID string
+		format   ImportIDFormat
+		expected ImportID
+	}{
+		{"share", zoned, ImportID{ID: "share"}},
+		{" tfacc : share ", zoned, ImportID{ID: "share", Zone: "tfacc"}},
+		{":share", zoned, ImportID{ID: "share"}},
+		{"zone:tfacc/share", zoned, ImportID{ID: "share", Zone: "tfacc"}},
+		{"zone:tfacc//alias", zoned, ImportID{ID: "/alias", Zone: "tfacc"}},
+		{"zone:/alias", zoned, ImportID{ID: "/alias", Zone: "zone"}},
+		{`{"zone":"tfacc","id":"share"}`, zoned, ImportID{ID: "share", Zone: "tfacc"}},
+		{`{"id":"share"}`, zoned, ImportID{ID: "share"}},
+		{"System", zone, ImportID{Zone: "System"}},
+		{`{"zone":"System"}`, zone, ImportID{Zone: "System"}},
+		{"groupnet0.subnet0.pool0", nested, ImportID{ID: "pool0", Parents: []string{"groupnet0", "subnet0"}}},
+		{`{"groupnet":"groupnet0","subnet":"subnet0","name":"pool0"}`, nested, ImportID{ID: "pool0", Parents: []string{"groupnet0", "subnet0"}}},
+		{"/ifs/data:x", plain, ImportID{ID: "/ifs/data:x"}},
+	} {
+		parsed, diags := ParseImportID(test.importID, test.format)
+		assert.False(t, diags.HasError(), test.importID)
+		assert.Equal(t, test.expected, parsed, test.importID)
+	}
+
+	for _, test := range []struct {
+		importID string
+		format   ImportIDFormat
+		detail   string
+	}{
+		{"", plain, "the name is empty"},
+		{"tfacc:", zoned, "the id is empty"},
+		{"", zone, "the access zone is empty"},
+		{"groupnet0,subnet0,pool0", nested, "expected 3 parts separated by dots, got 1"},
+		{"groupnet0..pool0", nested, "the subnet is empty"},
+		{`{"zone":"tfacc"}`, zoned, "the id is empty"},
+		{`{"zone":"tfacc","name":"share"}`, zoned, "unexpected key name"},
+		{`{"zone":"tfacc"}`, plain, "unexpected key zone"},
+		{`{"id":1}`, zoned, "invalid JSON object of strings"},
+	} {
+		_, diags := ParseImportID(test.importID, test.format)
+		assert.True(t, diags.HasError(), test.importID)
+		assert.Equal(t, ImportIDErrorSummary, diags[0].Summary())
+		assert.Contains(t, diags[0].Detail(), test.detail, test.importID)
+	}
+}
+
+func TestImportIDFormats(t *testing.T) {
+	assert.Equal(t, []string{"<id>", "<zone>:<id>", "zone:<zone>/<id>", `{"zone":"<zone>","id":"<id>"}`},
+		ImportIDFormat{ID: "id", Zoned: true}.Formats())
+	assert.Equal(t, []string{"<zone>", `{"zone":"<zone>"}`}, ImportIDFormat{Zoned: true}.Formats())
+	assert.Equal(t, []string{"<groupnet>.<subnet>.<name>", `{"groupnet":"<groupnet>","subnet":"<subnet>","name":"<name>"}`},
+		ImportIDFormat{ID: "name", Parents: []string{"groupnet", "subnet"}}.Formats())
+
+	_, diags := ParseImportID("subnetId", ImportIDFormat{ID: "name", Parents: []string{"groupnet"}})
+	assert.Equal(t, "expected 2 parts separated by dots, got 1. Expected import identifier with one of the formats:\n"+
+		"  <groupnet>.<name>\n  {\"groupnet\":\"<groupnet>\",\"name\":\"<name>\"}\nGot: \"subnetId\"", diags[0].Detail())
+}
@@ -0,0 +1,372 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Actions taken on a running job when its resource is destroyed.
+const (
+	JobDeleteActionCancel = "cancel"
+	JobDeleteActionPause  = "pause"
+	JobDeleteActionNone   = "none"
+)
+
+// StartJob starts a job with the type, paths, policy and parameters of the plan, and returns its ID.
+// The parameters are merged into the request first, so that the attributes of the plan take precedence.
+func StartJob(ctx context.Context, client *client.Client, plan models.JobResourceModel) (string, error) {
+	body := map[string]interface{}{}
+	if params := plan.Parameters.ValueString(); len(params) > 0 {
+		if err := json.Unmarshal([]byte(params), &body); err != nil {
+			return "", fmt.Errorf("invalid parameters: %w", err)
+		}
+	}
+	body["type"] = plan.Type.ValueString()
+	if !plan.Paths.IsNull() && !plan.Paths.IsUnknown() {
+		var paths []string
+		if diags := plan.Paths.ElementsAs(ctx, &paths, false); diags.HasError() {
+			return "", errors.New("invalid paths")
+		}
+		body["paths"] = paths
+	}
+	if !plan.Policy.IsNull() && !plan.Policy.IsUnknown() {
+		body["policy"] = plan.Policy.ValueString()
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		body["priority"] = plan.Priority.ValueInt64()
+	}
+	if !plan.AllowDup.IsNull() && !plan.AllowDup.IsUnknown() {
+		body["allow_dup"] = plan.AllowDup.ValueBool()
+	}
+
+	// the parameters of the job types are fields of the job, they are decoded along with the attributes of the plan
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid parameters: %w", err)
+	}
+	var job powerscale.V10JobJob
+	if err := json.Unmarshal(encoded, &job); err != nil {
+		return "", fmt.Errorf("invalid parameters: %w", err)
+	}
+	created, _, err := client.PscaleOpenAPIClient.JobApi.CreateJobv10JobJob(ctx).V10JobJob(job).Execute()
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(int64(created.Id), 10), nil
+}
+
+// GetJob returns the job with the given ID.
+func GetJob(ctx context.Context, client *client.Client, jobID string) (*powerscale.V10JobJobExtended, error) {
+	response, httpResp, err := client.PscaleOpenAPIClient.JobApi.GetJobv7JobJob(ctx, jobID).Execute()
+	if err != nil {
+		return nil, checkNotFound(httpResp, err)
+	}
+	if len(response.Jobs) == 0 {
+		return nil, fmt.Errorf("job %s not found", jobID)
+	}
+	return &response.Jobs[0], nil
+}
+
+// ListJobs returns the active jobs matching the filter.
+func ListJobs(ctx context.Context, client *client.Client, filter *models.JobDataSourceFilter) ([]powerscale.V10JobJobExtended, error) {
+	listParam := client.PscaleOpenAPIClient.JobApi.ListJobv10JobJobs(ctx)
+	var jobTypes []string
+	if filter != nil {
+		if !filter.State.IsNull() {
+			listParam = listParam.State(filter.State.ValueString())
+		}
+		for _, jobType := range filter.Types {
+			jobTypes = append(jobTypes, jobType.ValueString())
+		}
+	}
+	var jobs []powerscale.V10JobJobExtended
+	for {
+		response, _, err := listParam.Execute()
+		if err != nil {
+			return nil, err
+		}
+		for _, job := range response.Jobs {
+			if len(jobTypes) == 0 || slices.Contains(jobTypes, job.GetType()) {
+				jobs = append(jobs, job)
+			}
+		}
+		if len(response.GetResume()) == 0 {
+			return jobs, nil
+		}
+		listParam = client.PscaleOpenAPIClient.JobApi.ListJobv10JobJobs(ctx).Resume(response.GetResume())
+	}
+}
+
+// WaitForJob polls a job until it ends, bounded by the deadline of the context.
+func WaitForJob(ctx context.Context, client *client.Client, jobID string) (*powerscale.V10JobJobExtended, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var job *powerscale.V10JobJobExtended
+	err := Poll(ctx, func(ctx context.Context) (bool, string, error) {
+		current, err := GetJob(ctx, client, jobID)
+		if err != nil {
+			return false, "", err
+		}
+		job = current
+		return IsJobDone(job.State), JobStatus(job), nil
+	})
+	if err != nil {
+		var pollErr *PollError
+		if errors.As(err, &pollErr) {
+			diags.AddError(fmt.Sprintf("Error waiting for job %s", jobID), err.Error())
+			return job, diags
+		}
+		diags.AddError("Error reading job", GetErrorString(err, constants.ReadJobErrorMsg+"with error: "))
+		return job, diags
+	}
+	return job, diags
+}
+
+// UpdateJob changes the impact policy and priority of a job.
+func UpdateJob(ctx context.Context, client *client.Client, jobID string, plan models.JobResourceModel) error {
+	body := powerscale.V7JobJobExtendedExtended{}
+	if !plan.Policy.IsNull() && !plan.Policy.IsUnknown() {
+		body.Policy = plan.Policy.ValueStringPointer()
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		priority := int32(plan.Priority.ValueInt64())
+		body.Priority = &priority
+	}
+	if body.Policy == nil && body.Priority == nil {
+		return nil
+	}
+	_, err := client.PscaleOpenAPIClient.JobApi.UpdateJobv7JobJob(ctx, jobID).V7JobJob(body).Execute()
+	return err
+}
+
+// StopJob cancels or pauses a job that has not ended yet, as set by the delete action of the state.
+func StopJob(ctx context.Context, client *client.Client, state models.JobResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	action := state.DeleteAction.ValueString()
+	if action == JobDeleteActionNone {
+		return diags
+	}
+	job, err := GetJob(ctx, client, state.ID.ValueString())
+	if err != nil {
+		if IsPAPINotFound(err) {
+			return diags
+		}
+		diags.AddError("Error reading job", GetErrorString(err, constants.ReadJobErrorMsg+"with error: "))
+		return diags
+	}
+	if IsJobDone(job.State) || (action == JobDeleteActionPause && job.State == "paused_user") {
+		return diags
+	}
+	body := powerscale.V7JobJobExtendedExtended{State: &action}
+	if _, err := client.PscaleOpenAPIClient.JobApi.UpdateJobv7JobJob(ctx, state.ID.ValueString()).V7JobJob(body).Execute(); err != nil {
+		diags.AddError(fmt.Sprintf("Error stopping job %s", state.ID.ValueString()), GetErrorString(err, constants.DeleteJobErrorMsg+"with error: "))
+	}
+	return diags
+}
+
+// UpdateJobResourceState copies the progress of a job into the resource state.
+// The configured type, paths and parameters are kept as planned.
+func UpdateJobResourceState(job *powerscale.V10JobJobExtended, state *models.JobResourceModel) {
+	state.ID = types.StringValue(strconv.FormatInt(int64(job.Id), 10))
+	state.State = types.StringValue(job.State)
+	state.Progress = types.StringValue(job.GetProgress())
+	state.CurrentPhase = types.Int64Value(int64(job.GetCurrentPhase()))
+	state.TotalPhases = types.Int64Value(int64(job.GetTotalPhases()))
+	state.StartTime = types.Int64Null()
+	if startTime, ok := job.GetStartTimeOk(); ok && startTime != nil {
+		state.StartTime = types.Int64Value(int64(*startTime))
+	}
+	state.EndTime = types.Int64Null()
+	if endTime, ok := job.GetEndTimeOk(); ok && endTime != nil {
+		state.EndTime = types.Int64Value(int64(*endTime))
+	}
+
+	// the policy and priority of an ended job no longer apply, those of the state are kept
+	active := !IsJobDone(job.State)
+	if active || state.Priority.IsNull() || state.Priority.IsUnknown() {
+		state.Priority = types.Int64Value(int64(job.GetPriority()))
+	}
+	if policy := job.GetPolicy(); len(policy) > 0 && (active || state.Policy.IsNull() || state.Policy.IsUnknown()) {
+		state.Policy = types.StringValue(policy)
+	} else if state.Policy.IsUnknown() {
+		state.Policy = types.StringNull()
+	}
+}
+
+// CreateJobPolicy creates a job impact policy and returns its ID.
+func CreateJobPolicy(ctx context.Context, client *client.Client, plan models.JobPolicyResourceModel) (string, error) {
+	policy := powerscale.V1JobPolicy{
+		Name:        plan.Name.ValueString(),
+		Description: jobPolicyDescription(plan),
+		Intervals:   jobPolicyIntervals(plan),
+	}
+	created, _, err := client.PscaleOpenAPIClient.JobApi.CreateJobv1JobPolicy(ctx).V1JobPolicy(policy).Execute()
+	if err != nil {
+		return "", err
+	}
+	return created.GetId(), nil
+}
+
+// GetJobPolicy returns the job impact policy with the given ID or name.
+func GetJobPolicy(ctx context.Context, client *client.Client, policyID string) (*powerscale.V1JobPolicyExtended, error) {
+	response, httpResp, err := client.PscaleOpenAPIClient.JobApi.GetJobv1JobPolicy(ctx, policyID).Execute()
+	if err != nil {
+		return nil, checkNotFound(httpResp, err)
+	}
+	if len(response.Policies) == 0 {
+		return nil, fmt.Errorf("job impact policy %s not found", policyID)
+	}
+	return &response.Policies[0], nil
+}
+
+// UpdateJobPolicy updates the description and intervals of a job impact policy.
+func UpdateJobPolicy(ctx context.Context, client *client.Client, policyID string, plan models.JobPolicyResourceModel) error {
+	policy := powerscale.V1JobPolicyExtendedExtended{
+		Description: jobPolicyDescription(plan),
+		Intervals:   jobPolicyIntervals(plan),
+	}
+	_, err := client.PscaleOpenAPIClient.JobApi.UpdateJobv1JobPolicy(ctx, policyID).V1JobPolicy(policy).Execute()
+	return err
+}
+
+// DeleteJobPolicy deletes a job impact policy.
+func DeleteJobPolicy(ctx context.Context, client *client.Client, policyID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.JobApi.DeleteJobv1JobPolicy(ctx, policyID).Execute()
+	return checkNotFound(httpResp, err)
+}
+
+func jobPolicyDescription(plan models.JobPolicyResourceModel) *string {
+	if plan.Description.IsNull() || plan.Description.IsUnknown() {
+		return nil
+	}
+	return plan.Description.ValueStringPointer()
+}
+
+func jobPolicyIntervals(plan models.JobPolicyResourceModel) []powerscale.V1JobPolicyInterval {
+	intervals := make([]powerscale.V1JobPolicyInterval, 0, len(plan.Intervals))
+	for _, interval := range plan.Intervals {
+		intervals = append(intervals, powerscale.V1JobPolicyInterval{
+			Begin:  interval.Begin.ValueString(),
+			End:    interval.End.ValueString(),
+			Impact: interval.Impact.ValueString(),
+		})
+	}
+	return intervals
+}
+
+// ListJobTypes returns the job types, including the hidden ones when showAll is set.
+func ListJobTypes(ctx context.Context, client *client.Client, showAll bool) ([]powerscale.V1JobTypeExtended, error) {
+	listParam := client.PscaleOpenAPIClient.JobApi.ListJobv1JobTypes(ctx)
+	if showAll {
+		listParam = listParam.ShowAll(true)
+	}
+	response, _, err := listParam.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return response.Types, nil
+}
+
+// GetJobType returns the job type with the given ID.
+func GetJobType(ctx context.Context, client *client.Client, jobType string) (*powerscale.V1JobTypeExtended, error) {
+	response, httpResp, err := client.PscaleOpenAPIClient.JobApi.GetJobv1JobType(ctx, jobType).Execute()
+	if err != nil {
+		return nil, checkNotFound(httpResp, err)
+	}
+	if len(response.Types) == 0 {
+		return nil, fmt.Errorf("job type %s not found", jobType)
+	}
+	return &response.Types[0], nil
+}
+
+// UpdateJobType updates the settings of a job type set in the plan.
+func UpdateJobType(ctx context.Context, client *client.Client, plan models.JobTypeSettingsResourceModel) error {
+	body := powerscale.V1JobTypeExtendedExtended{}
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() {
+		body.Enabled = plan.Enabled.ValueBoolPointer()
+	}
+	if !plan.Policy.IsNull() && !plan.Policy.IsUnknown() {
+		body.Policy = plan.Policy.ValueStringPointer()
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		priority := int32(plan.Priority.ValueInt64())
+		body.Priority = &priority
+	}
+	if !plan.Schedule.IsNull() && !plan.Schedule.IsUnknown() {
+		body.Schedule = plan.Schedule.ValueStringPointer()
+	}
+	if body.Enabled == nil && body.Policy == nil && body.Priority == nil && body.Schedule == nil {
+		return nil
+	}
+	_, err := client.PscaleOpenAPIClient.JobApi.UpdateJobv1JobType(ctx, plan.Type.ValueString()).V1JobType(body).Execute()
+	return err
+}
+
+// UpdateJobTypeSettingsState copies the settings of a job type into the resource state.
+func UpdateJobTypeSettingsState(ctx context.Context, jobType *powerscale.V1JobTypeExtended, state *models.JobTypeSettingsResourceModel) error {
+	if err := CopyFields(ctx, jobType, state); err != nil {
+		return err
+	}
+	state.Type = types.StringValue(jobType.Id)
+	// unscheduled job types have no schedule, read as an empty string as set to remove a schedule
+	state.Schedule = types.StringValue(jobType.GetSchedule())
+	return nil
+}
+
+// ListJobReports returns the reports of the job phases matching the filter.
+func ListJobReports(ctx context.Context, client *client.Client, filter *models.JobReportDataSourceFilter) ([]powerscale.V1JobReport, error) {
+	listParam := client.PscaleOpenAPIClient.JobApi.GetJobv1JobReports(ctx)
+	if filter != nil {
+		if !filter.JobID.IsNull() {
+			listParam = listParam.JobId(int32(filter.JobID.ValueInt64()))
+		}
+		if !filter.JobType.IsNull() {
+			listParam = listParam.JobType(filter.JobType.ValueString())
+		}
+		if !filter.Begin.IsNull() {
+			listParam = listParam.Begin(int32(filter.Begin.ValueInt64()))
+		}
+		if !filter.End.IsNull() {
+			listParam = listParam.End(int32(filter.End.ValueInt64()))
+		}
+	}
+	var reports []powerscale.V1JobReport
+	for {
+		response, _, err := listParam.Execute()
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, response.Reports...)
+		if len(response.GetResume()) == 0 {
+			return reports, nil
+		}
+		listParam = client.PscaleOpenAPIClient.JobApi.GetJobv1JobReports(ctx).Resume(response.GetResume())
+	}
+}
@@ -115,14 +115,17 @@ func UpdateLdapProviderDataSourceState(ctx context.Context, ldapProviderModel *m
 	return
 }
 
+// ldapProviderV16Requirement selects the v16 LDAP provider endpoints over the v11 ones.
+var ldapProviderV16Requirement = VersionRequirement{MinVersion: "9.5.0"}
+
 // GetAllLdapProvidersWithFilter Returns all filtered Ldap Providers based on Onefs version.
 func GetAllLdapProvidersWithFilter(ctx context.Context, client *client.Client, filter *models.LdapProviderFilterType) (any, error) {
-	onfsVersion, err := client.GetOnefsVersion()
+	v16, err := MeetsVersionRequirement(ctx, client, ldapProviderV16Requirement)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get OneFS version: %v", err)
+		return nil, err
 	}
 
-	if onfsVersion.IsGreaterThan("9.4.0") {
+	if v16 {
 		queryParam := client.PscaleOpenAPIClient.AuthApi.ListAuthv16ProvidersLdap(ctx)
 		if filter != nil && filter.Scope.ValueString() != "" {
 			queryParam = queryParam.Scope(filter.Scope.ValueString())
@@ -150,12 +153,12 @@ func GetAllLdapProvidersWithFilter(ctx context.Context, client *client.Client, f
 
 // GetLdapProvider Returns the Ldap Provider by ldapProviderID based on Onefs version.
 func GetLdapProvider(ctx context.Context, client *client.Client, ldapProviderName, scope string) (any, error) {
-	onfsVersion, err := client.GetOnefsVersion()
+	v16, err := MeetsVersionRequirement(ctx, client, ldapProviderV16Requirement)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get OneFS version: %v", err)
+		return nil, err
 	}
 
-	if onfsVersion.IsGreaterThan("9.4.0") {
+	if v16 {
 		queryParam := client.PscaleOpenAPIClient.AuthApi.GetAuthv16ProvidersLdapById(ctx, ldapProviderName)
 		if scope != "" {
 			queryParam = queryParam.Scope(scope)
@@ -191,17 +194,20 @@ func GetLdapProvider(ctx context.Context, client *client.Client, ldapProviderNam
 
 // CreateLdapProvider Creates a LdapProvider.
 func CreateLdapProvider(ctx context.Context, client *client.Client, plan *models.LdapProviderModel) (err error) {
-	onfsVersion, err := client.GetOnefsVersion()
+	v16, err := MeetsVersionRequirement(ctx, client, ldapProviderV16Requirement)
 	if err != nil {
-		return fmt.Errorf("failed to get OneFS version: %v", err)
+		return err
 	}
 
-	if onfsVersion.IsGreaterThan("9.4.0") {
+	if v16 {
 		ldapToCreate := powerscale.V16ProvidersLdapItem{}
 		// Get param from tf input
 		if err = ReadFromState(ctx, plan, &ldapToCreate); err != nil {
 			return
 		}
+		if err = SetWriteOnlyField(plan.BindPasswordWO, &ldapToCreate, "bind_password"); err != nil {
+			return
+		}
 		createParam := client.PscaleOpenAPIClient.AuthApi.CreateAuthv16ProvidersLdapItem(ctx)
 		if !plan.IgnoreUnresolvableServerURIs.IsNull() && !plan.IgnoreUnresolvableServerURIs.IsUnknown() {
 			createParam = createParam.Force(plan.IgnoreUnresolvableServerURIs.ValueBool())
@@ -221,6 +227,9 @@ func CreateLdapProvider(ctx context.Context, client *client.Client, plan *models
 		if err = ReadFromState(ctx, plan, &ldapToCreate); err != nil {
 			return
 		}
+		if err = SetWriteOnlyField(plan.BindPasswordWO, &ldapToCreate, "bind_password"); err != nil {
+			return
+		}
 		createParam := client.PscaleOpenAPIClient.AuthApi.CreateAuthv11ProvidersLdapItem(ctx)
 		if !plan.IgnoreUnresolvableServerURIs.IsNull() && !plan.IgnoreUnresolvableServerURIs.IsUnknown() {
 			createParam = createParam.Force(plan.IgnoreUnresolvableServerURIs.ValueBool())
@@ -242,17 +251,20 @@ func UpdateLdapProvider(ctx context.Context, client *client.Client, state *model
 		return fmt.Errorf("may not change ldap provider's groupnet")
 	}
 
-	onfsVersion, err := client.GetOnefsVersion()
+	v16, err := MeetsVersionRequirement(ctx, client, ldapProviderV16Requirement)
 	if err != nil {
-		return fmt.Errorf("failed to get OneFS version: %v", err)
+		return err
 	}
 
-	if onfsVersion.IsGreaterThan("9.4.0") {
+	if v16 {
 		ldapToUpdate := powerscale.V16ProvidersLdapIdParams{}
 		// Get param from tf input
 		if err = ReadFromState(ctx, plan, &ldapToUpdate); err != nil {
 			return
 		}
+		if err = SetWriteOnlyField(plan.BindPasswordWO, &ldapToUpdate, "bind_password"); err != nil {
+			return
+		}
 		updateParam := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv16ProvidersLdapById(ctx, state.Name.ValueString())
 		if !plan.IgnoreUnresolvableServerURIs.IsNull() && !plan.IgnoreUnresolvableServerURIs.IsUnknown() {
 			updateParam = updateParam.Force(plan.IgnoreUnresolvableServerURIs.ValueBool())
@@ -272,6 +284,9 @@ func UpdateLdapProvider(ctx context.Context, client *client.Client, state *model
 		if err = ReadFromState(ctx, plan, &ldapToUpdate); err != nil {
 			return
 		}
+		if err = SetWriteOnlyField(plan.BindPasswordWO, &ldapToUpdate, "bind_password"); err != nil {
+			return
+		}
 		updateParam := client.PscaleOpenAPIClient.AuthApi.UpdateAuthv11ProvidersLdapById(ctx, state.Name.ValueString())
 		if !plan.IgnoreUnresolvableServerURIs.IsNull() && !plan.IgnoreUnresolvableServerURIs.IsUnknown() {
 			updateParam = updateParam.Force(plan.IgnoreUnresolvableServerURIs.ValueBool())
@@ -30,7 +30,7 @@ func GetNtpServers(ctx context.Context, client *client.Client) (*powerscale.V3Nt
 	ntpServers, _, err := ntpServerParams.Execute()
 	// Pagination
 	for ntpServers.Resume != nil {
-		respAdd, _, errAdd := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv3NtpServers(context.Background()).Resume(*ntpServers.Resume).Execute()
+		respAdd, _, errAdd := client.PscaleOpenAPIClient.ProtocolsApi.ListProtocolsv3NtpServers(ctx).Resume(*ntpServers.Resume).Execute()
 		if errAdd != nil {
 			return ntpServers, errAdd
 		}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"terraform-provider-powerscale/client"
+)
+
+// GetPAPI reads a PAPI path into the response, for the APIs the OpenAPI client does not cover.
+func GetPAPI(ctx context.Context, client *client.Client, path string, query url.Values, response interface{}) error {
+	return SendPAPI(ctx, client, http.MethodGet, path, query, nil, response)
+}
+
+// SendPAPI sends the body encoded as JSON to a PAPI path, and decodes the response body into the response when it is not nil.
+func SendPAPI(ctx context.Context, client *client.Client, method, path string, query url.Values, body interface{}, response interface{}) error {
+	var payload []byte
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("invalid request body: %w", err)
+		}
+		payload = encoded
+	}
+	respBody, _, err := client.Request(ctx, method, path, query, payload)
+	if err != nil {
+		return err
+	}
+	if response == nil || len(respBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return fmt.Errorf("invalid JSON response: %w", err)
+	}
+	return nil
+}
+
+// notFoundError marks the error of a call of the OpenAPI client answered with the not found status.
+type notFoundError struct {
+	error
+}
+
+// Body returns the body of the PAPI response, as read by GetErrorString.
+func (e notFoundError) Body() []byte {
+	if bodyErr, ok := e.error.(interface{ Body() []byte }); ok {
+		return bodyErr.Body()
+	}
+	return nil
+}
+
+// Unwrap returns the error of the OpenAPI client.
+func (e notFoundError) Unwrap() error {
+	return e.error
+}
+
+// checkNotFound marks the error of a call of the OpenAPI client when the response has the not found status, see IsPAPINotFound.
+func checkNotFound(httpResp *http.Response, err error) error {
+	if err != nil && httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		return notFoundError{err}
+	}
+	return err
+}
+
+// IsPAPINotFound returns whether the error is a PAPI response with the not found status.
+func IsPAPINotFound(err error) bool {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+	return errors.As(err, &notFoundError{})
+}
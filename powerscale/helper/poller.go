@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// PollInterval is the first wait between two polls of a long-running operation, doubled after every poll up to PollMaxInterval.
+var (
+	PollInterval    = time.Second
+	PollMaxInterval = 30 * time.Second
+)
+
+// ErrPollTerminalState is the error of a PollError for an operation in a state it can no longer complete from.
+var ErrPollTerminalState = errors.New("the operation reached a state it cannot complete from")
+
+// PollError is returned by Poll when the context of the operation is done before the operation,
+// or by a PollFunc seeing the operation in a terminal state. It holds the last observed status of the operation.
+type PollError struct {
+	Status string
+	Err    error
+}
+
+// Error describes the timeout and the last observed status.
+func (e *PollError) Error() string {
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		return fmt.Sprintf("timed out waiting for the operation to complete, last observed status: %s. "+
+			"Increase the timeouts of the resource if the operation is expected to take longer", e.Status)
+	}
+	return fmt.Sprintf("%s while waiting for the operation to complete, last observed status: %s", e.Err, e.Status)
+}
+
+// Unwrap returns the error of the context.
+func (e *PollError) Unwrap() error {
+	return e.Err
+}
+
+// PollFunc checks a long-running operation. It returns whether the operation is done and a description of its status.
+type PollFunc func(ctx context.Context) (done bool, status string, err error)
+
+// Poll calls check until the operation is done, waiting with an exponential backoff between the calls.
+// It gives up with a *PollError once the context is done, so the deadline of the operation is set on the context,
+// usually from the timeouts of the resource.
+func Poll(ctx context.Context, check PollFunc) error {
+	status := "unknown"
+	interval := PollInterval
+	for {
+		done, observed, err := check(ctx)
+		if len(observed) > 0 {
+			status = observed
+		}
+		if err != nil {
+			// a request cancelled by the deadline is reported as the deadline
+			if ctx.Err() != nil {
+				return &PollError{Status: status, Err: ctx.Err()}
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+		tflog.Debug(ctx, "Waiting for operation", map[string]interface{}{"status": status, "interval": interval.String()})
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &PollError{Status: status, Err: ctx.Err()}
+		case <-timer.C:
+		}
+		interval = min(interval*2, PollMaxInterval)
+	}
+}
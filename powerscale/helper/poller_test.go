@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoll(t *testing.T) {
+	PollInterval, PollMaxInterval = time.Millisecond, 4*time.Millisecond
+	defer func() { PollInterval, PollMaxInterval = time.Second, 30*time.Second }()
+
+	// done after a few polls
+	calls := 0
+	err := Poll(context.Background(), func(ctx context.Context) (bool, string, error) {
+		calls++
+		return calls == 3, fmt.Sprintf("poll %d", calls), nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, calls)
+
+	// errors of the check are returned as is
+	mockErr := errors.New("mock error")
+	err = Poll(context.Background(), func(ctx context.Context) (bool, string, error) {
+		return false, "", mockErr
+	})
+	assert.Equal(t, mockErr, err)
+
+	// the deadline is reported with the last observed status
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = Poll(ctx, func(ctx context.Context) (bool, string, error) {
+		return false, "job 1 (SnapRevert) is paused_user", nil
+	})
+	var pollErr *PollError
+	assert.True(t, errors.As(err, &pollErr))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Equal(t, "job 1 (SnapRevert) is paused_user", pollErr.Status)
+	assert.Contains(t, err.Error(), "timed out")
+
+	// a terminal state is reported without waiting for the deadline
+	err = Poll(context.Background(), func(ctx context.Context) (bool, string, error) {
+		return false, "failed", &PollError{Status: "failed", Err: ErrPollTerminalState}
+	})
+	assert.True(t, errors.As(err, &pollErr))
+	assert.True(t, errors.Is(err, ErrPollTerminalState))
+	assert.Equal(t, "failed", pollErr.Status)
+
+	// a request cancelled by the deadline is reported as the deadline
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = Poll(ctx, func(ctx context.Context) (bool, string, error) {
+		select {
+		case <-ctx.Done():
+			return false, "", ctx.Err()
+		case <-time.After(time.Millisecond):
+			return false, "running", nil
+		}
+	})
+	assert.True(t, errors.As(err, &pollErr))
+	assert.Equal(t, "running", pollErr.Status)
+}
@@ -0,0 +1,402 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// QuotaNotificationThresholds are the quota thresholds a notification rule applies to.
+var QuotaNotificationThresholds = []string{"hard", "soft", "advisory"}
+
+// QuotaNotificationConditions are the conditions on a threshold sending a notification.
+var QuotaNotificationConditions = []string{"exceeded", "denied", "violated", "expired"}
+
+// quotaNotificationRule is a notification rule of a quota, or a default rule of the quota settings, as returned by PAPI.
+type quotaNotificationRule interface {
+	GetId() string
+	GetThreshold() string
+	GetCondition() string
+	GetActionAlert() bool
+	GetActionEmailOwner() bool
+	GetActionEmailAddress() string
+	GetEmailTemplate() string
+	GetHoldoff() int32
+	GetSchedule() string
+}
+
+// quotaNotificationActions is the body of a request setting the actions of a notification rule.
+type quotaNotificationActions interface {
+	SetActionAlert(bool)
+	SetActionEmailOwner(bool)
+	SetActionEmailAddress(string)
+	SetEmailTemplate(string)
+	SetHoldoff(int32)
+	SetSchedule(string)
+}
+
+// CreateQuotaNotification creates a notification rule on a quota and returns its ID.
+func CreateQuotaNotification(ctx context.Context, client *client.Client, quotaID string, rule models.QuotaNotificationRuleModel) (string, error) {
+	body := powerscale.V1QuotaNotification{
+		Threshold: rule.Threshold.ValueString(),
+		Condition: rule.Condition.ValueString(),
+	}
+	setQuotaNotificationActions(rule, &body)
+	created, _, err := client.PscaleOpenAPIClient.QuotaQuotasApi.CreateQuotaQuotasv1QuotaNotification(ctx, quotaID).V1QuotaNotification(body).Execute()
+	if err != nil {
+		return "", err
+	}
+	return created.GetId(), nil
+}
+
+// GetQuotaNotification returns a notification rule of a quota.
+func GetQuotaNotification(ctx context.Context, client *client.Client, quotaID, notificationID string) (*models.QuotaNotificationRuleModel, error) {
+	response, httpResp, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1QuotasQuotaNotification(ctx, notificationID, quotaID).Execute()
+	if err != nil {
+		return nil, checkNotFound(httpResp, err)
+	}
+	notifications := response.GetNotifications()
+	if len(notifications) == 0 {
+		return nil, notFoundError{fmt.Errorf("notification rule %s of quota %s not found", notificationID, quotaID)}
+	}
+	rule := newQuotaNotificationRuleModel(&notifications[0])
+	return &rule, nil
+}
+
+// UpdateQuotaNotification updates the actions of a notification rule of a quota.
+func UpdateQuotaNotification(ctx context.Context, client *client.Client, quotaID, notificationID string, rule models.QuotaNotificationRuleModel) error {
+	body := powerscale.V1QuotasQuotaNotification{}
+	setQuotaNotificationActions(rule, &body)
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1QuotasQuotaNotification(ctx, notificationID, quotaID).V1QuotasQuotaNotification(body).Execute()
+	return err
+}
+
+// DeleteQuotaNotification deletes a notification rule of a quota.
+func DeleteQuotaNotification(ctx context.Context, client *client.Client, quotaID, notificationID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1QuotasQuotaNotification(ctx, notificationID, quotaID).Execute()
+	return checkNotFound(httpResp, err)
+}
+
+// QuotaNotificationRuleOf returns the notification rule of the quota notification resource.
+func QuotaNotificationRuleOf(plan models.QuotaNotificationResourceModel) models.QuotaNotificationRuleModel {
+	return models.QuotaNotificationRuleModel{
+		ID:                 plan.ID,
+		Threshold:          plan.Threshold,
+		Condition:          plan.Condition,
+		ActionAlert:        plan.ActionAlert,
+		ActionEmailOwner:   plan.ActionEmailOwner,
+		ActionEmailAddress: plan.ActionEmailAddress,
+		EmailTemplate:      plan.EmailTemplate,
+		Holdoff:            plan.Holdoff,
+		Schedule:           plan.Schedule,
+	}
+}
+
+// UpdateQuotaNotificationState copies a notification rule of a quota into the resource state.
+func UpdateQuotaNotificationState(quotaID string, model *models.QuotaNotificationRuleModel, state *models.QuotaNotificationResourceModel) {
+	state.ID = model.ID
+	state.QuotaID = types.StringValue(quotaID)
+	state.Threshold = model.Threshold
+	state.Condition = model.Condition
+	state.ActionAlert = model.ActionAlert
+	state.ActionEmailOwner = model.ActionEmailOwner
+	state.ActionEmailAddress = model.ActionEmailAddress
+	state.EmailTemplate = model.EmailTemplate
+	state.Holdoff = model.Holdoff
+	state.Schedule = model.Schedule
+}
+
+// GetQuotaSettings returns the quota settings. The reports, default notification rules and mappings
+// are only read when they are managed in the prior state, or when all is set as on import.
+// The default notification rules are kept in the order of the prior state.
+func GetQuotaSettings(ctx context.Context, client *client.Client, prior models.QuotaSettingsResourceModel, all bool) (models.QuotaSettingsResourceModel, error) {
+	state := models.QuotaSettingsResourceModel{ID: types.StringValue("quota_settings")}
+	if prior.Reports != nil || all {
+		response, _, err := client.PscaleOpenAPIClient.QuotaApi.GetQuotav1SettingsReports(ctx).Execute()
+		if err != nil {
+			return state, err
+		}
+		settings := response.GetSettings()
+		state.Reports = &models.QuotaReportSettingsModel{
+			Schedule:        types.StringValue(settings.GetSchedule()),
+			ScheduledDir:    types.StringValue(settings.GetScheduledDir()),
+			ScheduledRetain: types.Int64Value(int64(settings.GetScheduledRetain())),
+			ManualDir:       types.StringValue(settings.GetManualDir()),
+			ManualRetain:    types.Int64Value(int64(settings.GetManualRetain())),
+			LiveDir:         types.StringValue(settings.GetLiveDir()),
+			LiveRetain:      types.Int64Value(int64(settings.GetLiveRetain())),
+		}
+	}
+	if prior.Notifications != nil || all {
+		rules, err := listQuotaSettingsNotifications(ctx, client)
+		if err != nil {
+			return state, err
+		}
+		state.Notifications = []models.QuotaNotificationRuleModel{}
+		state.Notifications = append(state.Notifications, orderQuotaNotificationRules(prior.Notifications, rules)...)
+	}
+	if prior.Mappings != nil || all {
+		mappings, err := listQuotaMappings(ctx, client)
+		if err != nil {
+			return state, err
+		}
+		state.Mappings = []models.QuotaMappingModel{}
+		state.Mappings = append(state.Mappings, mappings...)
+	}
+	return state, nil
+}
+
+// UpdateQuotaSettings updates the quota settings managed in the plan.
+// The default notification rules and mappings of the cluster are made the ones of the plan.
+func UpdateQuotaSettings(ctx context.Context, client *client.Client, plan models.QuotaSettingsResourceModel) error {
+	if plan.Reports != nil {
+		if err := updateQuotaReportSettings(ctx, client, *plan.Reports); err != nil {
+			return err
+		}
+	}
+	if plan.Notifications != nil {
+		if err := updateQuotaSettingsNotifications(ctx, client, plan.Notifications); err != nil {
+			return err
+		}
+	}
+	if plan.Mappings != nil {
+		if err := updateQuotaMappings(ctx, client, plan.Mappings); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateQuotaReportSettings(ctx context.Context, client *client.Client, plan models.QuotaReportSettingsModel) error {
+	body := powerscale.V1SettingsReportsExtended{}
+	changed := false
+	for _, field := range []struct {
+		value types.String
+		set   func(string)
+	}{
+		{plan.Schedule, body.SetSchedule},
+		{plan.ScheduledDir, body.SetScheduledDir},
+		{plan.ManualDir, body.SetManualDir},
+		{plan.LiveDir, body.SetLiveDir},
+	} {
+		if !field.value.IsNull() && !field.value.IsUnknown() {
+			field.set(field.value.ValueString())
+			changed = true
+		}
+	}
+	for _, field := range []struct {
+		value types.Int64
+		set   func(int32)
+	}{
+		{plan.ScheduledRetain, body.SetScheduledRetain},
+		{plan.ManualRetain, body.SetManualRetain},
+		{plan.LiveRetain, body.SetLiveRetain},
+	} {
+		if !field.value.IsNull() && !field.value.IsUnknown() {
+			field.set(int32(field.value.ValueInt64()))
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	_, err := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsReports(ctx).V1SettingsReports(body).Execute()
+	return err
+}
+
+// updateQuotaSettingsNotifications updates the default rules matching a rule of the plan by threshold and condition,
+// creates the missing ones and deletes the others.
+func updateQuotaSettingsNotifications(ctx context.Context, client *client.Client, plan []models.QuotaNotificationRuleModel) error {
+	rules, err := listQuotaSettingsNotifications(ctx, client)
+	if err != nil {
+		return err
+	}
+	used := make([]bool, len(rules))
+	for _, planned := range plan {
+		index := matchQuotaNotificationRule(planned, rules, used)
+		if index < 0 {
+			body := powerscale.V1SettingsNotification{
+				Threshold: planned.Threshold.ValueString(),
+				Condition: planned.Condition.ValueString(),
+			}
+			setQuotaNotificationActions(planned, &body)
+			if _, _, err := client.PscaleOpenAPIClient.QuotaApi.CreateQuotav1SettingsNotification(ctx).V1SettingsNotification(body).Execute(); err != nil {
+				return err
+			}
+			continue
+		}
+		used[index] = true
+		body := powerscale.V1SettingsNotificationExtendedExtended{}
+		setQuotaNotificationActions(planned, &body)
+		updateParam := client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsNotification(ctx, rules[index].ID.ValueString())
+		if _, err := updateParam.V1SettingsNotification(body).Execute(); err != nil {
+			return err
+		}
+	}
+	for index, rule := range rules {
+		if used[index] {
+			continue
+		}
+		httpResp, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1SettingsNotification(ctx, rule.ID.ValueString()).Execute()
+		if err := checkNotFound(httpResp, err); err != nil && !IsPAPINotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateQuotaMappings makes the mappings of the cluster the ones of the plan.
+func updateQuotaMappings(ctx context.Context, client *client.Client, plan []models.QuotaMappingModel) error {
+	mappings, err := listQuotaMappings(ctx, client)
+	if err != nil {
+		return err
+	}
+	current := map[string]string{}
+	for _, mapping := range mappings {
+		current[mapping.Domain.ValueString()] = mapping.Mapping.ValueString()
+	}
+	for _, planned := range plan {
+		domain, mapping := planned.Domain.ValueString(), planned.Mapping.ValueString()
+		existing, ok := current[domain]
+		delete(current, domain)
+		switch {
+		case !ok:
+			body := powerscale.V1SettingsMapping{Domain: domain, Mapping: mapping}
+			_, _, err = client.PscaleOpenAPIClient.QuotaApi.CreateQuotav1SettingsMapping(ctx).V1SettingsMapping(body).Execute()
+		case existing != mapping:
+			body := powerscale.V1SettingsMappingExtendedExtended{}
+			body.SetMapping(mapping)
+			_, err = client.PscaleOpenAPIClient.QuotaApi.UpdateQuotav1SettingsMapping(ctx, domain).V1SettingsMapping(body).Execute()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for domain := range current {
+		httpResp, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1SettingsMapping(ctx, domain).Execute()
+		if err := checkNotFound(httpResp, err); err != nil && !IsPAPINotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func listQuotaSettingsNotifications(ctx context.Context, client *client.Client) ([]models.QuotaNotificationRuleModel, error) {
+	response, _, err := client.PscaleOpenAPIClient.QuotaApi.ListQuotav1SettingsNotifications(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	notifications := response.GetNotifications()
+	rules := make([]models.QuotaNotificationRuleModel, 0, len(notifications))
+	for i := range notifications {
+		rules = append(rules, newQuotaNotificationRuleModel(&notifications[i]))
+	}
+	return rules, nil
+}
+
+func listQuotaMappings(ctx context.Context, client *client.Client) ([]models.QuotaMappingModel, error) {
+	response, _, err := client.PscaleOpenAPIClient.QuotaApi.ListQuotav1SettingsMappings(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	mappings := []models.QuotaMappingModel{}
+	for _, mapping := range response.GetMappings() {
+		mappings = append(mappings, models.QuotaMappingModel{
+			Domain:  types.StringValue(mapping.GetDomain()),
+			Mapping: types.StringValue(mapping.GetMapping()),
+		})
+	}
+	return mappings, nil
+}
+
+// setQuotaNotificationActions sets the actions of a notification rule set in the plan, the threshold and condition cannot be updated.
+func setQuotaNotificationActions(rule models.QuotaNotificationRuleModel, body quotaNotificationActions) {
+	if !rule.ActionAlert.IsNull() && !rule.ActionAlert.IsUnknown() {
+		body.SetActionAlert(rule.ActionAlert.ValueBool())
+	}
+	if !rule.ActionEmailOwner.IsNull() && !rule.ActionEmailOwner.IsUnknown() {
+		body.SetActionEmailOwner(rule.ActionEmailOwner.ValueBool())
+	}
+	if !rule.ActionEmailAddress.IsNull() && !rule.ActionEmailAddress.IsUnknown() {
+		body.SetActionEmailAddress(rule.ActionEmailAddress.ValueString())
+	}
+	if !rule.EmailTemplate.IsNull() && !rule.EmailTemplate.IsUnknown() {
+		body.SetEmailTemplate(rule.EmailTemplate.ValueString())
+	}
+	if !rule.Schedule.IsNull() && !rule.Schedule.IsUnknown() {
+		body.SetSchedule(rule.Schedule.ValueString())
+	}
+	if !rule.Holdoff.IsNull() && !rule.Holdoff.IsUnknown() {
+		body.SetHoldoff(int32(rule.Holdoff.ValueInt64()))
+	}
+}
+
+// matchQuotaNotificationRule returns the index of the first unused rule with the ID, or else the threshold and condition, of the planned rule.
+func matchQuotaNotificationRule(planned models.QuotaNotificationRuleModel, rules []models.QuotaNotificationRuleModel, used []bool) int {
+	if !planned.ID.IsNull() && !planned.ID.IsUnknown() {
+		for index, rule := range rules {
+			if !used[index] && rule.ID.ValueString() == planned.ID.ValueString() {
+				return index
+			}
+		}
+	}
+	for index, rule := range rules {
+		if !used[index] && rule.Threshold.ValueString() == planned.Threshold.ValueString() && rule.Condition.ValueString() == planned.Condition.ValueString() {
+			return index
+		}
+	}
+	return -1
+}
+
+// orderQuotaNotificationRules returns the rules in the order of the prior ones, followed by the rules not in the prior state.
+func orderQuotaNotificationRules(prior []models.QuotaNotificationRuleModel, rules []models.QuotaNotificationRuleModel) []models.QuotaNotificationRuleModel {
+	used := make([]bool, len(rules))
+	ordered := make([]models.QuotaNotificationRuleModel, 0, len(rules))
+	for _, planned := range prior {
+		if index := matchQuotaNotificationRule(planned, rules, used); index >= 0 {
+			used[index] = true
+			ordered = append(ordered, rules[index])
+		}
+	}
+	for index, rule := range rules {
+		if !used[index] {
+			ordered = append(ordered, rule)
+		}
+	}
+	return ordered
+}
+
+func newQuotaNotificationRuleModel(rule quotaNotificationRule) models.QuotaNotificationRuleModel {
+	return models.QuotaNotificationRuleModel{
+		ID:                 types.StringValue(rule.GetId()),
+		Threshold:          types.StringValue(rule.GetThreshold()),
+		Condition:          types.StringValue(rule.GetCondition()),
+		ActionAlert:        types.BoolValue(rule.GetActionAlert()),
+		ActionEmailOwner:   types.BoolValue(rule.GetActionEmailOwner()),
+		ActionEmailAddress: types.StringValue(rule.GetActionEmailAddress()),
+		EmailTemplate:      types.StringValue(rule.GetEmailTemplate()),
+		Holdoff:            types.Int64Value(int64(rule.GetHoldoff())),
+		Schedule:           types.StringValue(rule.GetSchedule()),
+	}
+}
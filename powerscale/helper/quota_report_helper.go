@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CreateQuotaReport generates a manual quota report and returns its ID.
+func CreateQuotaReport(ctx context.Context, client *client.Client) (string, error) {
+	created, _, err := client.PscaleOpenAPIClient.QuotaApi.CreateQuotav1QuotaReport(ctx).V1QuotaReport(map[string]interface{}{}).Execute()
+	if err != nil {
+		return "", err
+	}
+	return created.GetId(), nil
+}
+
+// ListQuotaReports returns the quota reports matching the filter.
+func ListQuotaReports(ctx context.Context, client *client.Client, filter *models.QuotaReportDataSourceFilter) ([]models.QuotaReportDataSourceEntity, error) {
+	listParam := client.PscaleOpenAPIClient.QuotaApi.ListQuotav1QuotaReports(ctx)
+	var ids []string
+	if filter != nil {
+		if !filter.Generated.IsNull() {
+			listParam = listParam.Generated(filter.Generated.ValueString())
+		}
+		if !filter.Type.IsNull() {
+			listParam = listParam.Type_(filter.Type.ValueString())
+		}
+		for _, id := range filter.IDs {
+			ids = append(ids, id.ValueString())
+		}
+	}
+	var reports []models.QuotaReportDataSourceEntity
+	for {
+		response, _, err := listParam.Execute()
+		if err != nil {
+			return nil, err
+		}
+		for _, report := range response.GetReports() {
+			if len(ids) == 0 || slices.Contains(ids, report.GetId()) {
+				reports = append(reports, models.QuotaReportDataSourceEntity{
+					ID:        types.StringValue(report.GetId()),
+					Time:      types.Int64Value(int64(report.GetTime())),
+					Generated: types.StringValue(report.GetGenerated()),
+					Type:      types.StringValue(report.GetType()),
+				})
+			}
+		}
+		resume := response.GetResume()
+		if len(resume) == 0 {
+			return reports, nil
+		}
+		listParam = client.PscaleOpenAPIClient.QuotaApi.ListQuotav1QuotaReports(ctx).Resume(resume)
+	}
+}
+
+// FindQuotaReport returns the quota report with the given ID, or nil when there is none.
+// The report is looked up in the list, as reading a report returns its content.
+func FindQuotaReport(ctx context.Context, client *client.Client, reportID string) (*models.QuotaReportDataSourceEntity, error) {
+	reports, err := ListQuotaReports(ctx, client, &models.QuotaReportDataSourceFilter{
+		IDs:       []types.String{types.StringValue(reportID)},
+		Generated: types.StringNull(),
+		Type:      types.StringNull(),
+	})
+	if err != nil || len(reports) == 0 {
+		return nil, err
+	}
+	return &reports[0], nil
+}
+
+// WaitForQuotaReport waits until the quota report with the given ID is listed, as a manual report is generated asynchronously, and returns it.
+func WaitForQuotaReport(ctx context.Context, client *client.Client, reportID string) (*models.QuotaReportDataSourceEntity, error) {
+	var report *models.QuotaReportDataSourceEntity
+	err := Poll(ctx, func(ctx context.Context) (bool, string, error) {
+		var err error
+		report, err = FindQuotaReport(ctx, client, reportID)
+		if err != nil {
+			return false, "", err
+		}
+		return report != nil, fmt.Sprintf("quota report %s is not listed yet", reportID), nil
+	})
+	return report, err
+}
+
+// DeleteQuotaReport deletes a quota report.
+func DeleteQuotaReport(ctx context.Context, client *client.Client, reportID string) error {
+	httpResp, err := client.PscaleOpenAPIClient.QuotaApi.DeleteQuotav1QuotaReport(ctx, reportID).Execute()
+	return checkNotFound(httpResp, err)
+}
+
+// UpdateQuotaReportState copies a quota report into the resource state.
+func UpdateQuotaReportState(report *models.QuotaReportDataSourceEntity, state *models.QuotaReportResourceModel) {
+	state.ID = report.ID
+	state.Time = report.Time
+	state.Generated = report.Generated
+	state.Type = report.Type
+}
@@ -47,14 +47,17 @@ type SettingsSetter interface {
 	SetManageProtectionApplyToFiles(types.Bool)
 }
 
+// smartPoolSettingsV16Requirement selects the v16 storagepool settings endpoints over the v5 ones.
+var smartPoolSettingsV16Requirement = VersionRequirement{MinVersion: "9.5.0"}
+
 // GetSmartPoolSettings Get SmartPool settings based on Onefs version.
 func GetSmartPoolSettings(ctx context.Context, powerscaleClient *client.Client) (any, error) {
-	onfsVersion, err := powerscaleClient.GetOnefsVersion()
+	v16, err := MeetsVersionRequirement(ctx, powerscaleClient, smartPoolSettingsV16Requirement)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get OneFS version: %v", err)
+		return nil, err
 	}
 
-	if onfsVersion.IsGreaterThan("9.4.0") {
+	if v16 {
 		settings, _, err := powerscaleClient.PscaleOpenAPIClient.StoragepoolApi.GetStoragepoolv16StoragepoolSettings(ctx).Execute()
 		return settings, err
 	}
@@ -260,12 +263,12 @@ func BigFloatToInt32(x *big.Float) (*int32, error) {
 
 // UpdateSmartPoolSettings apply SmartPool Settings changes on PowerScale.
 func UpdateSmartPoolSettings(ctx context.Context, client *client.Client, model *models.SmartPoolSettingsResource) error {
-	onfsVersion, err := client.GetOnefsVersion()
+	v16, err := MeetsVersionRequirement(ctx, client, smartPoolSettingsV16Requirement)
 	if err != nil {
-		return fmt.Errorf("failed to get OneFS version: %v", err)
+		return err
 	}
 
-	if onfsVersion.IsGreaterThan("9.4.0") {
+	if v16 {
 		updateParam := client.PscaleOpenAPIClient.StoragepoolApi.UpdateStoragepoolv16StoragepoolSettings(ctx)
 		settings := powerscale.V16StoragepoolSettingsExtended{}
 
@@ -54,10 +54,13 @@ func GetAllSnapshots(ctx context.Context, client *client.Client, state *models.S
 	}
 
 	result, _, err := snapshotParams.Execute()
+	if err != nil {
+		return nil, err
+	}
 
 	//pagination
 	for result.Resume != nil && (state.SnapshotFilter != nil || state.SnapshotFilter.Limit.IsNull()) {
-		respAdd, _, errAdd := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(context.Background()).Resume(*result.Resume).Execute()
+		respAdd, _, errAdd := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv1SnapshotSnapshots(ctx).Resume(*result.Resume).Execute()
 		if errAdd != nil {
 			return result.Snapshots, errAdd
 		}
@@ -138,7 +141,15 @@ func SnapshotResourceDetailMapper(ctx context.Context, snap powerscale.Createv1S
 
 // CalclulateExpire Calculates the Unix Epic based on 1 day, 1 week or 1 month from the current date and time.
 func CalclulateExpire(setExpireValue string) int32 {
-	expireTime := time.Now().Unix()
+	return int32(CalculateExpireFrom(time.Now(), setExpireValue))
+}
+
+// SnapshotExpireValues are the accepted values of set_expires.
+var SnapshotExpireValues = []string{"Never", "1 Day", "1 Week", "1 Month"}
+
+// CalculateExpireFrom Calculates the Unix Epoch 1 day, 1 week or 1 month after the given time, 0 for Never.
+func CalculateExpireFrom(from time.Time, setExpireValue string) int64 {
+	expireTime := from.Unix()
 	// 86400 is the Epoch day in seconds
 	switch setExpireValue {
 	case "Never":
@@ -150,5 +161,5 @@ func CalclulateExpire(setExpireValue string) int32 {
 	case "1 Month":
 		expireTime = expireTime + (86400 * 30)
 	}
-	return int32(expireTime)
+	return expireTime
 }
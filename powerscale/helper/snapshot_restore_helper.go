@@ -20,12 +20,13 @@ package helper
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/constants"
 	"terraform-provider-powerscale/powerscale/models"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -175,15 +176,20 @@ func ManageSnapshotRestore(ctx context.Context, client *client.Client, plan mode
 		}
 
 		jobResponse, diag = CheckJobStatus(ctx, client, strID, jobResponse)
-		resp.Append(diag...)
+		if diag.HasError() {
+			return state, diag
+		}
 
-		if jobResponse.State == "failed" {
+		if jobResponse.State != "succeeded" {
 			resp.AddError(
 				"Error getting job report",
-				"Please check if snaprevert domain is created",
+				fmt.Sprintf("Please check if snaprevert domain is created, %s", JobStatus(jobResponse)),
 			)
 			return state, resp
 		}
+		// the SnapRevert job identifies the restore on import
+		state.ID = types.StringValue(strID)
+		return state, nil
 	} else if !plan.CopyParams.IsNull() {
 		var copyParams models.CopyParamsModel
 		diag := plan.CopyParams.As(ctx, &copyParams, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
@@ -259,25 +265,120 @@ func ManageSnapshotRestore(ctx context.Context, client *client.Client, plan mode
 	return state, nil
 }
 
-// CheckJobStatus checks the job status.
+// GetSnapRevertParams returns the parameters of a succeeded SnapRevert job, to import its snapshot restore.
+// The snaprevert domain to delete with the resource is rebuilt from the path of the snapshot, and checked not to be
+// deleted by a later DomainMark job since the revert.
+// allow_dup is only set when the job allowed duplicates, as it is usually left out of the configuration.
+func GetSnapRevertParams(ctx context.Context, client *client.Client, jobID string) (*models.SnapRevertParamsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	job, err := GetSnapshotRestoreJob(ctx, client, jobID)
+	if err != nil {
+		errStr := constants.ReadSnapshotRestoreJobErrorMsg + "with error: "
+		diags.AddError("Error getting job", GetErrorString(err, errStr))
+		return nil, diags
+	}
+	if job.Type != "SnapRevert" || !job.HasSnaprevertParams() {
+		diags.AddError("Error importing snapshot restore",
+			fmt.Sprintf("Job %s is a %s job, only SnapRevert jobs can be imported", jobID, job.Type))
+		return nil, diags
+	}
+	if job.State != "succeeded" {
+		diags.AddError("Error importing snapshot restore",
+			fmt.Sprintf("SnapRevert job %s is %s, only succeeded SnapRevert jobs can be imported", jobID, job.State))
+		return nil, diags
+	}
+	snapID := job.GetSnaprevertParams().Snapid
+	snapshot, err := GetSpecificSnapshot(ctx, client, strconv.Itoa(int(snapID)))
+	if err != nil {
+		errStr := constants.ReadSnapshotErrorMessage + "with error: "
+		diags.AddError(fmt.Sprintf("Error getting the snapshot with id %d of the snaprevert domain", snapID), GetErrorString(err, errStr))
+		return nil, diags
+	}
+	deletion, err := findSnaprevertDomainDeletion(ctx, client, snapshot.Path, job.Id)
+	if err != nil {
+		errStr := constants.ReadSnapshotRestoreJobErrorMsg + "with error: "
+		diags.AddError(fmt.Sprintf("Error checking the snaprevert domain %s", snapshot.Path), GetErrorString(err, errStr))
+		return nil, diags
+	}
+	if deletion != nil {
+		diags.AddError("Error importing snapshot restore",
+			fmt.Sprintf("The snaprevert domain %s of SnapRevert job %s was deleted by DomainMark job %d", snapshot.Path, jobID, deletion.Id))
+		return nil, diags
+	}
+
+	params := &models.SnapRevertParamsModel{SnapID: types.Int32Value(snapID), AllowDup: types.BoolNull()}
+	if job.GetAllowDup() {
+		params.AllowDup = types.BoolValue(true)
+	}
+	return params, diags
+}
+
+// findSnaprevertDomainDeletion returns the succeeded DomainMark job deleting the snaprevert domain at the root
+// after the job with the given ID, or nil when the domain was not deleted since.
+func findSnaprevertDomainDeletion(ctx context.Context, client *client.Client, root string, afterJobID int32) (*powerscale.V10JobJobExtended, error) {
+	jobs, err := ListJobs(ctx, client, &models.JobDataSourceFilter{
+		State: types.StringValue("succeeded"),
+		Types: []types.String{types.StringValue("DomainMark")},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		params := jobs[i].GetDomainmarkParams()
+		if jobs[i].Id > afterJobID && params.Type == "SnapRevert" && params.Root == root && params.GetDelete() {
+			return &jobs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CheckJobStatus waits until the job is done, as long as the context allows.
+// On timeout, the diagnostics report the last observed state and progress of the job.
 func CheckJobStatus(ctx context.Context, client *client.Client, jobID string, response *powerscale.V10JobJobExtended) (res *powerscale.V10JobJobExtended, resp diag.Diagnostics) {
-	var err error
-	for !(response.State == "succeeded" || response.State == "failed") {
-		time.Sleep(time.Second)
-		response, err = GetSnapshotRestoreJob(ctx, client, jobID)
-		if err != nil {
-			errStr := constants.ReadSnapshotRestoreJobErrorMsg + "with error: "
-			message := GetErrorString(err, errStr)
-			resp.AddError(
-				"Error getting job",
-				message,
-			)
+	err := Poll(ctx, func(ctx context.Context) (bool, string, error) {
+		if response == nil || !IsJobDone(response.State) {
+			job, err := GetSnapshotRestoreJob(ctx, client, jobID)
+			if err != nil {
+				return false, "", err
+			}
+			response = job
+		}
+		return IsJobDone(response.State), JobStatus(response), nil
+	})
+	if err != nil {
+		var pollErr *PollError
+		if errors.As(err, &pollErr) {
+			resp.AddError(fmt.Sprintf("Error waiting for job %s", jobID), err.Error())
 			return nil, resp
 		}
+		errStr := constants.ReadSnapshotRestoreJobErrorMsg + "with error: "
+		message := GetErrorString(err, errStr)
+		resp.AddError(
+			"Error getting job",
+			message,
+		)
+		return nil, resp
 	}
 	return response, nil
 }
 
+// IsJobDone returns whether a job in the given state has ended, successfully or not.
+func IsJobDone(state string) bool {
+	return state == "succeeded" || state == "failed" || strings.HasPrefix(state, "cancelled")
+}
+
+// JobStatus describes the state and progress of a job.
+func JobStatus(job *powerscale.V10JobJobExtended) string {
+	status := fmt.Sprintf("job %d (%s) is %s", job.Id, job.Type, job.State)
+	if job.GetTotalPhases() > 0 {
+		status += fmt.Sprintf(", phase %d of %d", job.GetCurrentPhase(), job.GetTotalPhases())
+	}
+	if progress := job.GetProgress(); len(progress) > 0 {
+		status += ", progress: " + progress
+	}
+	return status
+}
+
 // DeleteSnaprevertDomain deletes the snaprevert domain.
 func DeleteSnaprevertDomain(ctx context.Context, client *client.Client, state models.SnapshotRestoreModel) (resp diag.Diagnostics) {
 	var snapRevert models.SnapRevertParamsModel
@@ -337,10 +438,10 @@ func DeleteSnaprevertDomain(ctx context.Context, client *client.Client, state mo
 	if diag.HasError() {
 		return diag
 	}
-	if jobResponse.State == "failed" {
+	if jobResponse.State != "succeeded" {
 		resp.AddError(
 			"Error while deleting snaprevert domain",
-			"Error while deleting snaprevert domain",
+			fmt.Sprintf("Error while deleting snaprevert domain, %s", JobStatus(jobResponse)),
 		)
 		return resp
 	}
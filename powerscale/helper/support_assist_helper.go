@@ -20,10 +20,10 @@ package helper
 import (
 	"context"
 	powerscale "dell/powerscale-go-client"
+	"errors"
 	"fmt"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
-	"time"
 
 	"terraform-provider-powerscale/powerscale/constants"
 
@@ -96,6 +96,25 @@ func GetClusterVersion(ctx context.Context, client *client.Client) (string, erro
 	return clusterVersion.Nodes[0].Release, err
 }
 
+// supportAssistTaskState returns the state of a support assist task.
+func supportAssistTaskState(response *powerscale.V16SupportassistTaskId) string {
+	if response == nil {
+		return "unknown"
+	}
+	tasks := response.GetTasks()
+	return tasks.GetState()
+}
+
+// isSupportAssistTaskDone returns whether a support assist task has ended, successfully or not.
+func isSupportAssistTaskDone(response *powerscale.V16SupportassistTaskId) bool {
+	if response == nil {
+		return false
+	}
+	tasks := response.GetTasks()
+	state := tasks.GetState()
+	return state == "COMPLETED" || state == "FAILED" || tasks.GetErrorMsg() != ""
+}
+
 // ManageSupportAssist manages the support assist settings.
 func ManageSupportAssist(ctx context.Context, client *client.Client, plan models.SupportAssistModel) (state models.SupportAssistModel, resp diag.Diagnostics) {
 	// Update support assist terms status
@@ -223,15 +242,25 @@ func ManageSupportAssist(ctx context.Context, client *client.Client, plan models
 			return state, resp
 		}
 
-		jobState := "COMPLETED"
-		for *response.Tasks.State != jobState {
-			time.Sleep(time.Second)
-			if clusterVersion == "9.5.0.0" {
-				response, err = GetSupportAssistv16Task(ctx, client, taskCreate.TaskId)
-			} else {
-				response, err = GetSupportAssistv17Task(ctx, client, taskCreate.TaskId)
+		err = Poll(ctx, func(ctx context.Context) (bool, string, error) {
+			if !isSupportAssistTaskDone(response) {
+				var err error
+				if clusterVersion == "9.5.0.0" {
+					response, err = GetSupportAssistv16Task(ctx, client, taskCreate.TaskId)
+				} else {
+					response, err = GetSupportAssistv17Task(ctx, client, taskCreate.TaskId)
+				}
+				if err != nil {
+					return false, "", err
+				}
 			}
-			if err != nil {
+			return isSupportAssistTaskDone(response), fmt.Sprintf("task %s is %s", taskCreate.TaskId, supportAssistTaskState(response)), nil
+		})
+		if err != nil {
+			var pollErr *PollError
+			if errors.As(err, &pollErr) {
+				resp.AddError("Error waiting for support assist task", err.Error())
+			} else {
 				errStr := constants.GetSupportAssistTaskErrorMsg + "with error: "
 				message := GetErrorString(err, errStr)
 				resp.AddError(
@@ -239,6 +268,8 @@ func ManageSupportAssist(ctx context.Context, client *client.Client, plan models
 					message,
 				)
 			}
+			state, _ = ReadSupportAssistDetails(ctx, client, plan)
+			return state, resp
 		}
 
 		if response.Tasks.ErrorMsg != "" {
@@ -25,7 +25,7 @@ import (
 
 // CreatePeerCert creates a Peer Certificate.
 func CreatePeerCert(ctx context.Context, client *client.Client, req powerscale.V7CertificateAuthorityItem) (string, error) {
-	resp, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv7CertificatesPeerItem(context.Background()).V7CertificatesPeerItem(req).Execute()
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv7CertificatesPeerItem(ctx).V7CertificatesPeerItem(req).Execute()
 	if err != nil {
 		return "", err
 	}
@@ -34,18 +34,18 @@ func CreatePeerCert(ctx context.Context, client *client.Client, req powerscale.V
 
 // ReadPeerCert reads a Peer Certificate.
 func ReadPeerCert(ctx context.Context, client *client.Client, id string) (*powerscale.V16CertificatesSyslogExtended, error) {
-	resp, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv7CertificatesPeerById(context.Background(), id).Execute()
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv7CertificatesPeerById(ctx, id).Execute()
 	return resp, err
 }
 
 // ListPeerCerts lists all Peer Certificates.
 func ListPeerCerts(ctx context.Context, client *client.Client) (*powerscale.V7CertificatesPeer, error) {
-	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv7CertificatesPeer(context.Background()).Execute()
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv7CertificatesPeer(ctx).Execute()
 	if err != nil {
 		return resp, err
 	}
 	if resp.Resume != nil {
-		respAdd, _, errAdd := client.PscaleOpenAPIClient.SyncApi.ListSyncv7CertificatesPeer(context.Background()).Resume(*resp.Resume).Execute()
+		respAdd, _, errAdd := client.PscaleOpenAPIClient.SyncApi.ListSyncv7CertificatesPeer(ctx).Resume(*resp.Resume).Execute()
 		if errAdd != nil {
 			return resp, errAdd
 		}
@@ -57,12 +57,12 @@ func ListPeerCerts(ctx context.Context, client *client.Client) (*powerscale.V7Ce
 
 // UpdatePeerCert updates a Peer Certificate.
 func UpdatePeerCert(ctx context.Context, client *client.Client, id string, req powerscale.V16CertificatesSyslogIdParams) error {
-	_, err := client.PscaleOpenAPIClient.SyncApi.UpdateSyncv7CertificatesPeerById(context.Background(), id).V7CertificatesPeerIdParams(req).Execute()
+	_, err := client.PscaleOpenAPIClient.SyncApi.UpdateSyncv7CertificatesPeerById(ctx, id).V7CertificatesPeerIdParams(req).Execute()
 	return err
 }
 
 // DeletePeerCert deletes a Peer Certificate.
 func DeletePeerCert(ctx context.Context, client *client.Client, id string) error {
-	_, err := client.PscaleOpenAPIClient.SyncApi.DeleteSyncv7CertificatesPeerById(context.Background(), id).Execute()
+	_, err := client.PscaleOpenAPIClient.SyncApi.DeleteSyncv7CertificatesPeerById(ctx, id).Execute()
 	return err
 }
@@ -31,12 +31,12 @@ import (
 
 // GetAllSyncIQPolicies retrieve the cluster information.
 func GetAllSyncIQPolicies(ctx context.Context, client *client.Client) (*powerscale.V14SyncPolicies, error) {
-	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv14SyncPolicies(context.Background()).Execute()
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv14SyncPolicies(ctx).Execute()
 	if err != nil {
 		return resp, err
 	}
 	for resp.Resume != nil {
-		respAdd, _, errAdd := client.PscaleOpenAPIClient.SyncApi.ListSyncv14SyncPolicies(context.Background()).Resume(*resp.Resume).Execute()
+		respAdd, _, errAdd := client.PscaleOpenAPIClient.SyncApi.ListSyncv14SyncPolicies(ctx).Resume(*resp.Resume).Execute()
 		if errAdd != nil {
 			return resp, errAdd
 		}
@@ -64,7 +64,7 @@ func GetSyncIQPolicyIDByName(ctx context.Context, client *client.Client, name st
 
 // GetSyncIQPolicyByID retrieve the cluster information.
 func GetSyncIQPolicyByID(ctx context.Context, client *client.Client, id string) (*powerscale.V14SyncPoliciesExtended, error) {
-	resp, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv14SyncPolicy(context.Background(), id).Execute()
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv14SyncPolicy(ctx, id).Execute()
 	return resp, err
 }
 
@@ -31,7 +31,7 @@ import (
 
 // GetSyncIQReplicationJobs gets the list of SyncIQ jobs.
 func GetSyncIQReplicationJobs(ctx context.Context, client *client.Client, filter *models.SyncIQJobFilterModel) (*powerscale.V7SyncJobs, error) {
-	jobParams := client.PscaleOpenAPIClient.SyncApi.ListSyncv7SyncJobs(context.Background())
+	jobParams := client.PscaleOpenAPIClient.SyncApi.ListSyncv7SyncJobs(ctx)
 	if filter != nil {
 		if !filter.Sort.IsNull() {
 			jobParams = jobParams.Sort(filter.Sort.ValueString())
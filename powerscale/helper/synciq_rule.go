@@ -33,13 +33,13 @@ import (
 
 // GetAllSyncIQRules retrieve the cluster information.
 func GetAllSyncIQRules(ctx context.Context, client *client.Client) (*powerscale.V3SyncRules, error) {
-	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv3SyncRules(context.Background()).Execute()
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.ListSyncv3SyncRules(ctx).Execute()
 	if err != nil {
 		return resp, err
 	}
 	// Pagination
 	for resp.Resume != "" {
-		respAdd, _, errAdd := client.PscaleOpenAPIClient.SyncApi.ListSyncv3SyncRules(context.Background()).Resume(resp.Resume).Execute()
+		respAdd, _, errAdd := client.PscaleOpenAPIClient.SyncApi.ListSyncv3SyncRules(ctx).Resume(resp.Resume).Execute()
 		if errAdd != nil {
 			return resp, errAdd
 		}
@@ -51,13 +51,13 @@ func GetAllSyncIQRules(ctx context.Context, client *client.Client) (*powerscale.
 
 // GetSyncIQRuleByID retrieve the cluster information.
 func GetSyncIQRuleByID(ctx context.Context, client *client.Client, id string) (*powerscale.V3SyncRulesExtended, error) {
-	resp, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv3SyncRule(context.Background(), id).Execute()
+	resp, _, err := client.PscaleOpenAPIClient.SyncApi.GetSyncv3SyncRule(ctx, id).Execute()
 	return resp, err
 }
 
 // CreateSyncIQRule creates SyncIQRule.
 func CreateSyncIQRule(ctx context.Context, client *client.Client, v3SyncRule powerscale.V3SyncRule) (string, error) {
-	respC, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv3SyncRule(context.Background()).V3SyncRule(v3SyncRule).Execute()
+	respC, _, err := client.PscaleOpenAPIClient.SyncApi.CreateSyncv3SyncRule(ctx).V3SyncRule(v3SyncRule).Execute()
 	if err != nil {
 		return "", err
 	}
@@ -72,13 +72,13 @@ func UpdateSyncIQRule(ctx context.Context, client *client.Client, id string, v3S
 		Limit:       &v3SyncRule.Limit,
 		Schedule:    v3SyncRule.Schedule,
 	}
-	_, err := client.PscaleOpenAPIClient.SyncApi.UpdateSyncv3SyncRule(context.Background(), id).V3SyncRule(req).Execute()
+	_, err := client.PscaleOpenAPIClient.SyncApi.UpdateSyncv3SyncRule(ctx, id).V3SyncRule(req).Execute()
 	return err
 }
 
 // DeleteSyncIQRule deletes SyncIQRule.
 func DeleteSyncIQRule(ctx context.Context, client *client.Client, id string) error {
-	_, err := client.PscaleOpenAPIClient.SyncApi.DeleteSyncv3SyncRule(context.Background(), id).Execute()
+	_, err := client.PscaleOpenAPIClient.SyncApi.DeleteSyncv3SyncRule(ctx, id).Execute()
 	return err
 }
 
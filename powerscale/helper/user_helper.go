@@ -210,7 +210,20 @@ func GetUsersWithFilter(ctx context.Context, client *client.Client, filter *mode
 }
 
 // GetAllRolesWithZone returns all roles in specific zone.
-func GetAllRolesWithZone(ctx context.Context, client *client.Client, zone string) (roles []powerscale.V1AuthRoleExtended, err error) {
+// The roles are listed once for all the users and groups of a run, until the client changes the cluster.
+func GetAllRolesWithZone(ctx context.Context, powerscaleClient *client.Client, zone string) ([]powerscale.V1AuthRoleExtended, error) {
+	roles, err := client.Cached(ctx, powerscaleClient, "auth/roles?zone="+zone, func(ctx context.Context) ([]powerscale.V1AuthRoleExtended, error) {
+		return listRolesWithZone(ctx, powerscaleClient, zone)
+	})
+	if err != nil {
+		return make([]powerscale.V1AuthRoleExtended, 0), err
+	}
+	// callers own the returned slice
+	return append(make([]powerscale.V1AuthRoleExtended, 0, len(roles)), roles...), nil
+}
+
+// listRolesWithZone lists all roles in specific zone.
+func listRolesWithZone(ctx context.Context, client *client.Client, zone string) (roles []powerscale.V1AuthRoleExtended, err error) {
 	roles = make([]powerscale.V1AuthRoleExtended, 0)
 	emptyRoles := make([]powerscale.V1AuthRoleExtended, 0)
 
@@ -288,6 +301,9 @@ func CreateUser(ctx context.Context, client *client.Client, plan *models.UserRes
 		PromptPasswordChange: plan.PromptPasswordChange.ValueBoolPointer(),
 		PasswordExpires:      plan.PasswordExpires.ValueBoolPointer(),
 	}
+	if !plan.PasswordWO.IsNull() {
+		body.Password = plan.PasswordWO.ValueStringPointer()
+	}
 	if !plan.Expiry.IsNull() && plan.Expiry.ValueInt64() > 0 {
 		body.Expiry = plan.Expiry.ValueInt64Pointer()
 	}
@@ -382,6 +398,10 @@ func UpdateUser(ctx context.Context, client *client.Client, state *models.UserRe
 	if !plan.Password.IsNull() && plan.Password.ValueString() != state.Password.ValueString() {
 		body.Password = plan.Password.ValueStringPointer()
 	}
+	// write-only passwords are only set in the plan when they must be sent
+	if !plan.PasswordWO.IsNull() {
+		body.Password = plan.PasswordWO.ValueStringPointer()
+	}
 	if !state.Shell.Equal(plan.Shell) && plan.Shell.ValueString() != "" {
 		body.Shell = plan.Shell.ValueStringPointer()
 	}
@@ -0,0 +1,115 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// VersionRequirement declares the OneFS versions supporting an attribute of a resource.
+type VersionRequirement struct {
+	// Attribute matches the gated attributes, the whole resource is gated when empty.
+	Attribute path.Expression
+	// MinVersion is the first supported version, e.g. "9.5.0". No lower bound when empty.
+	MinVersion string
+	// MaxVersion is the last supported version, compared on major, minor and patch. No upper bound when empty.
+	MaxVersion string
+}
+
+// CheckVersionRequirements validates the configuration of a resource against the OneFS version of the cluster.
+// An attribute requirement only applies when the attribute is set in the configuration,
+// and the version is only read from the cluster when a requirement applies.
+func CheckVersionRequirements(ctx context.Context, client *client.Client, config tfsdk.Config, requirements []VersionRequirement) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil {
+		return diags
+	}
+
+	type gated struct {
+		requirement VersionRequirement
+		path        path.Path
+	}
+	var applicable []gated
+	for _, requirement := range requirements {
+		if len(requirement.Attribute.Steps()) == 0 {
+			applicable = append(applicable, gated{requirement: requirement})
+			continue
+		}
+		paths, pathDiags := config.PathMatches(ctx, requirement.Attribute)
+		diags.Append(pathDiags...)
+		for _, p := range paths {
+			var value attr.Value
+			diags.Append(config.GetAttribute(ctx, p, &value)...)
+			if value != nil && !value.IsNull() {
+				applicable = append(applicable, gated{requirement: requirement, path: p})
+			}
+		}
+	}
+	if diags.HasError() || len(applicable) == 0 {
+		return diags
+	}
+
+	version, err := client.GetOnefsVersion(ctx)
+	if err != nil {
+		diags.AddError("Error reading the OneFS version", GetErrorString(err, constants.ReadOnefsVersionErrorMsg+"with error: "))
+		return diags
+	}
+	for _, item := range applicable {
+		if item.requirement.IsMetBy(version) {
+			continue
+		}
+		needed := fmt.Sprintf("OneFS %s or earlier", item.requirement.MaxVersion)
+		if len(item.requirement.MinVersion) > 0 && version.IsLessThan(item.requirement.MinVersion) {
+			needed = fmt.Sprintf("OneFS %s or later", item.requirement.MinVersion)
+		}
+		if item.path.Equal(path.Empty()) {
+			diags.AddError("Unsupported OneFS version",
+				fmt.Sprintf("This resource requires %s, cluster is %s.", needed, version.ReleaseString()))
+			continue
+		}
+		diags.AddAttributeError(item.path, "Unsupported OneFS version",
+			fmt.Sprintf("Attribute %s requires %s, cluster is %s.", item.path, needed, version.ReleaseString()))
+	}
+	return diags
+}
+
+// IsMetBy reports whether the version is within the bounds of the requirement.
+func (r VersionRequirement) IsMetBy(version *client.OnefsVersion) bool {
+	if len(r.MinVersion) > 0 && version.IsLessThan(r.MinVersion) {
+		return false
+	}
+	return len(r.MaxVersion) == 0 || !version.IsGreaterThan(r.MaxVersion)
+}
+
+// MeetsVersionRequirement reports whether the cluster satisfies the requirement,
+// it selects between the versioned endpoints of an API.
+func MeetsVersionRequirement(ctx context.Context, client *client.Client, requirement VersionRequirement) (bool, error) {
+	version, err := client.GetOnefsVersion(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get OneFS version: %v", err)
+	}
+	return requirement.IsMetBy(version), nil
+}
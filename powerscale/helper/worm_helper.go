@@ -0,0 +1,487 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// namespacePath is the RAN path of /ifs, the directory listings and WORM states of the committed file walk are read from.
+const namespacePath = "/namespace"
+
+// Types of SmartLock domains.
+const (
+	WormDomainTypeEnterprise = "enterprise"
+	WormDomainTypeCompliance = "compliance"
+)
+
+// Keywords accepted by the SmartLock retention periods instead of a duration.
+const (
+	WormRetentionForever = "forever"
+	WormRetentionUseMin  = "use_min"
+	WormRetentionUseMax  = "use_max"
+)
+
+// WormRetentionPattern matches the retention periods, as an ISO 8601 duration such as P1Y6M or PT12H, or a keyword.
+var WormRetentionPattern = regexp.MustCompile(`^(forever|use_min|use_max|P(\d+Y)?(\d+M)?(\d+W)?(\d+D)?(T(\d+H)?(\d+M)?(\d+S)?)?)$`)
+
+var wormDurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// WormRetention is a retention period of a SmartLock domain, which PAPI represents either by a keyword or by a duration object.
+// It is converted from and to the retention periods of the WormApi models through their JSON form, see convertWormRetention.
+type WormRetention struct {
+	Keyword string
+	Years   int64
+	Months  int64
+	Weeks   int64
+	Days    int64
+	Hours   int64
+	Minutes int64
+	Seconds int64
+}
+
+type wormRetentionPeriod struct {
+	Years   int64 `json:"years"`
+	Months  int64 `json:"months"`
+	Weeks   int64 `json:"weeks"`
+	Days    int64 `json:"days"`
+	Hours   int64 `json:"hours"`
+	Minutes int64 `json:"minutes"`
+	Seconds int64 `json:"seconds"`
+}
+
+// MarshalJSON encodes the keyword as a string and the duration as an object.
+func (r WormRetention) MarshalJSON() ([]byte, error) {
+	if len(r.Keyword) > 0 {
+		return json.Marshal(r.Keyword)
+	}
+	return json.Marshal(wormRetentionPeriod{r.Years, r.Months, r.Weeks, r.Days, r.Hours, r.Minutes, r.Seconds})
+}
+
+// UnmarshalJSON decodes a keyword string or a duration object.
+func (r *WormRetention) UnmarshalJSON(data []byte) error {
+	var keyword string
+	if err := json.Unmarshal(data, &keyword); err == nil {
+		*r = WormRetention{Keyword: keyword}
+		return nil
+	}
+	var period wormRetentionPeriod
+	if err := json.Unmarshal(data, &period); err != nil {
+		return err
+	}
+	*r = WormRetention{
+		Years: period.Years, Months: period.Months, Weeks: period.Weeks, Days: period.Days,
+		Hours: period.Hours, Minutes: period.Minutes, Seconds: period.Seconds,
+	}
+	return nil
+}
+
+// String returns the keyword, or the duration in the ISO 8601 format.
+func (r WormRetention) String() string {
+	if len(r.Keyword) > 0 {
+		return r.Keyword
+	}
+	var date, clock strings.Builder
+	for _, part := range []struct {
+		value int64
+		unit  string
+		out   *strings.Builder
+	}{
+		{r.Years, "Y", &date}, {r.Months, "M", &date}, {r.Weeks, "W", &date}, {r.Days, "D", &date},
+		{r.Hours, "H", &clock}, {r.Minutes, "M", &clock}, {r.Seconds, "S", &clock},
+	} {
+		if part.value != 0 {
+			part.out.WriteString(strconv.FormatInt(part.value, 10) + part.unit)
+		}
+	}
+	if date.Len() == 0 && clock.Len() == 0 {
+		return "PT0S"
+	}
+	if clock.Len() == 0 {
+		return "P" + date.String()
+	}
+	return "P" + date.String() + "T" + clock.String()
+}
+
+// ParseWormRetention parses a retention period given as a keyword or an ISO 8601 duration.
+func ParseWormRetention(value string) (*WormRetention, error) {
+	switch value {
+	case WormRetentionForever, WormRetentionUseMin, WormRetentionUseMax:
+		return &WormRetention{Keyword: value}, nil
+	}
+	match := wormDurationPattern.FindStringSubmatch(value)
+	if match == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return nil, fmt.Errorf("invalid retention period %q, expected forever, use_min, use_max or an ISO 8601 duration such as P1Y6M", value)
+	}
+	var parts [7]int64
+	for i, group := range match[1:] {
+		if len(group) > 0 {
+			parsed, err := strconv.ParseInt(group, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid retention period %q: %w", value, err)
+			}
+			parts[i] = parsed
+		}
+	}
+	return &WormRetention{
+		Years: parts[0], Months: parts[1], Weeks: parts[2], Days: parts[3],
+		Hours: parts[4], Minutes: parts[5], Seconds: parts[6],
+	}, nil
+}
+
+// CreateWormDomain creates a SmartLock domain rooted at the path, and returns its ID.
+func CreateWormDomain(ctx context.Context, client *client.Client, path string, settings models.WormDomainSettingsModel) (string, error) {
+	body := powerscale.V1WormDomain{Path: path}
+	body.SetType(settings.Type.ValueString())
+	if err := setWormDomainSettings(settings, &body, &body.DefaultRetention, &body.MinRetention, &body.MaxRetention); err != nil {
+		return "", err
+	}
+	created, _, err := client.PscaleOpenAPIClient.WormApi.CreateWormv1WormDomain(ctx).V1WormDomain(body).Execute()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprint(created.GetId()), nil
+}
+
+// GetWormDomain returns the SmartLock domain with the given ID.
+func GetWormDomain(ctx context.Context, client *client.Client, domainID string) (*powerscale.V1WormDomainExtended, error) {
+	response, httpResp, err := client.PscaleOpenAPIClient.WormApi.GetWormv1WormDomain(ctx, domainID).Execute()
+	if err != nil {
+		return nil, checkNotFound(httpResp, err)
+	}
+	domains := response.GetDomains()
+	if len(domains) == 0 {
+		return nil, notFoundError{fmt.Errorf("SmartLock domain %s not found", domainID)}
+	}
+	return &domains[0], nil
+}
+
+// ListWormDomains returns all the SmartLock domains of the cluster.
+func ListWormDomains(ctx context.Context, client *client.Client) ([]powerscale.V1WormDomainExtended, error) {
+	listParam := client.PscaleOpenAPIClient.WormApi.ListWormv1WormDomains(ctx)
+	var domains []powerscale.V1WormDomainExtended
+	for {
+		response, _, err := listParam.Execute()
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, response.GetDomains()...)
+		if len(response.GetResume()) == 0 {
+			return domains, nil
+		}
+		listParam = client.PscaleOpenAPIClient.WormApi.ListWormv1WormDomains(ctx).Resume(response.GetResume())
+	}
+}
+
+// FindWormDomainByPath returns the SmartLock domain rooted at the path, or nil if there is none.
+func FindWormDomainByPath(ctx context.Context, client *client.Client, path string) (*powerscale.V1WormDomainExtended, error) {
+	domains, err := ListWormDomains(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	path = "/" + strings.Trim(path, "/")
+	for i := range domains {
+		if domains[i].GetPath() == path {
+			return &domains[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateWormDomain updates the retention settings of a SmartLock domain.
+func UpdateWormDomain(ctx context.Context, client *client.Client, domainID string, settings models.WormDomainSettingsModel) error {
+	body := powerscale.V1WormDomainExtendedExtended{}
+	if err := setWormDomainSettings(settings, &body, &body.DefaultRetention, &body.MinRetention, &body.MaxRetention); err != nil {
+		return err
+	}
+	_, err := client.PscaleOpenAPIClient.WormApi.UpdateWormv1WormDomain(ctx, domainID).V1WormDomain(body).Execute()
+	return err
+}
+
+// GetWormSettings returns the SmartLock settings of the cluster, including the compliance clock, whose date is null until the clock is set.
+func GetWormSettings(ctx context.Context, client *client.Client) (*powerscale.V1WormSettingsSettings, error) {
+	response, _, err := client.PscaleOpenAPIClient.WormApi.GetWormv1WormSettings(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+	settings := response.GetSettings()
+	return &settings, nil
+}
+
+// NewWormComplianceClockModel returns the state of the compliance clock of the SmartLock settings.
+func NewWormComplianceClockModel(settings *powerscale.V1WormSettingsSettings) *models.WormComplianceClockModel {
+	cdate, _ := settings.GetCdateOk()
+	return &models.WormComplianceClockModel{
+		Set:  types.BoolValue(cdate != nil),
+		Date: int64PointerValue(cdate),
+	}
+}
+
+// CreateWormDomainDirectory creates the root directory of a SmartLock domain, with its parents, unless it exists.
+// It returns whether the directory was created.
+func CreateWormDomainDirectory(ctx context.Context, client *client.Client, path string) (bool, error) {
+	_, httpResp, err := client.PscaleOpenAPIClient.NamespaceApi.GetDirectoryMetadata(ctx, strings.Trim(path, "/")).Metadata(true).Execute()
+	// the metadata of an existing directory may not decode, see GetDirectoryMetadata
+	if httpResp != nil && httpResp.StatusCode == http.StatusOK {
+		return false, nil
+	}
+	if err := checkNotFound(httpResp, err); !IsPAPINotFound(err) {
+		return false, err
+	}
+	createReq := client.PscaleOpenAPIClient.NamespaceApi.CreateDirectory(ctx, strings.Trim(path, "/"))
+	createReq = createReq.XIsiIfsTargetType("container").Recursive(true)
+	if _, _, err := ExecuteCreate(createReq); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CheckWormDomainDeletable returns an error when the directory tree of a SmartLock domain contains committed files,
+// which cannot be deleted before their retention date. Compliance domains are walked too, as the cluster deletes the
+// uncommitted files of a tree before refusing the committed ones, which would leave a partially deleted archive.
+// The walk stops with the error of the context when it is done.
+func CheckWormDomainDeletable(ctx context.Context, client *client.Client, path string) error {
+	committed, err := FindWormCommittedFile(ctx, client, path)
+	if err != nil {
+		return err
+	}
+	if len(committed) > 0 {
+		return fmt.Errorf("the SmartLock domain %s contains committed files, such as %s, which cannot be deleted", path, committed)
+	}
+	return nil
+}
+
+// FindWormCommittedFile walks the directory tree under the path, and returns the path of the first committed file it finds,
+// or an empty string when there is none or the directory does not exist.
+func FindWormCommittedFile(ctx context.Context, client *client.Client, path string) (string, error) {
+	directories := []string{"/" + strings.Trim(path, "/")}
+	for len(directories) > 0 {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		directory := directories[len(directories)-1]
+		directories = directories[:len(directories)-1]
+		query := url.Values{"detail": []string{"type"}}
+		for {
+			var listing struct {
+				Children []struct {
+					Name string `json:"name"`
+					Type string `json:"type"`
+				} `json:"children"`
+				Resume string `json:"resume"`
+			}
+			if err := GetPAPI(ctx, client, namespaceObjectPath(directory), query, &listing); err != nil {
+				if IsPAPINotFound(err) {
+					break
+				}
+				return "", err
+			}
+			for _, child := range listing.Children {
+				childPath := directory + "/" + child.Name
+				if child.Type == "container" {
+					directories = append(directories, childPath)
+					continue
+				}
+				var worm struct {
+					WormCommitted bool `json:"worm_committed"`
+				}
+				if err := GetPAPI(ctx, client, namespaceObjectPath(childPath), url.Values{"worm": []string{""}}, &worm); err != nil {
+					if IsPAPINotFound(err) {
+						continue
+					}
+					return "", err
+				}
+				if worm.WormCommitted {
+					return childPath, nil
+				}
+			}
+			if len(listing.Resume) == 0 {
+				break
+			}
+			query = url.Values{"detail": []string{"type"}, "resume": []string{listing.Resume}}
+		}
+	}
+	return "", nil
+}
+
+// UpdateWormDomainSettingsState copies the SmartLock domain into the settings model.
+// The retention periods of the prior settings are kept when they are equivalent to the ones of the domain, e.g. P12M and P1Y are not.
+func UpdateWormDomainSettingsState(settings *models.WormDomainSettingsModel, domain *powerscale.V1WormDomainExtended) {
+	settings.ID = types.StringValue(fmt.Sprint(domain.GetId()))
+	settings.Type = types.StringValue(domain.GetType())
+	settings.DefaultRetention = wormRetentionValue(domain.DefaultRetention, settings.DefaultRetention)
+	settings.MinRetention = wormRetentionValue(domain.MinRetention, settings.MinRetention)
+	settings.MaxRetention = wormRetentionValue(domain.MaxRetention, settings.MaxRetention)
+	autocommitOffset, _ := domain.GetAutocommitOffsetOk()
+	settings.AutocommitOffset = int64PointerValue(autocommitOffset)
+	settings.PrivilegedDelete = types.StringValue(domain.GetPrivilegedDelete())
+	overrideDate, _ := domain.GetOverrideDateOk()
+	settings.OverrideDate = int64PointerValue(overrideDate)
+}
+
+// WormDomainResourceSettings returns the settings of the SmartLock domain resource model.
+func WormDomainResourceSettings(model models.WormDomainResourceModel) models.WormDomainSettingsModel {
+	return models.WormDomainSettingsModel{
+		ID:               model.ID,
+		Type:             model.Type,
+		DefaultRetention: model.DefaultRetention,
+		MinRetention:     model.MinRetention,
+		MaxRetention:     model.MaxRetention,
+		AutocommitOffset: model.AutocommitOffset,
+		PrivilegedDelete: model.PrivilegedDelete,
+		OverrideDate:     model.OverrideDate,
+	}
+}
+
+// UpdateFileSystemWormDomainState reads the SmartLock domain of the file system, if it has one, into the file system model.
+// The domain is dropped from the model when it no longer exists.
+func UpdateFileSystemWormDomainState(ctx context.Context, client *client.Client, plan *models.FileSystemResource) error {
+	if plan.WormDomain == nil {
+		return nil
+	}
+	domain, err := GetWormDomain(ctx, client, plan.WormDomain.ID.ValueString())
+	if IsPAPINotFound(err) {
+		plan.WormDomain = nil
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	UpdateWormDomainSettingsState(plan.WormDomain, domain)
+	return nil
+}
+
+// UpdateWormDomainResourceState copies the SmartLock domain into the resource model.
+func UpdateWormDomainResourceState(state *models.WormDomainResourceModel, domain *powerscale.V1WormDomainExtended) {
+	settings := WormDomainResourceSettings(*state)
+	UpdateWormDomainSettingsState(&settings, domain)
+	state.ID = settings.ID
+	state.Path = types.StringValue(domain.GetPath())
+	state.Type = settings.Type
+	state.DefaultRetention = settings.DefaultRetention
+	state.MinRetention = settings.MinRetention
+	state.MaxRetention = settings.MaxRetention
+	state.AutocommitOffset = settings.AutocommitOffset
+	state.PrivilegedDelete = settings.PrivilegedDelete
+	state.OverrideDate = settings.OverrideDate
+}
+
+// NewWormDomainDataSourceEntity returns the data source entity of a SmartLock domain.
+func NewWormDomainDataSourceEntity(domain *powerscale.V1WormDomainExtended) models.WormDomainDataSourceEntity {
+	settings := models.WormDomainSettingsModel{}
+	UpdateWormDomainSettingsState(&settings, domain)
+	return models.WormDomainDataSourceEntity{
+		ID:               settings.ID,
+		Path:             types.StringValue(domain.GetPath()),
+		Type:             settings.Type,
+		DefaultRetention: settings.DefaultRetention,
+		MinRetention:     settings.MinRetention,
+		MaxRetention:     settings.MaxRetention,
+		AutocommitOffset: settings.AutocommitOffset,
+		PrivilegedDelete: settings.PrivilegedDelete,
+		OverrideDate:     settings.OverrideDate,
+	}
+}
+
+// wormRetentionValue returns the retention period of a WormApi model, keeping the prior value when it is equivalent.
+func wormRetentionValue(field interface{}, prior types.String) types.String {
+	var remote *WormRetention
+	if err := convertWormRetention(field, &remote); err != nil || remote == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if parsed, err := ParseWormRetention(prior.ValueString()); err == nil && *parsed == *remote {
+			return prior
+		}
+	}
+	return types.StringValue(remote.String())
+}
+
+// wormDomainSetters are the setters shared by the bodies creating and updating a SmartLock domain.
+type wormDomainSetters interface {
+	SetAutocommitOffset(int32)
+	SetPrivilegedDelete(string)
+	SetOverrideDate(int32)
+}
+
+// setWormDomainSettings sets the settings of the plan on the body of a SmartLock domain request,
+// the retention periods are set on the given fields of the body.
+func setWormDomainSettings(settings models.WormDomainSettingsModel, body wormDomainSetters, defaultRetention, minRetention, maxRetention interface{}) error {
+	for _, field := range []struct {
+		name   string
+		value  types.String
+		target interface{}
+	}{
+		{"default_retention", settings.DefaultRetention, defaultRetention},
+		{"min_retention", settings.MinRetention, minRetention},
+		{"max_retention", settings.MaxRetention, maxRetention},
+	} {
+		if field.value.IsNull() || field.value.IsUnknown() {
+			continue
+		}
+		retention, err := ParseWormRetention(field.value.ValueString())
+		if err != nil {
+			return errors.New(field.name + ": " + err.Error())
+		}
+		if err := convertWormRetention(retention, field.target); err != nil {
+			return errors.New(field.name + ": " + err.Error())
+		}
+	}
+	if !settings.AutocommitOffset.IsNull() && !settings.AutocommitOffset.IsUnknown() {
+		body.SetAutocommitOffset(int32(settings.AutocommitOffset.ValueInt64()))
+	}
+	if !settings.PrivilegedDelete.IsNull() && !settings.PrivilegedDelete.IsUnknown() {
+		body.SetPrivilegedDelete(settings.PrivilegedDelete.ValueString())
+	}
+	if !settings.OverrideDate.IsNull() && !settings.OverrideDate.IsUnknown() {
+		body.SetOverrideDate(int32(settings.OverrideDate.ValueInt64()))
+	}
+	return nil
+}
+
+// convertWormRetention converts a retention period between WormRetention and the keyword or duration types of the WormApi models,
+// which both encode it as PAPI does.
+func convertWormRetention(from, to interface{}) error {
+	encoded, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, to)
+}
+
+// namespaceObjectPath returns the RAN path of a file or directory under /ifs.
+func namespaceObjectPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return namespacePath + "/" + strings.Join(segments, "/")
+}
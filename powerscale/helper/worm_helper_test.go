@@ -0,0 +1,58 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWormRetention(t *testing.T) {
+	for _, test := range []struct {
+		value     string
+		retention WormRetention
+		json      string
+	}{
+		{"forever", WormRetention{Keyword: "forever"}, `"forever"`},
+		{"use_min", WormRetention{Keyword: "use_min"}, `"use_min"`},
+		{"P1Y6M", WormRetention{Years: 1, Months: 6}, `{"years":1,"months":6,"weeks":0,"days":0,"hours":0,"minutes":0,"seconds":0}`},
+		{"P2W", WormRetention{Weeks: 2}, `{"years":0,"months":0,"weeks":2,"days":0,"hours":0,"minutes":0,"seconds":0}`},
+		{"P1DT12H30M", WormRetention{Days: 1, Hours: 12, Minutes: 30}, `{"years":0,"months":0,"weeks":0,"days":1,"hours":12,"minutes":30,"seconds":0}`},
+		{"PT0S", WormRetention{}, `{"years":0,"months":0,"weeks":0,"days":0,"hours":0,"minutes":0,"seconds":0}`},
+	} {
+		parsed, err := ParseWormRetention(test.value)
+		assert.NoError(t, err, test.value)
+		assert.Equal(t, test.retention, *parsed, test.value)
+		assert.Equal(t, test.value, parsed.String(), test.value)
+		assert.True(t, WormRetentionPattern.MatchString(test.value), test.value)
+
+		encoded, err := json.Marshal(parsed)
+		assert.NoError(t, err, test.value)
+		assert.JSONEq(t, test.json, string(encoded), test.value)
+		var decoded WormRetention
+		assert.NoError(t, json.Unmarshal(encoded, &decoded), test.value)
+		assert.Equal(t, test.retention, decoded, test.value)
+	}
+
+	for _, value := range []string{"", "P", "PT", "P1DT", "1Y", "P1.5Y", "P1H", "never"} {
+		_, err := ParseWormRetention(value)
+		assert.Error(t, err, value)
+	}
+}
@@ -21,6 +21,9 @@ import (
 	"context"
 	powerscale "dell/powerscale-go-client"
 	"errors"
+	"fmt"
+	"net/http"
+	"slices"
 	"strconv"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/models"
@@ -46,6 +49,48 @@ func DeleteWritableSnapshot(ctx context.Context, client *client.Client, path str
 	return err
 }
 
+// writableSnapshotTerminalStates are the states a writable snapshot never becomes active from.
+var writableSnapshotTerminalStates = []string{"deleting", "failed"}
+
+// WaitForWritableSnapshot waits until the writable snapshot at the path is active, and returns it.
+// It returns a *PollError as soon as the writable snapshot is in a terminal state.
+func WaitForWritableSnapshot(ctx context.Context, client *client.Client, path string) (*powerscale.Createv14SnapshotWritableItemResponse, error) {
+	var writableSnapshot *powerscale.Createv14SnapshotWritableItemResponse
+	err := Poll(ctx, func(ctx context.Context) (bool, string, error) {
+		response, err := GetWritableSnapshot(ctx, client, path)
+		if err != nil {
+			return false, "", err
+		}
+		if len(response.Writable) == 0 {
+			return false, fmt.Sprintf("writable snapshot %s is not listed yet", path), nil
+		}
+		writableSnapshot = &response.Writable[0]
+		status := fmt.Sprintf("writable snapshot %s is %s", path, writableSnapshot.State)
+		if slices.Contains(writableSnapshotTerminalStates, writableSnapshot.State) {
+			return false, status, &PollError{Status: status, Err: ErrPollTerminalState}
+		}
+		return writableSnapshot.State == "active", status, nil
+	})
+	return writableSnapshot, err
+}
+
+// WaitForWritableSnapshotDeletion waits until the writable snapshot at the path is gone, as it stays in the deleting state for a while.
+func WaitForWritableSnapshotDeletion(ctx context.Context, client *client.Client, path string) error {
+	return Poll(ctx, func(ctx context.Context) (bool, string, error) {
+		response, httpResp, err := client.PscaleOpenAPIClient.SnapshotApi.GetSnapshotv14SnapshotWritableWspath(ctx, path).Execute()
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			return true, "", nil
+		}
+		if err != nil {
+			return false, "", err
+		}
+		if len(response.Writable) == 0 {
+			return true, "", nil
+		}
+		return false, fmt.Sprintf("writable snapshot %s is %s", path, response.Writable[0].State), nil
+	})
+}
+
 // GetAllWritableSnapshots returns the full list of writable snapshots.
 func GetAllWritableSnapshots(ctx context.Context, client *client.Client, state *models.WritablesnapshotModel) (*powerscale.V14SnapshotWritable, error) {
 	writablesnapshots := client.PscaleOpenAPIClient.SnapshotApi.ListSnapshotv14SnapshotWritable(ctx)
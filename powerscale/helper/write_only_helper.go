@@ -0,0 +1,69 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetWriteOnlySecret returns the value of a write-only attribute to send to the cluster, or null when it must not be sent.
+// Write-only values are never stored in the plan or state, so they are read from the configuration,
+// and, as a change cannot be detected, they are only sent again on update when the version attribute changed.
+// The state is nil on creation.
+func GetWriteOnlySecret(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, state *tfsdk.State, attribute, versionAttribute string) (types.String, diag.Diagnostics) {
+	var value types.String
+	diags := config.GetAttribute(ctx, path.Root(attribute), &value)
+	if diags.HasError() || value.IsNull() || state == nil {
+		return value, diags
+	}
+	var planVersion, stateVersion types.Int64
+	diags.Append(plan.GetAttribute(ctx, path.Root(versionAttribute), &planVersion)...)
+	diags.Append(state.GetAttribute(ctx, path.Root(versionAttribute), &stateVersion)...)
+	if diags.HasError() || planVersion.Equal(stateVersion) {
+		return types.StringNull(), diags
+	}
+	return value, diags
+}
+
+// SetWriteOnlyField sets the field with the given JSON tag of a request body to a write-only value, unless the value is null.
+func SetWriteOnlyField(value types.String, destination interface{}, jsonTag string) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	field, err := getFieldByJSONTag(destination, jsonTag)
+	if err != nil {
+		return err
+	}
+	secret := value.ValueString()
+	switch {
+	case field.Kind() == reflect.String:
+		field.SetString(secret)
+	case field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.String:
+		field.Set(reflect.ValueOf(&secret))
+	default:
+		return fmt.Errorf("field with tag %s is not a string", jsonTag)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetWriteOnlySecret(t *testing.T) {
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password_wo":         schema.StringAttribute{Optional: true, Sensitive: true, WriteOnly: true},
+			"password_wo_version": schema.Int64Attribute{Optional: true},
+		},
+	}
+	objectType := resourceSchema.Type().TerraformType(ctx)
+	value := func(password interface{}, version interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"password_wo":         tftypes.NewValue(tftypes.String, password),
+			"password_wo_version": tftypes.NewValue(tftypes.Number, version),
+		})
+	}
+	config := tfsdk.Config{Schema: resourceSchema, Raw: value("secret", 2)}
+	plan := tfsdk.Plan{Schema: resourceSchema, Raw: value(nil, 2)}
+
+	// the secret is sent on creation
+	secret, diags := GetWriteOnlySecret(ctx, config, plan, nil, "password_wo", "password_wo_version")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("secret"), secret)
+
+	// and on update when its version changed
+	secret, _ = GetWriteOnlySecret(ctx, config, plan, &tfsdk.State{Schema: resourceSchema, Raw: value(nil, 1)}, "password_wo", "password_wo_version")
+	assert.Equal(t, types.StringValue("secret"), secret)
+	secret, _ = GetWriteOnlySecret(ctx, config, plan, &tfsdk.State{Schema: resourceSchema, Raw: value(nil, 2)}, "password_wo", "password_wo_version")
+	assert.True(t, secret.IsNull())
+
+	secret, _ = GetWriteOnlySecret(ctx, tfsdk.Config{Schema: resourceSchema, Raw: value(nil, nil)}, plan, nil, "password_wo", "password_wo_version")
+	assert.True(t, secret.IsNull())
+}
+
+func TestSetWriteOnlyField(t *testing.T) {
+	body := struct {
+		Password     *string `json:"password,omitempty"`
+		BindPassword string  `json:"bind_password,omitempty"`
+		Port         *int64  `json:"port,omitempty"`
+	}{}
+	assert.Nil(t, SetWriteOnlyField(types.StringValue("secret"), &body, "password"))
+	assert.Equal(t, "secret", *body.Password)
+	assert.Nil(t, SetWriteOnlyField(types.StringValue("bind"), &body, "bind_password"))
+	assert.Equal(t, "bind", body.BindPassword)
+
+	// null values leave the body unchanged
+	assert.Nil(t, SetWriteOnlyField(types.StringNull(), &body, "bind_password"))
+	assert.Equal(t, "bind", body.BindPassword)
+
+	assert.NotNil(t, SetWriteOnlyField(types.StringValue("secret"), &body, "port"))
+	assert.NotNil(t, SetWriteOnlyField(types.StringValue("secret"), &body, "unknown"))
+}
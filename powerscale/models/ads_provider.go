@@ -228,6 +228,10 @@ type AdsProviderResourceModel struct {
 	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
 	// Specifies the password used during domain join.
 	Password types.String `tfsdk:"password"`
+	// Specifies the password used during domain join, without storing it in the state.
+	PasswordWO types.String `tfsdk:"password_wo"`
+	// The version of the write-only password.
+	PasswordWOVersion types.Int64 `tfsdk:"password_wo_version"`
 	// Resets the secure channel to the primary domain.
 	ResetSchannel types.Bool `tfsdk:"reset_schannel"`
 	// Check the provider for filtered lists of findable and unfindable users and groups.
@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// APIRequestDataSourceModel describes the api request data source data model.
+type APIRequestDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Path         types.String `tfsdk:"path"`
+	Query        types.Map    `tfsdk:"query"`
+	StatusCode   types.Int64  `tfsdk:"status_code"`
+	ResponseBody types.String `tfsdk:"response_body"`
+}
+
+// APIObjectResourceModel describes the api object resource data model.
+type APIObjectResourceModel struct {
+	ID types.String `tfsdk:"id"`
+	// Path the object is created at, and methods and paths of the other operations. {id} is replaced by the object ID.
+	Path         types.String `tfsdk:"path"`
+	CreateMethod types.String `tfsdk:"create_method"`
+	IDAttribute  types.String `tfsdk:"id_attribute"`
+	ReadPath     types.String `tfsdk:"read_path"`
+	UpdatePath   types.String `tfsdk:"update_path"`
+	UpdateMethod types.String `tfsdk:"update_method"`
+	DeletePath   types.String `tfsdk:"delete_path"`
+	DeleteMethod types.String `tfsdk:"delete_method"`
+	Query        types.Map    `tfsdk:"query"`
+	// JSON documents sent on create and update, and on delete.
+	Body       types.String `tfsdk:"body"`
+	DeleteBody types.String `tfsdk:"delete_body"`
+	// JSON document of the object as read from PAPI.
+	Response types.String `tfsdk:"response"`
+}
@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// AuditGlobalSettingsResourceModel describes the audit global settings resource data model.
+type AuditGlobalSettingsResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	ProtocolAuditingEnabled types.Bool   `tfsdk:"protocol_auditing_enabled"`
+	ConfigAuditingEnabled   types.Bool   `tfsdk:"config_auditing_enabled"`
+	ConfigSyslogEnabled     types.Bool   `tfsdk:"config_syslog_enabled"`
+	AuditedZones            types.Set    `tfsdk:"audited_zones"`
+	CeeServerURIs           types.List   `tfsdk:"cee_server_uris"`
+	Hostname                types.String `tfsdk:"hostname"`
+	RetentionPeriod         types.Int64  `tfsdk:"retention_period"`
+	AutoPurgingEnabled      types.Bool   `tfsdk:"auto_purging_enabled"`
+}
+
+// AuditZoneSettingsResourceModel describes the audit zone settings resource data model.
+type AuditZoneSettingsResourceModel struct {
+	ID                      types.String `tfsdk:"id"`
+	Zone                    types.String `tfsdk:"zone"`
+	AuditSuccess            types.Set    `tfsdk:"audit_success"`
+	AuditFailure            types.Set    `tfsdk:"audit_failure"`
+	SyslogForwardingEnabled types.Bool   `tfsdk:"syslog_forwarding_enabled"`
+	SyslogAuditEvents       types.Set    `tfsdk:"syslog_audit_events"`
+}
+
+// AuditTopicDataSourceModel describes the audit topic data source data model.
+type AuditTopicDataSourceModel struct {
+	ID          types.String                 `tfsdk:"id"`
+	AuditTopics []AuditTopicDataSourceEntity `tfsdk:"audit_topics"`
+	Filter      *AuditTopicDataSourceFilter  `tfsdk:"filter"`
+}
+
+// AuditTopicDataSourceEntity describes an audit topic of the data source.
+type AuditTopicDataSourceEntity struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	MaxCachedMessages types.Int64  `tfsdk:"max_cached_messages"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+}
+
+// AuditTopicDataSourceFilter describes the filter of the audit topic data source.
+type AuditTopicDataSourceFilter struct {
+	IDs []types.String `tfsdk:"ids"`
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EventChannelResourceModel describes the event channel resource data model.
+type EventChannelResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Type                  types.String `tfsdk:"type"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	AllowedNodes          types.Set    `tfsdk:"allowed_nodes"`
+	ExcludedNodes         types.Set    `tfsdk:"excluded_nodes"`
+	Address               types.Set    `tfsdk:"address"`
+	SendAs                types.String `tfsdk:"send_as"`
+	Subject               types.String `tfsdk:"subject"`
+	SMTPHost              types.String `tfsdk:"smtp_host"`
+	SMTPPort              types.Int64  `tfsdk:"smtp_port"`
+	SMTPUseAuth           types.Bool   `tfsdk:"smtp_use_auth"`
+	SMTPUsername          types.String `tfsdk:"smtp_username"`
+	SMTPPassword          types.String `tfsdk:"smtp_password"`
+	SMTPPasswordWO        types.String `tfsdk:"smtp_password_wo"`
+	SMTPPasswordWOVersion types.Int64  `tfsdk:"smtp_password_wo_version"`
+	SMTPSecurity          types.String `tfsdk:"smtp_security"`
+	Batch                 types.String `tfsdk:"batch"`
+	BatchPeriod           types.Int64  `tfsdk:"batch_period"`
+	CustomTemplate        types.String `tfsdk:"custom_template"`
+	Host                  types.String `tfsdk:"host"`
+	Community             types.String `tfsdk:"community"`
+	System                types.Bool   `tfsdk:"system"`
+}
+
+// EventAlertConditionResourceModel describes the alert condition resource data model.
+type EventAlertConditionResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Condition  types.String `tfsdk:"condition"`
+	Categories types.Set    `tfsdk:"categories"`
+	Severities types.Set    `tfsdk:"severities"`
+	Channels   types.Set    `tfsdk:"channels"`
+	Interval   types.Int64  `tfsdk:"interval"`
+	Limit      types.Int64  `tfsdk:"limit"`
+}
+
+// EventGroupDataSourceModel describes the event group data source data model.
+type EventGroupDataSourceModel struct {
+	ID          types.String                 `tfsdk:"id"`
+	EventGroups []EventGroupDataSourceEntity `tfsdk:"event_groups"`
+	Filter      *EventGroupDataSourceFilter  `tfsdk:"filter"`
+}
+
+// EventGroupDataSourceFilter holds the filter conditions of the event group data source.
+type EventGroupDataSourceFilter struct {
+	Severities []types.String `tfsdk:"severities"`
+	Resolved   types.Bool     `tfsdk:"resolved"`
+	Begin      types.Int64    `tfsdk:"begin"`
+	End        types.Int64    `tfsdk:"end"`
+}
+
+// EventGroupDataSourceEntity describes an occurrence of an event group.
+type EventGroupDataSourceEntity struct {
+	ID          types.String `tfsdk:"id"`
+	Causes      types.List   `tfsdk:"causes"`
+	Severity    types.String `tfsdk:"severity"`
+	Resolved    types.Bool   `tfsdk:"resolved"`
+	ResolveTime types.Int64  `tfsdk:"resolve_time"`
+	TimeNoticed types.Int64  `tfsdk:"time_noticed"`
+	LastEvent   types.Int64  `tfsdk:"last_event"`
+	Events      types.Int64  `tfsdk:"events"`
+	Ignore      types.Bool   `tfsdk:"ignore"`
+	Lnn         types.Int64  `tfsdk:"lnn"`
+	Channels    types.List   `tfsdk:"channels"`
+}
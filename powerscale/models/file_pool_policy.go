@@ -203,3 +203,13 @@ type V1FilepoolPolicyFileMatchingPatternOrCriteriaItemAndCriteriaItem struct {
 	// The value to be compared against a file attribute.
 	Value types.String `tfsdk:"value"`
 }
+
+// FilePoolPolicyIdentityModel describes the identity of the file pool policy resource.
+type FilePoolPolicyIdentityModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// FilePoolPolicyListModel describes the config of the file pool policy list resource.
+type FilePoolPolicyListModel struct {
+	Names []types.String `tfsdk:"names"`
+}
@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // FileSystemDataSourceModel describes the data source data model.
 type FileSystemDataSourceModel struct {
@@ -183,4 +186,8 @@ type FileSystemResource struct {
 	Recursive types.Bool `tfsdk:"recursive"`
 	// Deletes and replaces the existing user attributes and ACLs of the directory with user-specified attributes if set to true.
 	Overwrite types.Bool `tfsdk:"overwrite"`
+	// SmartLock domain created on the directory.
+	WormDomain *WormDomainSettingsModel `tfsdk:"worm_domain"`
+	// Timeout of the deletion of the directory.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JobResourceModel describes the Job Engine job resource data model.
+type JobResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Type              types.String   `tfsdk:"type"`
+	Paths             types.List     `tfsdk:"paths"`
+	Policy            types.String   `tfsdk:"policy"`
+	Priority          types.Int64    `tfsdk:"priority"`
+	AllowDup          types.Bool     `tfsdk:"allow_dup"`
+	Parameters        types.String   `tfsdk:"parameters"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	DeleteAction      types.String   `tfsdk:"delete_action"`
+	State             types.String   `tfsdk:"state"`
+	Progress          types.String   `tfsdk:"progress"`
+	CurrentPhase      types.Int64    `tfsdk:"current_phase"`
+	TotalPhases       types.Int64    `tfsdk:"total_phases"`
+	StartTime         types.Int64    `tfsdk:"start_time"`
+	EndTime           types.Int64    `tfsdk:"end_time"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// JobPolicyResourceModel describes the job impact policy resource data model.
+type JobPolicyResourceModel struct {
+	ID          types.String             `tfsdk:"id"`
+	Name        types.String             `tfsdk:"name"`
+	Description types.String             `tfsdk:"description"`
+	Intervals   []JobPolicyIntervalModel `tfsdk:"intervals"`
+	System      types.Bool               `tfsdk:"system"`
+}
+
+// JobPolicyIntervalModel describes a time window of a job impact policy.
+type JobPolicyIntervalModel struct {
+	Begin  types.String `tfsdk:"begin"`
+	End    types.String `tfsdk:"end"`
+	Impact types.String `tfsdk:"impact"`
+}
+
+// JobTypeSettingsResourceModel describes the job type settings resource data model.
+type JobTypeSettingsResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Type                   types.String `tfsdk:"type"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Policy                 types.String `tfsdk:"policy"`
+	Priority               types.Int64  `tfsdk:"priority"`
+	Schedule               types.String `tfsdk:"schedule"`
+	Description            types.String `tfsdk:"description"`
+	ExclusionSet           types.String `tfsdk:"exclusion_set"`
+	AllowMultipleInstances types.Bool   `tfsdk:"allow_multiple_instances"`
+}
+
+// JobDataSourceModel describes the job data source data model.
+type JobDataSourceModel struct {
+	ID     types.String          `tfsdk:"id"`
+	Jobs   []JobDataSourceEntity `tfsdk:"jobs"`
+	Filter *JobDataSourceFilter  `tfsdk:"filter"`
+}
+
+// JobDataSourceFilter holds the filter conditions of the job data source.
+type JobDataSourceFilter struct {
+	State types.String   `tfsdk:"state"`
+	Types []types.String `tfsdk:"types"`
+}
+
+// JobDataSourceEntity describes a job of the Job Engine.
+type JobDataSourceEntity struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Type         types.String `tfsdk:"type"`
+	State        types.String `tfsdk:"state"`
+	ControlState types.String `tfsdk:"control_state"`
+	Description  types.String `tfsdk:"description"`
+	Paths        types.List   `tfsdk:"paths"`
+	Policy       types.String `tfsdk:"policy"`
+	Impact       types.String `tfsdk:"impact"`
+	Priority     types.Int64  `tfsdk:"priority"`
+	Progress     types.String `tfsdk:"progress"`
+	CurrentPhase types.Int64  `tfsdk:"current_phase"`
+	TotalPhases  types.Int64  `tfsdk:"total_phases"`
+	CreateTime   types.Int64  `tfsdk:"create_time"`
+	StartTime    types.Int64  `tfsdk:"start_time"`
+	EndTime      types.Int64  `tfsdk:"end_time"`
+}
+
+// JobTypeDataSourceModel describes the job type data source data model.
+type JobTypeDataSourceModel struct {
+	ID       types.String              `tfsdk:"id"`
+	JobTypes []JobTypeDataSourceEntity `tfsdk:"job_types"`
+	Filter   *JobTypeDataSourceFilter  `tfsdk:"filter"`
+}
+
+// JobTypeDataSourceFilter holds the filter conditions of the job type data source.
+type JobTypeDataSourceFilter struct {
+	IDs     []types.String `tfsdk:"ids"`
+	ShowAll types.Bool     `tfsdk:"show_all"`
+}
+
+// JobTypeDataSourceEntity describes a job type and its settings.
+type JobTypeDataSourceEntity struct {
+	ID                     types.String `tfsdk:"id"`
+	Description            types.String `tfsdk:"description"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	Policy                 types.String `tfsdk:"policy"`
+	Priority               types.Int64  `tfsdk:"priority"`
+	Schedule               types.String `tfsdk:"schedule"`
+	ExclusionSet           types.String `tfsdk:"exclusion_set"`
+	AllowMultipleInstances types.Bool   `tfsdk:"allow_multiple_instances"`
+	Hidden                 types.Bool   `tfsdk:"hidden"`
+}
+
+// JobReportDataSourceModel describes the job report data source data model.
+type JobReportDataSourceModel struct {
+	ID         types.String                `tfsdk:"id"`
+	JobReports []JobReportDataSourceEntity `tfsdk:"job_reports"`
+	Filter     *JobReportDataSourceFilter  `tfsdk:"filter"`
+}
+
+// JobReportDataSourceFilter holds the filter conditions of the job report data source.
+type JobReportDataSourceFilter struct {
+	JobID   types.Int64  `tfsdk:"job_id"`
+	JobType types.String `tfsdk:"job_type"`
+	Begin   types.Int64  `tfsdk:"begin"`
+	End     types.Int64  `tfsdk:"end"`
+}
+
+// JobReportDataSourceEntity describes the report of a job phase.
+type JobReportDataSourceEntity struct {
+	JobID   types.Int64  `tfsdk:"job_id"`
+	JobType types.String `tfsdk:"job_type"`
+	Phase   types.Int64  `tfsdk:"phase"`
+	Time    types.Int64  `tfsdk:"time"`
+	Results types.String `tfsdk:"results"`
+}
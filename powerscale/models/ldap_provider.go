@@ -225,6 +225,10 @@ type LdapProviderModel struct {
 	BaseDn types.String `tfsdk:"base_dn"`
 	// Specifies the distinguished name for binding to the LDAP server.
 	BindDn types.String `tfsdk:"bind_dn"`
+	// Specifies the password for the distinguished name for binding to the LDAP server, without storing it in the state.
+	BindPasswordWO types.String `tfsdk:"bind_password_wo"`
+	// The version of the write-only bind password.
+	BindPasswordWOVersion types.Int64 `tfsdk:"bind_password_wo_version"`
 	// Specifies which bind mechanism to use when connecting to an LDAP server. The only supported option is the 'simple' value.
 	BindMechanism types.String `tfsdk:"bind_mechanism"`
 	// Specifies the timeout in seconds when binding to an LDAP server.
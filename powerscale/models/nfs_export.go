@@ -292,3 +292,15 @@ type V11NfsExportMapAllSecondaryGroupsInner struct {
 	// Specifies the type of persona, which must be combined with a name.
 	Type types.String `tfsdk:"type"`
 }
+
+// NfsExportIdentityModel describes the identity of the nfs export resource.
+type NfsExportIdentityModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Zone types.String `tfsdk:"zone"`
+}
+
+// NfsExportListModel describes the config of the nfs export list resource.
+type NfsExportListModel struct {
+	Zone  types.String   `tfsdk:"zone"`
+	Paths []types.String `tfsdk:"paths"`
+}
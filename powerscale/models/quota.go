@@ -188,3 +188,20 @@ type QuotaUsage struct {
 	// True if shadow_refs resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.
 	ShadowRefsReady types.Bool `tfsdk:"shadow_refs_ready"`
 }
+
+// QuotaIdentityModel describes the identity of the quota resource.
+type QuotaIdentityModel struct {
+	ID   types.String `tfsdk:"id"`
+	Zone types.String `tfsdk:"zone"`
+}
+
+// QuotaListModel describes the config of the quota list resource.
+type QuotaListModel struct {
+	Enforced            types.Bool   `tfsdk:"enforced"`
+	Exceeded            types.Bool   `tfsdk:"exceeded"`
+	Path                types.String `tfsdk:"path"`
+	Persona             types.String `tfsdk:"persona"`
+	RecursePathChildren types.Bool   `tfsdk:"recurse_path_children"`
+	Type                types.String `tfsdk:"type"`
+	Zone                types.String `tfsdk:"zone"`
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// QuotaNotificationResourceModel describes the quota notification rule resource data model.
+type QuotaNotificationResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	QuotaID            types.String `tfsdk:"quota_id"`
+	Threshold          types.String `tfsdk:"threshold"`
+	Condition          types.String `tfsdk:"condition"`
+	ActionAlert        types.Bool   `tfsdk:"action_alert"`
+	ActionEmailOwner   types.Bool   `tfsdk:"action_email_owner"`
+	ActionEmailAddress types.String `tfsdk:"action_email_address"`
+	EmailTemplate      types.String `tfsdk:"email_template"`
+	Holdoff            types.Int64  `tfsdk:"holdoff"`
+	Schedule           types.String `tfsdk:"schedule"`
+}
+
+// QuotaNotificationRuleModel describes a default notification rule of the quota settings.
+type QuotaNotificationRuleModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Threshold          types.String `tfsdk:"threshold"`
+	Condition          types.String `tfsdk:"condition"`
+	ActionAlert        types.Bool   `tfsdk:"action_alert"`
+	ActionEmailOwner   types.Bool   `tfsdk:"action_email_owner"`
+	ActionEmailAddress types.String `tfsdk:"action_email_address"`
+	EmailTemplate      types.String `tfsdk:"email_template"`
+	Holdoff            types.Int64  `tfsdk:"holdoff"`
+	Schedule           types.String `tfsdk:"schedule"`
+}
+
+// QuotaSettingsResourceModel describes the quota settings resource data model.
+type QuotaSettingsResourceModel struct {
+	ID            types.String                 `tfsdk:"id"`
+	Reports       *QuotaReportSettingsModel    `tfsdk:"reports"`
+	Notifications []QuotaNotificationRuleModel `tfsdk:"notifications"`
+	Mappings      []QuotaMappingModel          `tfsdk:"mappings"`
+}
+
+// QuotaReportSettingsModel describes the settings of the quota reports.
+type QuotaReportSettingsModel struct {
+	Schedule        types.String `tfsdk:"schedule"`
+	ScheduledDir    types.String `tfsdk:"scheduled_dir"`
+	ScheduledRetain types.Int64  `tfsdk:"scheduled_retain"`
+	ManualDir       types.String `tfsdk:"manual_dir"`
+	ManualRetain    types.Int64  `tfsdk:"manual_retain"`
+	LiveDir         types.String `tfsdk:"live_dir"`
+	LiveRetain      types.Int64  `tfsdk:"live_retain"`
+}
+
+// QuotaMappingModel describes a mapping of an authentication domain to an email domain.
+type QuotaMappingModel struct {
+	Domain  types.String `tfsdk:"domain"`
+	Mapping types.String `tfsdk:"mapping"`
+}
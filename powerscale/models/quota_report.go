@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// QuotaReportResourceModel describes the quota report resource data model.
+type QuotaReportResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Time      types.Int64  `tfsdk:"time"`
+	Generated types.String `tfsdk:"generated"`
+	Type      types.String `tfsdk:"type"`
+	// Timeouts of the operations waiting on the cluster.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// QuotaReportDataSourceModel describes the quota report data source data model.
+type QuotaReportDataSourceModel struct {
+	ID           types.String                  `tfsdk:"id"`
+	QuotaReports []QuotaReportDataSourceEntity `tfsdk:"quota_reports"`
+	Quotas       []QuotaDatasourceEntity       `tfsdk:"quotas"`
+	Filter       *QuotaReportDataSourceFilter  `tfsdk:"filter"`
+	QuotaFilter  *QuotaDatasourceFilter        `tfsdk:"quota_filter"`
+}
+
+// QuotaReportDataSourceFilter holds the filter conditions of the quota reports.
+type QuotaReportDataSourceFilter struct {
+	IDs       []types.String `tfsdk:"ids"`
+	Generated types.String   `tfsdk:"generated"`
+	Type      types.String   `tfsdk:"type"`
+}
+
+// QuotaReportDataSourceEntity describes a quota report.
+type QuotaReportDataSourceEntity struct {
+	ID        types.String `tfsdk:"id"`
+	Time      types.Int64  `tfsdk:"time"`
+	Generated types.String `tfsdk:"generated"`
+	Type      types.String `tfsdk:"type"`
+}
@@ -31,3 +31,17 @@ type S3KeyResourceData struct {
 	OldKeyExpiry          types.Int64  `tfsdk:"old_key_expiry"`
 	OldKeyTimestamp       types.Int64  `tfsdk:"old_key_timestamp"`
 }
+
+// S3KeyEphemeralData struct to unmarshall the schema of the S3 key ephemeral resource.
+type S3KeyEphemeralData struct {
+	AccessID              types.String `tfsdk:"access_id"`
+	User                  types.String `tfsdk:"user"`
+	Zone                  types.String `tfsdk:"zone"`
+	Generate              types.Bool   `tfsdk:"generate"`
+	ExistingKeyExpiryTime types.Int64  `tfsdk:"existing_key_expiry_time"`
+	SecretKey             types.String `tfsdk:"secret_key"`
+	SecretKeyTimestamp    types.Int64  `tfsdk:"secret_key_timestamp"`
+	OldSecretKey          types.String `tfsdk:"old_secret_key"`
+	OldKeyExpiry          types.Int64  `tfsdk:"old_key_expiry"`
+	OldKeyTimestamp       types.Int64  `tfsdk:"old_key_timestamp"`
+}
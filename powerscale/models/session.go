@@ -0,0 +1,31 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// SessionEphemeralResourceData describes the PAPI session ephemeral resource data model.
+type SessionEphemeralResourceData struct {
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	SessionID       types.String `tfsdk:"session_id"`
+	CSRFToken       types.String `tfsdk:"csrf_token"`
+	Cookie          types.String `tfsdk:"cookie"`
+	TimeoutAbsolute types.Int64  `tfsdk:"timeout_absolute"`
+	TimeoutInactive types.Int64  `tfsdk:"timeout_inactive"`
+}
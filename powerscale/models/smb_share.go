@@ -225,3 +225,15 @@ type SmbShareDatasourceEntity struct {
 	// Numeric ID of the access zone which contains this SMB share
 	Zid types.Int64 `tfsdk:"zid"`
 }
+
+// SmbShareIdentityModel describes the identity of the smb share resource.
+type SmbShareIdentityModel struct {
+	ID   types.String `tfsdk:"id"`
+	Zone types.String `tfsdk:"zone"`
+}
+
+// SmbShareListModel describes the config of the smb share list resource.
+type SmbShareListModel struct {
+	Zone  types.String   `tfsdk:"zone"`
+	Names []types.String `tfsdk:"names"`
+}
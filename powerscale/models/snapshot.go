@@ -74,3 +74,17 @@ type SnapshotDetailModel struct {
 	// The name of the snapshot pointed to if this is an alias.
 	TargetName types.String `tfsdk:"target_name"`
 }
+
+// SnapshotIdentityModel describes the identity of the snapshot resource.
+type SnapshotIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// SnapshotListModel describes the config of the snapshot list resource.
+type SnapshotListModel struct {
+	Path     types.String `tfsdk:"path"`
+	Name     types.String `tfsdk:"name"`
+	Schedule types.String `tfsdk:"schedule"`
+	State    types.String `tfsdk:"state"`
+	Type     types.String `tfsdk:"type"`
+}
@@ -18,15 +18,17 @@ limitations under the License.
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // SnapshotRestoreModel represents snapshot restore resource model.
 type SnapshotRestoreModel struct {
-	ID               types.String `tfsdk:"id"`
-	SnapRevertParams types.Object `tfsdk:"snaprevert_params"`
-	CopyParams       types.Object `tfsdk:"copy_params"`
-	CloneParams      types.Object `tfsdk:"clone_params"`
+	ID               types.String   `tfsdk:"id"`
+	SnapRevertParams types.Object   `tfsdk:"snaprevert_params"`
+	CopyParams       types.Object   `tfsdk:"copy_params"`
+	CloneParams      types.Object   `tfsdk:"clone_params"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // SnapRevertParamsModel represents snapshot revert parameters model.
@@ -188,3 +188,13 @@ type SynciqpolicyResourceModel struct {
 	Conflicted                        types.Bool   `tfsdk:"conflicted"`
 	ID                                types.String `tfsdk:"id"`
 }
+
+// SynciqpolicyIdentityModel describes the identity of the SyncIQ policy resource.
+type SynciqpolicyIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// SynciqpolicyListModel describes the config of the SyncIQ policy list resource.
+type SynciqpolicyListModel struct {
+	Names []types.String `tfsdk:"names"`
+}
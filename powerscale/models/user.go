@@ -93,6 +93,8 @@ type UserResourceModel struct {
 	Gecos                 types.String `tfsdk:"gecos"`
 	HomeDirectory         types.String `tfsdk:"home_directory"`
 	Password              types.String `tfsdk:"password"`
+	PasswordWO            types.String `tfsdk:"password_wo"`
+	PasswordWOVersion     types.Int64  `tfsdk:"password_wo_version"`
 	PasswordExpires       types.Bool   `tfsdk:"password_expires"`
 	PrimaryGroup          types.String `tfsdk:"primary_group"`
 	PromptPasswordChange  types.Bool   `tfsdk:"prompt_password_change"`
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WormDomainResourceModel describes the SmartLock domain resource data model.
+type WormDomainResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Path             types.String   `tfsdk:"path"`
+	DirectoryCreated types.Bool     `tfsdk:"directory_created"`
+	Type             types.String   `tfsdk:"type"`
+	DefaultRetention types.String   `tfsdk:"default_retention"`
+	MinRetention     types.String   `tfsdk:"min_retention"`
+	MaxRetention     types.String   `tfsdk:"max_retention"`
+	AutocommitOffset types.Int64    `tfsdk:"autocommit_offset"`
+	PrivilegedDelete types.String   `tfsdk:"privileged_delete"`
+	OverrideDate     types.Int64    `tfsdk:"override_date"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// WormDomainSettingsModel describes the SmartLock domain created on the directory of a file system.
+type WormDomainSettingsModel struct {
+	ID               types.String `tfsdk:"id"`
+	Type             types.String `tfsdk:"type"`
+	DefaultRetention types.String `tfsdk:"default_retention"`
+	MinRetention     types.String `tfsdk:"min_retention"`
+	MaxRetention     types.String `tfsdk:"max_retention"`
+	AutocommitOffset types.Int64  `tfsdk:"autocommit_offset"`
+	PrivilegedDelete types.String `tfsdk:"privileged_delete"`
+	OverrideDate     types.Int64  `tfsdk:"override_date"`
+}
+
+// WormDomainDataSourceModel describes the SmartLock domain data source data model.
+type WormDomainDataSourceModel struct {
+	ID              types.String                 `tfsdk:"id"`
+	WormDomains     []WormDomainDataSourceEntity `tfsdk:"worm_domains"`
+	ComplianceClock *WormComplianceClockModel    `tfsdk:"compliance_clock"`
+	Filter          *WormDomainDataSourceFilter  `tfsdk:"filter"`
+}
+
+// WormDomainDataSourceEntity describes a SmartLock domain of the data source.
+type WormDomainDataSourceEntity struct {
+	ID               types.String `tfsdk:"id"`
+	Path             types.String `tfsdk:"path"`
+	Type             types.String `tfsdk:"type"`
+	DefaultRetention types.String `tfsdk:"default_retention"`
+	MinRetention     types.String `tfsdk:"min_retention"`
+	MaxRetention     types.String `tfsdk:"max_retention"`
+	AutocommitOffset types.Int64  `tfsdk:"autocommit_offset"`
+	PrivilegedDelete types.String `tfsdk:"privileged_delete"`
+	OverrideDate     types.Int64  `tfsdk:"override_date"`
+}
+
+// WormComplianceClockModel describes the state of the compliance clock of the cluster.
+type WormComplianceClockModel struct {
+	Set  types.Bool  `tfsdk:"set"`
+	Date types.Int64 `tfsdk:"date"`
+}
+
+// WormDomainDataSourceFilter describes the filter of the SmartLock domain data source.
+type WormDomainDataSourceFilter struct {
+	IDs   []types.String `tfsdk:"ids"`
+	Paths []types.String `tfsdk:"paths"`
+	Type  types.String   `tfsdk:"type"`
+}
@@ -17,7 +17,10 @@ limitations under the License.
 
 package models
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 // WritableSnapshot defines the writable snapshot.
 type WritableSnapshot struct {
@@ -38,6 +41,9 @@ type WritableSnapshot struct {
 
 	// Snapshot state.
 	State types.String `tfsdk:"state"`
+
+	// Timeouts of the operations waiting on the cluster.
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // WritableSnapshotDataSource defines the writable snapshot data source.
@@ -397,7 +397,12 @@ func (r *AccessZoneResource) Delete(ctx context.Context, req resource.DeleteRequ
 // ImportState imports the resource state.
 func (r *AccessZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "importing access zone")
-	id := req.ID
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := parsedID.ID
 	result, err := helper.GetAllAccessZones(ctx, r.client)
 	if err != nil {
 		errStr := constants.ReadAccessZoneErrorMsg + "with error: "
@@ -30,6 +30,7 @@ import (
 )
 
 func TestAccAclSettingsDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	var aclServerTerraformName = "data.powerscale_aclsettings.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -50,6 +51,7 @@ func TestAccAclSettingsDataSourceAll(t *testing.T) {
 }
 
 func TestAccAclSettingsDataSourceGettingErr(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -69,6 +71,7 @@ func TestAccAclSettingsDataSourceGettingErr(t *testing.T) {
 }
 
 func TestAccAclSettingsDataSourceMappingErr(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
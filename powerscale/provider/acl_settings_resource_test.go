@@ -27,6 +27,7 @@ import (
 )
 
 func TestAccAclSettingsResource(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -62,6 +63,7 @@ func TestAccAclSettingsResource(t *testing.T) {
 }
 
 func TestAccAclSettingsResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -88,6 +90,7 @@ func TestAccAclSettingsResourceErrorRead(t *testing.T) {
 }
 
 func TestAccAclSettingsResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -134,6 +137,7 @@ func TestAccAclSettingsResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccAclSettingsResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -150,6 +154,7 @@ func TestAccAclSettingsResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccAclSettingsResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -187,6 +192,7 @@ func TestAccAclSettingsResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccAclSettingsResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "the ACL settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccAdsProviderDataSourceNames(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	TestAccClusterTimeResourceMock(t)
 	var adsTerraformName = "data.powerscale_adsprovider.test"
 	resource.Test(t, resource.TestCase{
@@ -52,6 +53,7 @@ func TestAccAdsProviderDataSourceNames(t *testing.T) {
 }
 
 func TestAccAdsProviderDataSourceFilter(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	var adsTerraformName = "data.powerscale_adsprovider.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -73,6 +75,7 @@ func TestAccAdsProviderDataSourceFilter(t *testing.T) {
 }
 
 func TestAccAdsProviderDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	var adsTerraformName = "data.powerscale_adsprovider.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -90,6 +93,7 @@ func TestAccAdsProviderDataSourceAll(t *testing.T) {
 }
 
 func TestAccAdsProviderDataSourceNamesErr(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -103,6 +107,7 @@ func TestAccAdsProviderDataSourceNamesErr(t *testing.T) {
 }
 
 func TestAccAdsProviderDataSourceFilterErr(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -116,6 +121,7 @@ func TestAccAdsProviderDataSourceFilterErr(t *testing.T) {
 }
 
 func TestAccAdsProviderDataSourceMappingErr(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -21,8 +21,11 @@ import (
 	"context"
 	powerscale "dell/powerscale-go-client"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/constants"
@@ -318,10 +321,26 @@ func (r *AdsProviderResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				Description:         "Specifies the password used during domain join.",
-				MarkdownDescription: "Specifies the password used during domain join.",
-				Required:            true,
+				Description:         "Specifies the password used during domain join. Exactly one of password and password_wo must be set.",
+				MarkdownDescription: "Specifies the password used during domain join. Exactly one of `password` and `password_wo` must be set.",
+				Optional:            true,
 				Sensitive:           true,
+				Validators:          []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot("password_wo"))},
+			},
+			"password_wo": schema.StringAttribute{
+				Description: "Specifies the password used during domain join, without storing it in the Terraform state. Requires Terraform 1.11 or later." +
+					" The password is sent on creation and whenever password_wo_version changes.",
+				MarkdownDescription: "Specifies the password used during domain join, without storing it in the Terraform state. Requires Terraform 1.11 or later." +
+					" The password is sent on creation and whenever `password_wo_version` changes.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description:         "The version of password_wo. Change it to send password_wo to the cluster again.",
+				MarkdownDescription: "The version of `password_wo`. Change it to send `password_wo` to the cluster again.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("password_wo"))},
 			},
 			"reset_schannel": schema.BoolAttribute{
 				Description:         "Resets the secure channel to the primary domain.",
@@ -453,9 +472,19 @@ func (r *AdsProviderResource) Create(ctx context.Context, req resource.CreateReq
 		)
 		return
 	}
+	password, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, nil, "password_wo", "password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the write-only password is sent in place of the password, but never stored in the state
+	params := plan
+	if !password.IsNull() {
+		params.Password = password
+	}
 	adsToCreate := powerscale.V14ProvidersAdsItem{}
 	// Get param from tf input
-	err := helper.ReadFromState(ctx, plan, &adsToCreate)
+	err := helper.ReadFromState(ctx, params, &adsToCreate)
 	if err != nil {
 		errStr := constants.CreateAdsProviderErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -608,9 +637,18 @@ func (r *AdsProviderResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 	adsID := adsState.ID.ValueString()
 	adsPlan.ID = adsState.ID
+	password, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, &req.State, "password_wo", "password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := adsPlan
+	if !password.IsNull() {
+		params.Password = password
+	}
 	var adsToUpdate powerscale.V14ProvidersAdsIdParams
 	// Get param from tf input
-	err := helper.ReadFromState(ctx, adsPlan, &adsToUpdate)
+	err := helper.ReadFromState(ctx, params, &adsToUpdate)
 	if err != nil {
 		errStr := constants.UpdateAdsProviderErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -714,5 +752,10 @@ func (r *AdsProviderResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // ImportState imports the resource state.
 func (r *AdsProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parsedID.ID)...)
 }
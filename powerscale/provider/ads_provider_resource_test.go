@@ -30,6 +30,7 @@ import (
 )
 
 func TestAccAdsProviderResource(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -67,6 +68,7 @@ func TestAccAdsProviderResource(t *testing.T) {
 }
 
 func TestAccAdsProviderResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -103,6 +105,7 @@ func TestAccAdsProviderResourceErrorRead(t *testing.T) {
 }
 
 func TestAccAdsProviderResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -168,6 +171,7 @@ func TestAccAdsProviderResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccAdsProviderResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -185,6 +189,7 @@ func TestAccAdsProviderResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccAdsProviderResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -222,6 +227,7 @@ func TestAccAdsProviderResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccAdsProviderResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "Active Directory providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
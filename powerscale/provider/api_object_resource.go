@@ -0,0 +1,331 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiPathRegex matches the absolute PAPI paths accepted by the api resources.
+var apiPathRegex = regexp.MustCompile(`^/[^?#]*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIObjectResource{}
+var _ resource.ResourceWithConfigure = &APIObjectResource{}
+var _ resource.ResourceWithImportState = &APIObjectResource{}
+var _ resource.ResourceWithValidateConfig = &APIObjectResource{}
+
+// NewAPIObjectResource creates a new resource.
+func NewAPIObjectResource() resource.Resource {
+	return &APIObjectResource{}
+}
+
+// APIObjectResource defines the resource implementation.
+type APIObjectResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *APIObjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_object"
+}
+
+// Schema describes the resource arguments.
+func (r *APIObjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage an arbitrary object of the PowerScale Platform API (PAPI), for settings and objects the provider has no dedicated resource for. " +
+			"Requests are sent with the authentication of the provider. Only the fields of `body` are compared with the object read from PAPI to detect drift.",
+		Description: "This resource is used to manage an arbitrary object of the PowerScale Platform API (PAPI), for settings and objects the provider has no dedicated resource for. " +
+			"Requests are sent with the authentication of the provider. Only the fields of body are compared with the object read from PAPI to detect drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the object, read from the `id_attribute` field of the creation response, or the path when the response has no ID, as for settings.",
+				MarkdownDescription: "ID of the object, read from the `id_attribute` field of the creation response, or the path when the response has no ID, as for settings.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"path": schema.StringAttribute{
+				Description:         "PAPI path the object is created at, e.g. /platform/1/protocols/hdfs/racks.",
+				MarkdownDescription: "PAPI path the object is created at, e.g. `/platform/1/protocols/hdfs/racks`.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.RegexMatches(apiPathRegex, "must be an absolute path")},
+			},
+			"create_method": schema.StringAttribute{
+				Description:         "HTTP method creating the object. Defaults to POST, use PUT for settings.",
+				MarkdownDescription: "HTTP method creating the object. Defaults to `POST`, use `PUT` for settings.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(http.MethodPost),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:          []validator.String{stringvalidator.OneOf(http.MethodPost, http.MethodPut, http.MethodPatch)},
+			},
+			"id_attribute": schema.StringAttribute{
+				Description:         "Field of the creation response holding the ID of the object. Defaults to id.",
+				MarkdownDescription: "Field of the creation response holding the ID of the object. Defaults to `id`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("id"),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"read_path": schema.StringAttribute{
+				Description:         "PAPI path reading the object, where {id} stands for the object ID. Defaults to path followed by /{id} when the creation response holds an ID, and to path otherwise.",
+				MarkdownDescription: "PAPI path reading the object, where `{id}` stands for the object ID. Defaults to `path` followed by `/{id}` when the creation response holds an ID, and to `path` otherwise.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{stringvalidator.RegexMatches(apiPathRegex, "must be an absolute path")},
+			},
+			"update_path": schema.StringAttribute{
+				Description:         "PAPI path updating the object, where {id} stands for the object ID. Defaults to read_path.",
+				MarkdownDescription: "PAPI path updating the object, where `{id}` stands for the object ID. Defaults to `read_path`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{stringvalidator.RegexMatches(apiPathRegex, "must be an absolute path")},
+			},
+			"update_method": schema.StringAttribute{
+				Description:         "HTTP method updating the object. Defaults to PUT.",
+				MarkdownDescription: "HTTP method updating the object. Defaults to `PUT`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(http.MethodPut),
+				Validators:          []validator.String{stringvalidator.OneOf(http.MethodPut, http.MethodPatch, http.MethodPost)},
+			},
+			"delete_path": schema.StringAttribute{
+				Description:         "PAPI path deleting the object, where {id} stands for the object ID. Defaults to read_path.",
+				MarkdownDescription: "PAPI path deleting the object, where `{id}` stands for the object ID. Defaults to `read_path`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators:          []validator.String{stringvalidator.RegexMatches(apiPathRegex, "must be an absolute path")},
+			},
+			"delete_method": schema.StringAttribute{
+				Description:         "HTTP method deleting the object. Defaults to DELETE. NONE leaves the object on the cluster on destroy, e.g. for settings; PUT with delete_body restores them instead.",
+				MarkdownDescription: "HTTP method deleting the object. Defaults to `DELETE`. `NONE` leaves the object on the cluster on destroy, e.g. for settings; `PUT` with `delete_body` restores them instead.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(http.MethodDelete),
+				Validators:          []validator.String{stringvalidator.OneOf(http.MethodDelete, http.MethodPut, http.MethodPost, helper.APIObjectNoDelete)},
+			},
+			"query": schema.MapAttribute{
+				Description:         "Query parameters sent with every request, e.g. the access zone.",
+				MarkdownDescription: "Query parameters sent with every request, e.g. the access `zone`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+			"body": schema.StringAttribute{
+				Description:         "JSON object sent to create and update the object, usually built with jsonencode.",
+				MarkdownDescription: "JSON object sent to create and update the object, usually built with `jsonencode`.",
+				Required:            true,
+			},
+			"delete_body": schema.StringAttribute{
+				Description:         "JSON object sent with the delete request.",
+				MarkdownDescription: "JSON object sent with the delete request.",
+				Optional:            true,
+			},
+			"response": schema.StringAttribute{
+				Description:         "JSON object of the object as last read from PAPI, to be decoded with jsondecode.",
+				MarkdownDescription: "JSON object of the object as last read from PAPI, to be decoded with `jsondecode`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *APIObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ValidateConfig validates that the bodies are JSON objects.
+func (r *APIObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg models.APIObjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, value := range map[string]types.String{"body": cfg.Body, "delete_body": cfg.DeleteBody} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if err := helper.ValidateJSONObject(value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), fmt.Sprintf("Invalid %s", name), err.Error())
+		}
+	}
+}
+
+// Create allocates the resource.
+func (r *APIObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating API object")
+	var plan models.APIObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.CreateAPIObject(ctx, r.client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the planned body is kept, so the state matches the configuration even if PAPI normalizes some values
+	body := plan.Body
+	found, diags := helper.ReadAPIObject(ctx, r.client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error creating API object",
+			fmt.Sprintf("The object was created but could not be read at %s, set read_path.",
+				helper.ExpandAPIObjectPath(plan.ReadPath.ValueString(), plan.ID.ValueString())))
+		return
+	}
+	plan.Body = body
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Create API object resource state")
+}
+
+// Read reads the resource state.
+func (r *APIObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading API object")
+	var state models.APIObjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := helper.ReadAPIObject(ctx, r.client, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "API object not found, removing it from the state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with Read API object resource state")
+}
+
+// Update updates the resource state.
+func (r *APIObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating API object")
+	var plan models.APIObjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.UpdateAPIObject(ctx, r.client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body := plan.Body
+	found, diags := helper.ReadAPIObject(ctx, r.client, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error updating API object", "The object could not be read after the update.")
+		return
+	}
+	plan.Body = body
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with Update API object resource state")
+}
+
+// Delete deletes the resource.
+func (r *APIObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting API object")
+	var state models.APIObjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.DeleteAPIObject(ctx, r.client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with Delete API object resource state")
+}
+
+// ImportState imports the resource state from the read path of the object, e.g. /platform/1/protocols/smb/shares/share?zone=zone.
+func (r *APIObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var state models.APIObjectResourceModel
+	if err := helper.ImportAPIObject(req.ID, &state); err != nil {
+		resp.Diagnostics.AddError("Error importing API object", err.Error())
+		return
+	}
+
+	found, diags := helper.ReadAPIObject(ctx, r.client, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error importing API object", fmt.Sprintf("No object found at %s", req.ID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIObjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + APIObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_api_object.example", "id", "/tfaccAPIObject"),
+					resource.TestCheckResourceAttr("powerscale_api_object.example", "read_path", "/platform/2/protocols/nfs/aliases/{id}"),
+					resource.TestCheckResourceAttr("powerscale_api_object.example", "delete_method", "DELETE"),
+					resource.TestCheckResourceAttrSet("powerscale_api_object.example", "response"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "powerscale_api_object.example",
+				ImportState:             true,
+				ImportStateId:           "/platform/2/protocols/nfs/aliases/%2FtfaccAPIObject",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+			// Update and Read testing
+			{
+				Config: ProviderConfig + APIObjectResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_api_object.example", "body", `{"name":"/tfaccAPIObject","path":"/ifs/data"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAPIObjectResourceInvalidBody(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + APIObjectResourceConfigInvalidBody,
+				ExpectError: regexp.MustCompile(`.*invalid JSON object*.`),
+			},
+		},
+	})
+}
+
+func TestAccAPIObjectResourceMockErr(t *testing.T) {
+	var diags diag.Diagnostics
+	diags.AddError("mock error", "mock error")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.CreateAPIObject).Return(diags).Build()
+				},
+				Config:      ProviderConfig + APIObjectResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + APIObjectResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateAPIObject).Return(diags).Build()
+				},
+				Config:      ProviderConfig + APIObjectResourceConfigUpdate,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.ReadAPIObject).Return(false, diags).Build()
+				},
+				Config:      ProviderConfig + APIObjectResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config: ProviderConfig + APIObjectResourceConfig,
+			},
+		},
+	})
+}
+
+var APIObjectResourceConfig = `
+resource "powerscale_api_object" "example" {
+	path = "/platform/2/protocols/nfs/aliases"
+	body = jsonencode({
+		name = "/tfaccAPIObject"
+		path = "/ifs"
+	})
+}
+`
+
+var APIObjectResourceConfigUpdate = `
+resource "powerscale_api_object" "example" {
+	path = "/platform/2/protocols/nfs/aliases"
+	body = jsonencode({
+		name = "/tfaccAPIObject"
+		path = "/ifs/data"
+	})
+}
+`
+
+var APIObjectResourceConfigInvalidBody = `
+resource "powerscale_api_object" "example" {
+	path = "/platform/2/protocols/nfs/aliases"
+	body = "[\"not an object\"]"
+}
+`
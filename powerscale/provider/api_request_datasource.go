@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &APIRequestDataSource{}
+	_ datasource.DataSourceWithConfigure = &APIRequestDataSource{}
+)
+
+// NewAPIRequestDataSource returns the APIRequest data source object.
+func NewAPIRequestDataSource() datasource.DataSource {
+	return &APIRequestDataSource{}
+}
+
+// APIRequestDataSource defines the data source implementation.
+type APIRequestDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *APIRequestDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_request"
+}
+
+// Schema describes the data source arguments.
+func (d *APIRequestDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to read an arbitrary path of the PowerScale Platform API (PAPI), for information the provider has no dedicated datasource for. " +
+			"The request is sent with GET and the authentication of the provider.",
+		Description: "This datasource is used to read an arbitrary path of the PowerScale Platform API (PAPI), for information the provider has no dedicated datasource for. " +
+			"The request is sent with GET and the authentication of the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"path": schema.StringAttribute{
+				Description:         "PAPI path to read, e.g. /platform/1/protocols/hdfs/settings.",
+				MarkdownDescription: "PAPI path to read, e.g. `/platform/1/protocols/hdfs/settings`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.RegexMatches(apiPathRegex, "must be an absolute path")},
+			},
+			"query": schema.MapAttribute{
+				Description:         "Query parameters of the request, e.g. the access zone.",
+				MarkdownDescription: "Query parameters of the request, e.g. the access `zone`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status_code": schema.Int64Attribute{
+				Description:         "HTTP status of the response.",
+				MarkdownDescription: "HTTP status of the response.",
+				Computed:            true,
+			},
+			"response_body": schema.StringAttribute{
+				Description:         "Body of the response, to be decoded with jsondecode.",
+				MarkdownDescription: "Body of the response, to be decoded with `jsondecode`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *APIRequestDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *APIRequestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading API request data source ")
+	var state models.APIRequestDataSourceModel
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, status, err := helper.SendAPIRequest(ctx, d.client, http.MethodGet, state.Path.ValueString(), state.Query, "")
+	if err != nil {
+		errStr := constants.APIRequestErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error reading api request datasource", message)
+		return
+	}
+
+	state.ID = types.StringValue(state.Path.ValueString())
+	state.StatusCode = types.Int64Value(int64(status))
+	state.ResponseBody = types.StringValue(string(body))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAPIRequestDataSource(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + APIRequestDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerscale_api_request.cluster_config", "status_code", "200"),
+					resource.TestCheckResourceAttrSet("data.powerscale_api_request.cluster_config", "response_body"),
+					resource.TestCheckOutput("cluster_guid_set", "true"),
+				),
+			},
+			{
+				Config:      ProviderConfig + APIRequestDataSourceNotFoundConfig,
+				ExpectError: regexp.MustCompile(`.*Error reading api request datasource*.`),
+			},
+		},
+	})
+}
+
+func TestAccAPIRequestDataSourceMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.SendAPIRequest).Return(nil, 0, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + APIRequestDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var APIRequestDataSourceConfig = `
+data "powerscale_api_request" "cluster_config" {
+	path = "/platform/1/cluster/config"
+}
+
+output "cluster_guid_set" {
+	value = length(jsondecode(data.powerscale_api_request.cluster_config.response_body).guid) > 0
+}
+`
+
+var APIRequestDataSourceNotFoundConfig = `
+data "powerscale_api_request" "missing" {
+	path = "/platform/1/tfacc/missing"
+}
+`
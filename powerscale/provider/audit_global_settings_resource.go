@@ -0,0 +1,246 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &AuditGlobalSettingsResource{}
+	_ resource.ResourceWithConfigure   = &AuditGlobalSettingsResource{}
+	_ resource.ResourceWithImportState = &AuditGlobalSettingsResource{}
+)
+
+// NewAuditGlobalSettingsResource creates a new resource.
+func NewAuditGlobalSettingsResource() resource.Resource {
+	return &AuditGlobalSettingsResource{}
+}
+
+// AuditGlobalSettingsResource defines the resource implementation.
+type AuditGlobalSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *AuditGlobalSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_global_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *AuditGlobalSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the audit global settings of PowerScale Array, which enable the protocol and configuration auditing and forward the audit events. " +
+			"We can Create, Update and Delete the audit global settings using this resource. We can also import the existing audit global settings. " +
+			"Note that the audit global settings are the native functionality of PowerScale, deleting the resource only removes it from the Terraform state.",
+		Description: "This resource is used to manage the audit global settings of PowerScale Array, which enable the protocol and configuration auditing and forward the audit events. " +
+			"We can Create, Update and Delete the audit global settings using this resource. We can also import the existing audit global settings. " +
+			"Note that the audit global settings are the native functionality of PowerScale, deleting the resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the audit global settings.",
+				MarkdownDescription: "ID of the audit global settings.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"protocol_auditing_enabled": schema.BoolAttribute{
+				Description:         "Whether the protocol auditing is enabled, for the access zones in audited_zones.",
+				MarkdownDescription: "Whether the protocol auditing is enabled, for the access zones in `audited_zones`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"config_auditing_enabled": schema.BoolAttribute{
+				Description:         "Whether the auditing of the configuration changes made through PAPI is enabled.",
+				MarkdownDescription: "Whether the auditing of the configuration changes made through PAPI is enabled.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"config_syslog_enabled": schema.BoolAttribute{
+				Description:         "Whether the configuration audit events are forwarded to syslog.",
+				MarkdownDescription: "Whether the configuration audit events are forwarded to syslog.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"audited_zones": schema.SetAttribute{
+				Description:         "Access zones whose protocol events are audited. The audited events of each zone are set by the powerscale_audit_zone_settings resource.",
+				MarkdownDescription: "Access zones whose protocol events are audited. The audited events of each zone are set by the `powerscale_audit_zone_settings` resource.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"cee_server_uris": schema.ListAttribute{
+				Description:         "URIs of the Common Event Enabler (CEE) servers the protocol audit events are forwarded to, such as http://cee.example.com:12228/cee.",
+				MarkdownDescription: "URIs of the Common Event Enabler (CEE) servers the protocol audit events are forwarded to, such as `http://cee.example.com:12228/cee`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1))},
+			},
+			"hostname": schema.StringAttribute{
+				Description:         "Hostname of the cluster reported in the protocol audit events forwarded to the CEE servers.",
+				MarkdownDescription: "Hostname of the cluster reported in the protocol audit events forwarded to the CEE servers.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"retention_period": schema.Int64Attribute{
+				Description:         "Days the audit logs are retained, before they are purged when auto_purging_enabled is set.",
+				MarkdownDescription: "Days the audit logs are retained, before they are purged when `auto_purging_enabled` is set.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"auto_purging_enabled": schema.BoolAttribute{
+				Description:         "Whether the audit logs older than the retention period are purged automatically.",
+				MarkdownDescription: "Whether the audit logs older than the retention period are purged automatically.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AuditGlobalSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create updates the audit global settings.
+func (r *AuditGlobalSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating audit global settings")
+	var plan models.AuditGlobalSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating audit global settings")
+}
+
+// Read reads the resource state.
+func (r *AuditGlobalSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading audit global settings")
+	var state models.AuditGlobalSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetAuditGlobalSettings(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading audit global settings", helper.GetErrorString(err, constants.ReadAuditGlobalSettingsErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateAuditGlobalSettingsState(ctx, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading audit global settings")
+}
+
+// Update updates the audit global settings.
+func (r *AuditGlobalSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating audit global settings")
+	var plan models.AuditGlobalSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating audit global settings")
+}
+
+// Delete removes the resource from the state, the cluster keeps its audit settings.
+func (r *AuditGlobalSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting audit global settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting audit global settings")
+}
+
+// ImportState imports the audit global settings.
+func (r *AuditGlobalSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing audit global settings")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply sends the settings of the plan and returns the settings read back.
+func (r *AuditGlobalSettingsResource) apply(ctx context.Context, plan models.AuditGlobalSettingsResourceModel) (models.AuditGlobalSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	if err := helper.UpdateAuditGlobalSettings(ctx, r.client, plan); err != nil {
+		diags.AddError("Error updating audit global settings", helper.GetErrorString(err, constants.UpdateAuditGlobalSettingsErrorMsg+"with error: "))
+		return state, diags
+	}
+	settings, err := helper.GetAuditGlobalSettings(ctx, r.client)
+	if err != nil {
+		diags.AddError("Error reading audit global settings", helper.GetErrorString(err, constants.ReadAuditGlobalSettingsErrorMsg+"with error: "))
+		return state, diags
+	}
+	diags.Append(helper.UpdateAuditGlobalSettingsState(ctx, settings, &state)...)
+	return state, diags
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditGlobalSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuditGlobalSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "id", "audit_global_settings"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "protocol_auditing_enabled", "true"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "config_auditing_enabled", "true"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "audited_zones.#", "1"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "hostname", "tfacc-cluster"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "retention_period", "90"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_audit_global_settings.test",
+				ImportState:       true,
+				ImportStateId:     "audit_global_settings",
+				ImportStateVerify: true,
+			},
+			// Update testing, restoring the default settings
+			{
+				Config: ProviderConfig + AuditGlobalSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "protocol_auditing_enabled", "false"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "config_auditing_enabled", "false"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "audited_zones.#", "0"),
+					resource.TestCheckResourceAttr("powerscale_audit_global_settings.test", "cee_server_uris.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditGlobalSettingsResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateAuditGlobalSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuditGlobalSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetAuditGlobalSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuditGlobalSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + AuditGlobalSettingsResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var AuditGlobalSettingsResourceConfig = `
+resource "powerscale_audit_global_settings" "test" {
+	protocol_auditing_enabled = true
+	config_auditing_enabled   = true
+	audited_zones             = ["System"]
+	cee_server_uris           = ["http://tfacc-cee.example.com:12228/cee"]
+	hostname                  = "tfacc-cluster"
+	retention_period          = 90
+}
+`
+
+var AuditGlobalSettingsResourceUpdateConfig = `
+resource "powerscale_audit_global_settings" "test" {
+	protocol_auditing_enabled = false
+	config_auditing_enabled   = false
+	audited_zones             = []
+	cee_server_uris           = []
+	hostname                  = ""
+	retention_period          = 180
+}
+`
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &AuditTopicDataSource{}
+	_ datasource.DataSourceWithConfigure = &AuditTopicDataSource{}
+)
+
+// NewAuditTopicDataSource returns the AuditTopic data source object.
+func NewAuditTopicDataSource() datasource.DataSource {
+	return &AuditTopicDataSource{}
+}
+
+// AuditTopicDataSource defines the data source implementation.
+type AuditTopicDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *AuditTopicDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_topic"
+}
+
+// Schema describes the data source arguments.
+func (d *AuditTopicDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the audit topics of PowerScale Array, i.e. the protocol and configuration auditing, and whether their auditing is enabled. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description: "This datasource is used to query the audit topics of PowerScale Array, i.e. the protocol and configuration auditing, and whether their auditing is enabled. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"audit_topics": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of audit topics",
+				MarkdownDescription: "List of audit topics",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "ID of the audit topic.",
+							MarkdownDescription: "ID of the audit topic.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							Description:         "Name of the audit topic, e.g. protocol or config.",
+							MarkdownDescription: "Name of the audit topic, e.g. `protocol` or `config`.",
+							Computed:            true,
+						},
+						"max_cached_messages": schema.Int64Attribute{
+							Description:         "Maximum number of messages of the topic cached before they are forwarded.",
+							MarkdownDescription: "Maximum number of messages of the topic cached before they are forwarded.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the auditing of the topic is enabled in the audit global settings.",
+							MarkdownDescription: "Whether the auditing of the topic is enabled in the audit global settings.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Description:         "IDs of the audit topics to list.",
+						MarkdownDescription: "IDs of the audit topics to list.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *AuditTopicDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *AuditTopicDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading audit topic data source")
+	var config models.AuditTopicDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	if config.Filter != nil {
+		for _, id := range config.Filter.IDs {
+			ids = append(ids, id.ValueString())
+		}
+	}
+	topics, err := helper.ListAuditTopics(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading audit topics", helper.GetErrorString(err, constants.ReadAuditTopicErrorMsg+"with error: "))
+		return
+	}
+	settings, err := helper.GetAuditGlobalSettings(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading audit topics", helper.GetErrorString(err, constants.ReadAuditGlobalSettingsErrorMsg+"with error: "))
+		return
+	}
+
+	state := models.AuditTopicDataSourceModel{
+		ID:          types.StringValue("audit_topic_datasource"),
+		AuditTopics: []models.AuditTopicDataSourceEntity{},
+		Filter:      config.Filter,
+	}
+	for _, topic := range topics {
+		if len(ids) > 0 && !slices.Contains(ids, topic.GetId()) {
+			continue
+		}
+		state.AuditTopics = append(state.AuditTopics, models.AuditTopicDataSourceEntity{
+			ID:                types.StringValue(topic.GetId()),
+			Name:              types.StringValue(topic.GetName()),
+			MaxCachedMessages: types.Int64Value(int64(topic.GetMaxCachedMessages())),
+			Enabled:           types.BoolValue(helper.AuditTopicEnabled(topic.GetName(), settings)),
+		})
+	}
+	if len(state.AuditTopics) < len(ids) {
+		resp.Diagnostics.AddError("Error reading audit topics", fmt.Sprintf("Could not find all the audit topics %v", ids))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading audit topic data source")
+}
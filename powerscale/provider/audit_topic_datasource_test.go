@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditTopicDatasource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + AuditTopicDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_audit_topic.all", "audit_topics.#"),
+					resource.TestCheckResourceAttr("data.powerscale_audit_topic.filtered", "audit_topics.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_audit_topic.filtered", "audit_topics.0.name", "protocol"),
+					resource.TestCheckResourceAttrSet("data.powerscale_audit_topic.filtered", "audit_topics.0.enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditTopicDatasourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + AuditTopicDatasourceUnknownConfig,
+				ExpectError: regexp.MustCompile("Could not find all the audit topics"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListAuditTopics).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuditTopicDatasourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetAuditGlobalSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuditTopicDatasourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + AuditTopicDatasourceConfig,
+			},
+		},
+	})
+}
+
+var AuditTopicDatasourceConfig = `
+data "powerscale_audit_topic" "all" {
+}
+
+data "powerscale_audit_topic" "filtered" {
+	filter {
+		ids = ["protocol"]
+	}
+}
+`
+
+var AuditTopicDatasourceUnknownConfig = `
+data "powerscale_audit_topic" "unknown" {
+	filter {
+		ids = ["tfacc_unknown_topic"]
+	}
+}
+`
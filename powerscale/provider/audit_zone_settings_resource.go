@@ -0,0 +1,249 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &AuditZoneSettingsResource{}
+	_ resource.ResourceWithConfigure   = &AuditZoneSettingsResource{}
+	_ resource.ResourceWithImportState = &AuditZoneSettingsResource{}
+)
+
+// NewAuditZoneSettingsResource creates a new resource.
+func NewAuditZoneSettingsResource() resource.Resource {
+	return &AuditZoneSettingsResource{}
+}
+
+// AuditZoneSettingsResource defines the resource implementation.
+type AuditZoneSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *AuditZoneSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_zone_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *AuditZoneSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the audit settings of an access zone of PowerScale Array, i.e. the audited protocol events and their forwarding to syslog. " +
+			"The events are audited once the access zone is in the audited_zones of the powerscale_audit_global_settings resource. " +
+			"We can Create, Update and Delete the audit zone settings using this resource. We can also import the existing audit settings of an access zone. " +
+			"Note that the audit zone settings are the native functionality of PowerScale, deleting the resource only removes it from the Terraform state.",
+		Description: "This resource is used to manage the audit settings of an access zone of PowerScale Array, i.e. the audited protocol events and their forwarding to syslog. " +
+			"The events are audited once the access zone is in the audited_zones of the powerscale_audit_global_settings resource. " +
+			"We can Create, Update and Delete the audit zone settings using this resource. We can also import the existing audit settings of an access zone. " +
+			"Note that the audit zone settings are the native functionality of PowerScale, deleting the resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the audit zone settings, which is the access zone.",
+				MarkdownDescription: "ID of the audit zone settings, which is the access zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Description:         "Access zone",
+				MarkdownDescription: "Access zone",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"audit_success": schema.SetAttribute{
+				Description:         "Protocol events audited when they succeed, e.g. create, delete, rename, set_security or all.",
+				MarkdownDescription: "Protocol events audited when they succeed, e.g. `create`, `delete`, `rename`, `set_security` or `all`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.AuditEvents...))},
+			},
+			"audit_failure": schema.SetAttribute{
+				Description:         "Protocol events audited when they fail, e.g. create, delete, rename, set_security or all.",
+				MarkdownDescription: "Protocol events audited when they fail, e.g. `create`, `delete`, `rename`, `set_security` or `all`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.AuditEvents...))},
+			},
+			"syslog_forwarding_enabled": schema.BoolAttribute{
+				Description:         "Whether the audited protocol events of the access zone are forwarded to syslog.",
+				MarkdownDescription: "Whether the audited protocol events of the access zone are forwarded to syslog.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"syslog_audit_events": schema.SetAttribute{
+				Description:         "Protocol events forwarded to syslog, among the audited ones.",
+				MarkdownDescription: "Protocol events forwarded to syslog, among the audited ones.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.AuditEvents...))},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *AuditZoneSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create updates the audit settings of the access zone.
+func (r *AuditZoneSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating audit zone settings")
+	var plan models.AuditZoneSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating audit zone settings")
+}
+
+// Read reads the resource state.
+func (r *AuditZoneSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading audit zone settings")
+	var state models.AuditZoneSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := state.Zone.ValueString()
+	settings, err := helper.GetAuditZoneSettings(ctx, r.client, zone)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading audit zone settings", helper.GetErrorString(err, constants.ReadAuditZoneSettingsErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateAuditZoneSettingsState(ctx, zone, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading audit zone settings")
+}
+
+// Update updates the audit settings of the access zone.
+func (r *AuditZoneSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating audit zone settings")
+	var plan models.AuditZoneSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating audit zone settings")
+}
+
+// Delete removes the resource from the state, the access zone keeps its audit settings.
+func (r *AuditZoneSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting audit zone settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting audit zone settings")
+}
+
+// ImportState imports the audit settings of an access zone.
+func (r *AuditZoneSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetAuditZoneSettings(ctx, r.client, parsedID.Zone)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing audit zone settings", helper.GetErrorString(err, constants.ReadAuditZoneSettingsErrorMsg+"with error: "))
+		return
+	}
+	var state models.AuditZoneSettingsResourceModel
+	resp.Diagnostics.Append(helper.UpdateAuditZoneSettingsState(ctx, parsedID.Zone, settings, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply sends the settings of the plan and returns the settings read back.
+func (r *AuditZoneSettingsResource) apply(ctx context.Context, plan models.AuditZoneSettingsResourceModel) (models.AuditZoneSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	if err := helper.UpdateAuditZoneSettings(ctx, r.client, plan); err != nil {
+		diags.AddError("Error updating audit zone settings", helper.GetErrorString(err, constants.UpdateAuditZoneSettingsErrorMsg+"with error: "))
+		return state, diags
+	}
+	settings, err := helper.GetAuditZoneSettings(ctx, r.client, plan.Zone.ValueString())
+	if err != nil {
+		diags.AddError("Error reading audit zone settings", helper.GetErrorString(err, constants.ReadAuditZoneSettingsErrorMsg+"with error: "))
+		return state, diags
+	}
+	diags.Append(helper.UpdateAuditZoneSettingsState(ctx, plan.Zone.ValueString(), settings, &state)...)
+	return state, diags
+}
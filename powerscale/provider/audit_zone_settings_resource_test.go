@@ -0,0 +1,128 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAuditZoneSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + AuditZoneSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_audit_zone_settings.test", "id", "System"),
+					resource.TestCheckResourceAttr("powerscale_audit_zone_settings.test", "audit_success.#", "3"),
+					resource.TestCheckTypeSetElemAttr("powerscale_audit_zone_settings.test", "audit_failure.*", "all"),
+					resource.TestCheckResourceAttr("powerscale_audit_zone_settings.test", "syslog_forwarding_enabled", "true"),
+					resource.TestCheckResourceAttr("powerscale_audit_zone_settings.test", "syslog_audit_events.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_audit_zone_settings.test",
+				ImportState:       true,
+				ImportStateId:     "System",
+				ImportStateVerify: true,
+			},
+			// Update testing, restoring the default settings
+			{
+				Config: ProviderConfig + AuditZoneSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_audit_zone_settings.test", "audit_success.#", "4"),
+					resource.TestCheckResourceAttr("powerscale_audit_zone_settings.test", "syslog_forwarding_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditZoneSettingsResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + AuditZoneSettingsResourceInvalidEventConfig,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateAuditZoneSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuditZoneSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetAuditZoneSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + AuditZoneSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config:        ProviderConfig + AuditZoneSettingsResourceUpdateConfig,
+				ResourceName:  "powerscale_audit_zone_settings.test",
+				ImportState:   true,
+				ImportStateId: "tfacc_unknown_zone",
+				ExpectError:   regexp.MustCompile("Error importing audit zone settings"),
+			},
+		},
+	})
+}
+
+var AuditZoneSettingsResourceConfig = `
+resource "powerscale_audit_zone_settings" "test" {
+	zone                      = "System"
+	audit_success             = ["create", "delete", "rename"]
+	audit_failure             = ["all"]
+	syslog_forwarding_enabled = true
+	syslog_audit_events       = ["delete"]
+}
+`
+
+var AuditZoneSettingsResourceUpdateConfig = `
+resource "powerscale_audit_zone_settings" "test" {
+	zone                      = "System"
+	audit_success             = ["create", "delete", "rename", "set_security"]
+	audit_failure             = ["create", "delete", "rename", "set_security"]
+	syslog_forwarding_enabled = false
+	syslog_audit_events       = ["create", "delete", "rename", "set_security"]
+}
+`
+
+var AuditZoneSettingsResourceInvalidEventConfig = `
+resource "powerscale_audit_zone_settings" "test" {
+	zone          = "System"
+	audit_success = ["mkdir"]
+}
+`
@@ -29,6 +29,7 @@ import (
 )
 
 func TestAccClusterDataSource(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -62,6 +63,7 @@ func TestAccClusterDataSource(t *testing.T) {
 }
 
 func TestAccClusterConfigError(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -85,6 +87,7 @@ func TestAccClusterConfigError(t *testing.T) {
 }
 
 func TestAccClusterIdentityError(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -108,6 +111,7 @@ func TestAccClusterIdentityError(t *testing.T) {
 }
 
 func TestAccClusterNodesError(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -131,6 +135,7 @@ func TestAccClusterNodesError(t *testing.T) {
 }
 
 func TestAccClusterInternalNetworksError(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -154,6 +159,7 @@ func TestAccClusterInternalNetworksError(t *testing.T) {
 }
 
 func TestAccClusterAcsError(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccClusterEmailDataSource(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	var clusterEmailName = "data.powerscale_cluster_email.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -51,6 +52,7 @@ func TestAccClusterEmailDataSource(t *testing.T) {
 }
 
 func TestAccClusterEmailDatasourceErrorGetAll(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
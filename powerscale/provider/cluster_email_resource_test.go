@@ -29,6 +29,7 @@ import (
 )
 
 func TestAccClusterEmailResourceImport(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	var clusterEmailResourceName = "powerscale_cluster_email.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -59,6 +60,7 @@ func TestAccClusterEmailResourceImport(t *testing.T) {
 }
 
 func TestAccClusterEmailResourceNullableField(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -77,6 +79,7 @@ func TestAccClusterEmailResourceNullableField(t *testing.T) {
 }
 
 func TestAccClusterEmailResourceUpdate(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	var clusterEmailResourceName = "powerscale_cluster_email.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -108,6 +111,7 @@ func TestAccClusterEmailResourceUpdate(t *testing.T) {
 }
 
 func TestAccClusterEmailResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -157,6 +161,7 @@ func TestAccClusterEmailResourceCreateMockErr(t *testing.T) {
 }
 
 func TestAccClusterEmailResourceUpdateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -203,6 +208,7 @@ func TestAccClusterEmailResourceUpdateMockErr(t *testing.T) {
 }
 
 func TestAccClusterEmailResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster email settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
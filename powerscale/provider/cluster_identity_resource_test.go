@@ -31,6 +31,7 @@ import (
 )
 
 func TestAccClusterIdentityResourceImport(t *testing.T) {
+	skipIfSimulated(t, "the cluster identity is not simulated")
 	var clusterIdentityResourceName = "powerscale_cluster_identity.test"
 
 	resource.Test(t, resource.TestCase{
@@ -78,6 +79,7 @@ func TestAccClusterIdentityResourceImport(t *testing.T) {
 
 // TestAccClusterIdentityResource - Tests the creation of a cluster Identity resource.
 func TestAccClusterIdentityResource(t *testing.T) {
+	skipIfSimulated(t, "the cluster identity is not simulated")
 	var clusterIdentityResourceName = "powerscale_cluster_identity.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -97,6 +99,7 @@ func TestAccClusterIdentityResource(t *testing.T) {
 
 // TestAccClusterIdentityResource_Update - Tests the update of a cluster Identity resource along with error mocking.
 func TestAccClusterIdentityResource_Update(t *testing.T) {
+	skipIfSimulated(t, "the cluster identity is not simulated")
 	var clusterIdentityResourceName = "powerscale_cluster_identity.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -183,6 +186,7 @@ func TestAccClusterIdentityResource_Update(t *testing.T) {
 
 // TestAccClusterIdentityResource_Create - Tests the mock errors during the create operation of the cluster Identity resource.
 func TestAccClusterIdentityResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster identity is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -230,6 +234,7 @@ func TestAccClusterIdentityResourceCreateMockErr(t *testing.T) {
 
 // TestAccClusterIdentityResource_Update - Tests the mock errors during the update operation of the cluster Identity resource.
 func TestAccClusterIdentityResourceUpdateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster identity is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -267,6 +272,7 @@ func TestAccClusterIdentityResourceUpdateMockErr(t *testing.T) {
 
 // TestAccClusterIdentityResource_Import - Tests the mock errors during the import of the cluster Identity resource.
 func TestAccClusterIdentityResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster identity is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
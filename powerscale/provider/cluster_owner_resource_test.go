@@ -32,6 +32,7 @@ import (
 )
 
 func TestAccClusterOwnerResourceImport(t *testing.T) {
+	skipIfSimulated(t, "the cluster owner is not simulated")
 	var clusterOwnerResourceName = "powerscale_cluster_owner.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -83,6 +84,7 @@ func TestAccClusterOwnerResourceImport(t *testing.T) {
 }
 
 func TestAccClusterOwnerResourceUpdate(t *testing.T) {
+	skipIfSimulated(t, "the cluster owner is not simulated")
 	var clusterOwnerResourceName = "powerscale_cluster_owner.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -187,6 +189,7 @@ func TestAccClusterOwnerResourceUpdate(t *testing.T) {
 }
 
 func TestAccClusterOwnerResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster owner is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -233,6 +236,7 @@ func TestAccClusterOwnerResourceCreateMockErr(t *testing.T) {
 }
 
 func TestAccClusterOwnerResourceUpdateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster owner is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -279,6 +283,7 @@ func TestAccClusterOwnerResourceUpdateMockErr(t *testing.T) {
 }
 
 func TestAccClusterOwnerResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "the cluster owner is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
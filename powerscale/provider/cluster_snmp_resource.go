@@ -22,11 +22,13 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-powerscale/client"
@@ -99,12 +101,11 @@ func (r *ClusterSnmpResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"snmp_v3_access": schema.BoolAttribute{
 				Description: "The SNMPv3 access for the Cluster SNMP." +
-					" Also requires snmp_v3_password.",
+					" Also requires snmp_v3_password or snmp_v3_password_wo.",
 				MarkdownDescription: "The SNMPv3 access for the Cluster SNMP." +
-					" Also requires snmp_v3_password.",
-				Computed:   true,
-				Optional:   true,
-				Validators: []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("snmp_v3_password"))},
+					" Also requires `snmp_v3_password` or `snmp_v3_password_wo`.",
+				Computed: true,
+				Optional: true,
 			},
 			"snmp_v3_password": schema.StringAttribute{
 				Description:         "The SNMPv3 authentication password for the Cluster SNMP.",
@@ -115,6 +116,25 @@ func (r *ClusterSnmpResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringvalidator.LengthAtLeast(8),
 				},
 			},
+			"snmp_v3_password_wo": schema.StringAttribute{
+				Description: "The SNMPv3 authentication password for the Cluster SNMP, without storing it in the Terraform state." +
+					" Requires Terraform 1.11 or later. The password is sent whenever snmp_v3_password_wo_version changes. Conflicts with snmp_v3_password.",
+				MarkdownDescription: "The SNMPv3 authentication password for the Cluster SNMP, without storing it in the Terraform state." +
+					" Requires Terraform 1.11 or later. The password is sent whenever `snmp_v3_password_wo_version` changes. Conflicts with `snmp_v3_password`.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(8),
+					stringvalidator.ConflictsWith(path.MatchRoot("snmp_v3_password")),
+				},
+			},
+			"snmp_v3_password_wo_version": schema.Int64Attribute{
+				Description:         "The version of snmp_v3_password_wo. Change it to send snmp_v3_password_wo to the cluster again.",
+				MarkdownDescription: "The version of `snmp_v3_password_wo`. Change it to send `snmp_v3_password_wo` to the cluster again.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("snmp_v3_password_wo"))},
+			},
 			"snmp_v3_auth_protocol": schema.StringAttribute{
 				Description: "The SNMPv3 authentication protocol for the Cluster SNMP." +
 					" Accepted values are `MD5`and `SHA`.",
@@ -141,6 +161,22 @@ func (r *ClusterSnmpResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				Optional:            true,
 			},
+			"snmp_v3_priv_password_wo": schema.StringAttribute{
+				Description: "The SNMPv3 privacy protocol password for the Cluster SNMP, without storing it in the Terraform state." +
+					" Requires Terraform 1.11 or later. The password is sent whenever snmp_v3_priv_password_wo_version changes. Conflicts with snmp_v3_priv_password.",
+				MarkdownDescription: "The SNMPv3 privacy protocol password for the Cluster SNMP, without storing it in the Terraform state." +
+					" Requires Terraform 1.11 or later. The password is sent whenever `snmp_v3_priv_password_wo_version` changes. Conflicts with `snmp_v3_priv_password`.",
+				Optional:   true,
+				Sensitive:  true,
+				WriteOnly:  true,
+				Validators: []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("snmp_v3_priv_password"))},
+			},
+			"snmp_v3_priv_password_wo_version": schema.Int64Attribute{
+				Description:         "The version of snmp_v3_priv_password_wo. Change it to send snmp_v3_priv_password_wo to the cluster again.",
+				MarkdownDescription: "The version of `snmp_v3_priv_password_wo`. Change it to send `snmp_v3_priv_password_wo` to the cluster again.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("snmp_v3_priv_password_wo"))},
+			},
 			"snmp_v3_read_only_user": schema.StringAttribute{
 				Description:         "The SNMPv3 read-only user for the Cluster SNMP.",
 				MarkdownDescription: "The SNMPv3 read-only user for the Cluster SNMP.",
@@ -199,6 +235,10 @@ func (r *ClusterSnmpResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(r.readWriteOnlyPasswords(ctx, req.Config, req.Plan, nil, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags := r.UpdateClusterSNMP(ctx, plan, &state)
 	if diags.HasError() {
@@ -257,6 +297,10 @@ func (r *ClusterSnmpResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(r.readWriteOnlyPasswords(ctx, req.Config, req.Plan, &req.State, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	diags := r.UpdateClusterSNMP(ctx, plan, &state)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
@@ -309,6 +353,18 @@ func mapBoolValue(v bool) *bool {
 	return &v
 }
 
+// readWriteOnlyPasswords sets the write-only passwords of the plan which must be sent to the cluster.
+func (r *ClusterSnmpResource) readWriteOnlyPasswords(ctx context.Context, config tfsdk.Config, plan tfsdk.Plan, state *tfsdk.State, model *models.ClusterSNMPModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	password, passwordDiags := helper.GetWriteOnlySecret(ctx, config, plan, state, "snmp_v3_password_wo", "snmp_v3_password_wo_version")
+	diags.Append(passwordDiags...)
+	privPassword, privPasswordDiags := helper.GetWriteOnlySecret(ctx, config, plan, state, "snmp_v3_priv_password_wo", "snmp_v3_priv_password_wo_version")
+	diags.Append(privPasswordDiags...)
+	model.SnmpV3PasswordWO = password
+	model.SnmpV3PrivPasswordWO = privPassword
+	return diags
+}
+
 // UpdateClusterSNMP is a common function that both Create and Update functions can call to update cluster SNMP.
 func (r *ClusterSnmpResource) UpdateClusterSNMP(ctx context.Context, plan models.ClusterSNMPModel, state *models.ClusterSNMPModel) diag.Diagnostics {
 	tflog.Info(ctx, "Creating Cluster SNMP Settings resource state")
@@ -317,6 +373,12 @@ func (r *ClusterSnmpResource) UpdateClusterSNMP(ctx context.Context, plan models
 
 	// Get param from tf input
 	err := helper.ReadFromState(ctx, &plan, &toUpdate)
+	if err == nil {
+		err = helper.SetWriteOnlyField(plan.SnmpV3PasswordWO, &toUpdate, "snmp_v3_password")
+	}
+	if err == nil {
+		err = helper.SetWriteOnlyField(plan.SnmpV3PrivPasswordWO, &toUpdate, "snmp_v3_priv_password")
+	}
 	if err != nil {
 		errStr := constants.UpdateClusterSNMPSettingsErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -370,6 +432,14 @@ func (r *ClusterSnmpResource) ValidateConfig(ctx context.Context, req resource.V
 		return
 	}
 
+	if !cfg.SnmpV3Access.IsNull() && cfg.SnmpV3Password.IsNull() && cfg.SnmpV3PasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("snmp_v3_access"),
+			"Missing SNMPv3 password",
+			"Attribute \"snmp_v3_password\" or \"snmp_v3_password_wo\" must be specified when \"snmp_v3_access\" is specified",
+		)
+	}
+
 	if !cfg.Service.IsUnknown() && cfg.Service.ValueBool() {
 
 		if (cfg.SnmpV1V2cAccess.IsNull() && cfg.SnmpV3Access.IsNull()) || (cfg.SnmpV1V2cAccess.IsUnknown() && cfg.SnmpV3Access.IsUnknown()) {
@@ -27,12 +27,15 @@ import (
 
 	"github.com/bytedance/mockey"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // TestAccClusterSnmpResource - Tests the creation of a cluster SNMP resource.
 func TestAccClusterSnmpResource(t *testing.T) {
+	skipIfSimulated(t, "the SNMP settings are not simulated")
 	var clusterSNMPResourceName = "powerscale_cluster_snmp.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -52,6 +55,7 @@ func TestAccClusterSnmpResource(t *testing.T) {
 
 // TestAccClusterSnmpResource_Update - Tests the update of a cluster SNMP resource along with error mocking.
 func TestAccClusterSnmpResource_Update(t *testing.T) {
+	skipIfSimulated(t, "the SNMP settings are not simulated")
 	var clusterSNMPResourceName = "powerscale_cluster_snmp.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -136,8 +140,38 @@ func TestAccClusterSnmpResource_Update(t *testing.T) {
 	})
 }
 
+// TestAccClusterSnmpResourceWriteOnlyPassword - Tests the write-only SNMPv3 passwords of the cluster SNMP resource.
+func TestAccClusterSnmpResourceWriteOnlyPassword(t *testing.T) {
+	skipIfSimulated(t, "the SNMP settings are not simulated")
+	var clusterSNMPResourceName = "powerscale_cluster_snmp.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// write-only attributes are supported from Terraform 1.11
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + clusterSnmpResourceConfigMissingPassword,
+				ExpectError: regexp.MustCompile(`.*Missing SNMPv3 password*.`),
+			},
+			{
+				Config: ProviderConfig + clusterSnmpResourceConfigWriteOnlyPassword,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterSNMPResourceName, "snmp_v3_access", "true"),
+					resource.TestCheckResourceAttr(clusterSNMPResourceName, "snmp_v3_password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(clusterSNMPResourceName, "snmp_v3_password_wo"),
+					resource.TestCheckNoResourceAttr(clusterSNMPResourceName, "snmp_v3_priv_password_wo"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccClusterSnmpResource_Create - Tests the mock errors during the create operation of the cluster SNMP resource.
 func TestAccClusterSnmpResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the SNMP settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -175,6 +209,7 @@ func TestAccClusterSnmpResourceCreateMockErr(t *testing.T) {
 
 // TestAccClusterSnmpResource_Update - Tests the mock errors during the update operation of the cluster SNMP resource.
 func TestAccClusterSnmpResourceUpdateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the SNMP settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -216,6 +251,7 @@ func TestAccClusterSnmpResourceUpdateMockErr(t *testing.T) {
 
 // TestAccClusterSnmpResource_Import - Tests the mock errors during the import of the cluster SNMP resource.
 func TestAccClusterSnmpResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "the SNMP settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -243,6 +279,7 @@ func TestAccClusterSnmpResourceImportMockErr(t *testing.T) {
 
 // TestAccClusterSnmpResource_Import - Tests the import of the cluster SNMP resource.
 func TestAccClusterSnmpResource_Import(t *testing.T) {
+	skipIfSimulated(t, "the SNMP settings are not simulated")
 	var clusterSNMPResourceName = "powerscale_cluster_snmp.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -316,3 +353,19 @@ resource "powerscale_cluster_snmp" "test" {
 var clusterSnmpResourceEmptyConfig = `
 resource "powerscale_cluster_snmp" "test" {}
 `
+var clusterSnmpResourceConfigWriteOnlyPassword = `
+resource "powerscale_cluster_snmp" "test" {
+	enabled = true
+	snmp_v3_access = true
+	snmp_v3_password_wo = "snmp_v3_password"
+	snmp_v3_password_wo_version = 1
+	snmp_v3_priv_password_wo = "snmp_v3_priv_password"
+	snmp_v3_priv_password_wo_version = 1
+}
+`
+var clusterSnmpResourceConfigMissingPassword = `
+resource "powerscale_cluster_snmp" "test" {
+	enabled = true
+	snmp_v3_access = true
+}
+`
@@ -61,6 +61,7 @@ resource "powerscale_cluster_time" "test" {
 var dateValue, timeValue string
 
 func TestAccClusterTimeResource(t *testing.T) {
+	skipIfSimulated(t, "the cluster time is not simulated")
 
 	// Define the desired location (Asia/Kolkata)
 	loc, err := time.LoadLocation("Asia/Kolkata")
@@ -136,6 +137,7 @@ func TestAccClusterTimeResource(t *testing.T) {
 }
 
 func TestAccClusterTimeResourceMock(t *testing.T) {
+	skipIfSimulated(t, "the cluster time is not simulated")
 
 	// Define the desired location (Asia/Kolkata)
 	loc, err := time.LoadLocation("Asia/Kolkata")
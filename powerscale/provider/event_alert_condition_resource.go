@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &EventAlertConditionResource{}
+	_ resource.ResourceWithConfigure   = &EventAlertConditionResource{}
+	_ resource.ResourceWithImportState = &EventAlertConditionResource{}
+)
+
+// NewEventAlertConditionResource creates a new resource.
+func NewEventAlertConditionResource() resource.Resource {
+	return &EventAlertConditionResource{}
+}
+
+// EventAlertConditionResource defines the resource implementation.
+type EventAlertConditionResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *EventAlertConditionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_alert_condition"
+}
+
+// Schema describes the resource arguments.
+func (r *EventAlertConditionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the alert conditions of PowerScale Array, which send alerts through the event channels when the event groups of some categories and severities meet a condition. " +
+			"We can Create, Update and Delete the alert conditions using this resource. We can also import an existing alert condition by its name.",
+		Description: "This resource is used to manage the alert conditions of PowerScale Array, which send alerts through the event channels when the event groups of some categories and severities meet a condition. " +
+			"We can Create, Update and Delete the alert conditions using this resource. We can also import an existing alert condition by its name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the alert condition.",
+				MarkdownDescription: "ID of the alert condition.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the alert condition.",
+				MarkdownDescription: "Name of the alert condition.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"condition": schema.StringAttribute{
+				Description:         "Condition of the event groups raising an alert: NEW, NEW EVENTS, ONGOING, SEVERITY INCREASE, SEVERITY DECREASE or RESOLVED.",
+				MarkdownDescription: "Condition of the event groups raising an alert: `NEW`, `NEW EVENTS`, `ONGOING`, `SEVERITY INCREASE`, `SEVERITY DECREASE` or `RESOLVED`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(helper.EventAlertConditions...)},
+			},
+			"categories": schema.SetAttribute{
+				Description:         "IDs of the event group categories the condition applies to, such as 100000000 for the system disk events, or all.",
+				MarkdownDescription: "IDs of the event group categories the condition applies to, such as `100000000` for the system disk events, or `all`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"severities": schema.SetAttribute{
+				Description:         "Severities of the event groups the condition applies to: emergency, critical, warning or information.",
+				MarkdownDescription: "Severities of the event groups the condition applies to: `emergency`, `critical`, `warning` or `information`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.EventSeverities...))},
+			},
+			"channels": schema.SetAttribute{
+				Description:         "Names of the event channels the alerts are sent through.",
+				MarkdownDescription: "Names of the event channels the alerts are sent through.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"interval": schema.Int64Attribute{
+				Description:         "Seconds between the alerts of an ongoing event group, for the ONGOING condition.",
+				MarkdownDescription: "Seconds between the alerts of an ongoing event group, for the `ONGOING` condition.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"limit": schema.Int64Attribute{
+				Description:         "Maximum number of alerts sent for the new events of an event group, for the NEW EVENTS condition.",
+				MarkdownDescription: "Maximum number of alerts sent for the new events of an event group, for the `NEW EVENTS` condition.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *EventAlertConditionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *EventAlertConditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating alert condition")
+	var plan models.EventAlertConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conditionID, err := helper.CreateEventAlertCondition(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating alert condition", helper.GetErrorString(err, constants.CreateEventAlertConditionErrorMsg+"with error: "))
+		return
+	}
+	tflog.Debug(ctx, "alert condition created", map[string]interface{}{"conditionID": conditionID})
+
+	condition, err := helper.GetEventAlertCondition(ctx, r.client, conditionID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating alert condition", helper.GetErrorString(err, constants.ReadEventAlertConditionErrorMsg+"with error: "))
+		return
+	}
+	var state models.EventAlertConditionResourceModel
+	resp.Diagnostics.Append(helper.UpdateEventAlertConditionState(ctx, condition, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating alert condition")
+}
+
+// Read reads the resource state.
+func (r *EventAlertConditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading alert condition")
+	var state models.EventAlertConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conditionID := state.ID.ValueString()
+	condition, err := helper.GetEventAlertCondition(ctx, r.client, conditionID)
+	if err != nil {
+		if helper.IsPAPINotFound(err) {
+			tflog.Warn(ctx, "alert condition not found, removing it from the state", map[string]interface{}{"conditionID": conditionID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading alert condition", helper.GetErrorString(err, constants.ReadEventAlertConditionErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateEventAlertConditionState(ctx, condition, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading alert condition")
+}
+
+// Update updates the resource state.
+func (r *EventAlertConditionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating alert condition")
+	var plan, state models.EventAlertConditionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateEventAlertCondition(ctx, r.client, state.ID.ValueString(), plan); err != nil {
+		resp.Diagnostics.AddError("Error updating alert condition", helper.GetErrorString(err, constants.UpdateEventAlertConditionErrorMsg+"with error: "))
+		return
+	}
+
+	condition, err := helper.GetEventAlertCondition(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating alert condition", helper.GetErrorString(err, constants.ReadEventAlertConditionErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateEventAlertConditionState(ctx, condition, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating alert condition")
+}
+
+// Delete deletes the resource.
+func (r *EventAlertConditionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting alert condition")
+	var state models.EventAlertConditionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteEventAlertCondition(ctx, r.client, state.ID.ValueString()); err != nil && !helper.IsPAPINotFound(err) {
+		resp.Diagnostics.AddError("Error deleting alert condition", helper.GetErrorString(err, constants.DeleteEventAlertConditionErrorMsg+"with error: "))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting alert condition")
+}
+
+// ImportState imports an alert condition by its name.
+func (r *EventAlertConditionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	condition, err := helper.GetEventAlertCondition(ctx, r.client, parsedID.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing alert condition", helper.GetErrorString(err, constants.ReadEventAlertConditionErrorMsg+"with error: "))
+		return
+	}
+	var state models.EventAlertConditionResourceModel
+	resp.Diagnostics.Append(helper.UpdateEventAlertConditionState(ctx, condition, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEventAlertConditionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + EventAlertConditionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_event_alert_condition.test", "id", "tfacc_alert_condition"),
+					resource.TestCheckResourceAttr("powerscale_event_alert_condition.test", "condition", "NEW"),
+					resource.TestCheckResourceAttr("powerscale_event_alert_condition.test", "categories.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerscale_event_alert_condition.test", "severities.*", "critical"),
+					resource.TestCheckTypeSetElemAttr("powerscale_event_alert_condition.test", "channels.*", "tfacc_alert_channel"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_event_alert_condition.test",
+				ImportState:       true,
+				ImportStateId:     "tfacc_alert_condition",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + EventAlertConditionResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_event_alert_condition.test", "condition", "ONGOING"),
+					resource.TestCheckResourceAttr("powerscale_event_alert_condition.test", "interval", "3600"),
+					resource.TestCheckResourceAttr("powerscale_event_alert_condition.test", "severities.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventAlertConditionResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + EventAlertConditionResourceInvalidSeverityConfig,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateEventAlertCondition).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + EventAlertConditionResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetEventAlertCondition).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + EventAlertConditionResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + EventAlertConditionResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateEventAlertCondition).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + EventAlertConditionResourceUpdateConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + EventAlertConditionResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var EventAlertConditionChannelConfig = `
+resource "powerscale_event_channel" "test" {
+	name      = "tfacc_alert_channel"
+	type      = "smtp"
+	address   = ["admin@example.com"]
+	smtp_host = "smtp.example.com"
+}
+`
+
+var EventAlertConditionResourceConfig = EventAlertConditionChannelConfig + `
+resource "powerscale_event_alert_condition" "test" {
+	name       = "tfacc_alert_condition"
+	condition  = "NEW"
+	categories = ["all"]
+	severities = ["emergency", "critical"]
+	channels   = [powerscale_event_channel.test.name]
+}
+`
+
+var EventAlertConditionResourceUpdateConfig = EventAlertConditionChannelConfig + `
+resource "powerscale_event_alert_condition" "test" {
+	name       = "tfacc_alert_condition"
+	condition  = "ONGOING"
+	categories = ["all"]
+	severities = ["emergency", "critical", "warning"]
+	channels   = [powerscale_event_channel.test.name]
+	interval   = 3600
+}
+`
+
+var EventAlertConditionResourceInvalidSeverityConfig = `
+resource "powerscale_event_alert_condition" "test" {
+	name       = "tfacc_alert_condition"
+	condition  = "NEW"
+	severities = ["fatal"]
+}
+`
@@ -0,0 +1,403 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &EventChannelResource{}
+	_ resource.ResourceWithConfigure   = &EventChannelResource{}
+	_ resource.ResourceWithImportState = &EventChannelResource{}
+)
+
+// NewEventChannelResource creates a new resource.
+func NewEventChannelResource() resource.Resource {
+	return &EventChannelResource{}
+}
+
+// EventChannelResource defines the resource implementation.
+type EventChannelResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *EventChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_channel"
+}
+
+// Schema describes the resource arguments.
+func (r *EventChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the event channels of PowerScale Array, through which the alerts raised by the alert conditions are sent, e.g. by email, SNMP traps or SupportAssist. " +
+			"We can Create, Update and Delete the event channels using this resource. We can also import an existing event channel by its ID or name.",
+		Description: "This resource is used to manage the event channels of PowerScale Array, through which the alerts raised by the alert conditions are sent, e.g. by email, SNMP traps or SupportAssist. " +
+			"We can Create, Update and Delete the event channels using this resource. We can also import an existing event channel by its ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the event channel.",
+				MarkdownDescription: "ID of the event channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the event channel, referred to by the channels of the alert conditions.",
+				MarkdownDescription: "Name of the event channel, referred to by the `channels` of the alert conditions.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Type of the event channel: smtp, snmp, connectemc, supportassist or heartbeat.",
+				MarkdownDescription: "Type of the event channel: `smtp`, `snmp`, `connectemc`, `supportassist` or `heartbeat`.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(helper.EventChannelTypes...)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:         "Whether the alerts are sent through the event channel.",
+				MarkdownDescription: "Whether the alerts are sent through the event channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"allowed_nodes": schema.SetAttribute{
+				Description:         "Logical node numbers of the nodes allowed to send the alerts through the event channel. All the nodes are allowed when empty.",
+				MarkdownDescription: "Logical node numbers of the nodes allowed to send the alerts through the event channel. All the nodes are allowed when empty.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Validators:          []validator.Set{setvalidator.ValueInt64sAre(int64validator.AtLeast(1))},
+			},
+			"excluded_nodes": schema.SetAttribute{
+				Description:         "Logical node numbers of the nodes excluded from sending the alerts through the event channel.",
+				MarkdownDescription: "Logical node numbers of the nodes excluded from sending the alerts through the event channel.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Validators:          []validator.Set{setvalidator.ValueInt64sAre(int64validator.AtLeast(1))},
+			},
+			"address": schema.SetAttribute{
+				Description:         "Email addresses of the recipients of the alerts, for a smtp channel.",
+				MarkdownDescription: "Email addresses of the recipients of the alerts, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"send_as": schema.StringAttribute{
+				Description:         "Email address of the sender of the alerts, for a smtp channel.",
+				MarkdownDescription: "Email address of the sender of the alerts, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"subject": schema.StringAttribute{
+				Description:         "Subject of the alert emails, for a smtp channel.",
+				MarkdownDescription: "Subject of the alert emails, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smtp_host": schema.StringAttribute{
+				Description:         "SMTP server relaying the alert emails, for a smtp channel.",
+				MarkdownDescription: "SMTP server relaying the alert emails, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smtp_port": schema.Int64Attribute{
+				Description:         "Port of the SMTP server, for a smtp channel.",
+				MarkdownDescription: "Port of the SMTP server, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.Between(1, 65535)},
+			},
+			"smtp_use_auth": schema.BoolAttribute{
+				Description:         "Whether the cluster authenticates to the SMTP server with smtp_username and smtp_password, for a smtp channel.",
+				MarkdownDescription: "Whether the cluster authenticates to the SMTP server with `smtp_username` and `smtp_password`, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smtp_username": schema.StringAttribute{
+				Description:         "Username authenticating to the SMTP server, for a smtp channel.",
+				MarkdownDescription: "Username authenticating to the SMTP server, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"smtp_password": schema.StringAttribute{
+				Description: "Password authenticating to the SMTP server, for a smtp channel. The password is not returned by PowerScale, so that its changes on the cluster are not detected." +
+					" Conflicts with smtp_password_wo.",
+				MarkdownDescription: "Password authenticating to the SMTP server, for a `smtp` channel. The password is not returned by PowerScale, so that its changes on the cluster are not detected." +
+					" Conflicts with `smtp_password_wo`.",
+				Optional:   true,
+				Sensitive:  true,
+				Validators: []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("smtp_password_wo"))},
+			},
+			"smtp_password_wo": schema.StringAttribute{
+				Description: "Password authenticating to the SMTP server, for a smtp channel, without storing it in the Terraform state. Requires Terraform 1.11 or later." +
+					" The password is sent on creation and whenever smtp_password_wo_version changes.",
+				MarkdownDescription: "Password authenticating to the SMTP server, for a `smtp` channel, without storing it in the Terraform state. Requires Terraform 1.11 or later." +
+					" The password is sent on creation and whenever `smtp_password_wo_version` changes.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"smtp_password_wo_version": schema.Int64Attribute{
+				Description:         "The version of smtp_password_wo. Change it to send smtp_password_wo to the cluster again.",
+				MarkdownDescription: "The version of `smtp_password_wo`. Change it to send `smtp_password_wo` to the cluster again.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("smtp_password_wo"))},
+			},
+			"smtp_security": schema.StringAttribute{
+				Description:         "Security of the connection to the SMTP server, for a smtp channel: none or starttls.",
+				MarkdownDescription: "Security of the connection to the SMTP server, for a `smtp` channel: `none` or `starttls`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("none", "starttls")},
+			},
+			"batch": schema.StringAttribute{
+				Description:         "How the alerts are batched in the emails, for a smtp channel: NONE, ALL, CATEGORY or SEVERITY.",
+				MarkdownDescription: "How the alerts are batched in the emails, for a `smtp` channel: `NONE`, `ALL`, `CATEGORY` or `SEVERITY`.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("NONE", "ALL", "CATEGORY", "SEVERITY")},
+			},
+			"batch_period": schema.Int64Attribute{
+				Description:         "Seconds the alerts are batched for before they are sent, for a smtp channel.",
+				MarkdownDescription: "Seconds the alerts are batched for before they are sent, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			},
+			"custom_template": schema.StringAttribute{
+				Description:         "Path of a custom template laying out the alert emails, for a smtp channel.",
+				MarkdownDescription: "Path of a custom template laying out the alert emails, for a `smtp` channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"host": schema.StringAttribute{
+				Description:         "Host receiving the SNMP traps, for a snmp channel.",
+				MarkdownDescription: "Host receiving the SNMP traps, for a `snmp` channel.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"community": schema.StringAttribute{
+				Description:         "SNMP community of the traps, for a snmp channel.",
+				MarkdownDescription: "SNMP community of the traps, for a `snmp` channel.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"system": schema.BoolAttribute{
+				Description:         "Whether the event channel is a system channel.",
+				MarkdownDescription: "Whether the event channel is a system channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *EventChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *EventChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating event channel")
+	var plan models.EventChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, nil, "smtp_password_wo", "smtp_password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the write-only password is sent in place of the password, but never stored in the state
+	params := plan
+	if !password.IsNull() {
+		params.SMTPPassword = password
+	}
+	channelID, err := helper.CreateEventChannel(ctx, r.client, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating event channel", helper.GetErrorString(err, constants.CreateEventChannelErrorMsg+"with error: "))
+		return
+	}
+	tflog.Debug(ctx, "event channel created", map[string]interface{}{"channelID": channelID})
+
+	channel, err := helper.GetEventChannel(ctx, r.client, channelID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating event channel", helper.GetErrorString(err, constants.ReadEventChannelErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateEventChannelState(ctx, channel, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with creating event channel")
+}
+
+// Read reads the resource state.
+func (r *EventChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading event channel")
+	var state models.EventChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channelID := state.ID.ValueString()
+	channel, err := helper.GetEventChannel(ctx, r.client, channelID)
+	if err != nil {
+		if helper.IsPAPINotFound(err) {
+			tflog.Warn(ctx, "event channel not found, removing it from the state", map[string]interface{}{"channelID": channelID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading event channel", helper.GetErrorString(err, constants.ReadEventChannelErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateEventChannelState(ctx, channel, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading event channel")
+}
+
+// Update updates the resource state.
+func (r *EventChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating event channel")
+	var plan, state models.EventChannelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	password, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, &req.State, "smtp_password_wo", "smtp_password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// the write-only password is sent in place of the password, but never stored in the state
+	params := plan
+	if !password.IsNull() {
+		params.SMTPPassword = password
+	}
+	if err := helper.UpdateEventChannel(ctx, r.client, state.ID.ValueString(), params); err != nil {
+		resp.Diagnostics.AddError("Error updating event channel", helper.GetErrorString(err, constants.UpdateEventChannelErrorMsg+"with error: "))
+		return
+	}
+
+	channel, err := helper.GetEventChannel(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating event channel", helper.GetErrorString(err, constants.ReadEventChannelErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(helper.UpdateEventChannelState(ctx, channel, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with updating event channel")
+}
+
+// Delete deletes the resource.
+func (r *EventChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting event channel")
+	var state models.EventChannelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteEventChannel(ctx, r.client, state.ID.ValueString()); err != nil && !helper.IsPAPINotFound(err) {
+		resp.Diagnostics.AddError("Error deleting event channel", helper.GetErrorString(err, constants.DeleteEventChannelErrorMsg+"with error: "))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting event channel")
+}
+
+// ImportState imports an event channel by its ID or name.
+func (r *EventChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := helper.GetEventChannel(ctx, r.client, parsedID.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing event channel", helper.GetErrorString(err, constants.ReadEventChannelErrorMsg+"with error: "))
+		return
+	}
+	state := models.EventChannelResourceModel{SMTPPassword: types.StringNull()}
+	resp.Diagnostics.Append(helper.UpdateEventChannelState(ctx, channel, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
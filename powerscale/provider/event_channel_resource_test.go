@@ -0,0 +1,197 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEventChannelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + EventChannelResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_event_channel.test", "id"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "name", "tfacc_event_channel"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "type", "smtp"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "address.#", "2"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "smtp_host", "smtp.example.com"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "smtp_port", "25"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "excluded_nodes.#", "1"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "system", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_event_channel.test",
+				ImportState:       true,
+				ImportStateId:     "tfacc_event_channel",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + EventChannelResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "address.#", "1"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "subject", "tfacc alert"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "batch", "SEVERITY"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "excluded_nodes.#", "0"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "smtp_password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr("powerscale_event_channel.test", "smtp_password_wo"),
+				),
+			},
+			// Replace testing, changing the type
+			{
+				Config: ProviderConfig + EventChannelResourceSNMPConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "type", "snmp"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "host", "snmp.example.com"),
+					resource.TestCheckResourceAttr("powerscale_event_channel.test", "community", "public"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventChannelResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + EventChannelResourceInvalidTypeConfig,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				Config:      ProviderConfig + EventChannelResourcePasswordConflictConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateEventChannel).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + EventChannelResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetEventChannel).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + EventChannelResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + EventChannelResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateEventChannel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + EventChannelResourceUpdateConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.DeleteEventChannel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig,
+			},
+		},
+	})
+}
+
+var EventChannelResourceConfig = `
+resource "powerscale_event_channel" "test" {
+	name           = "tfacc_event_channel"
+	type           = "smtp"
+	address        = ["admin@example.com", "storage@example.com"]
+	send_as        = "cluster@example.com"
+	smtp_host      = "smtp.example.com"
+	smtp_port      = 25
+	excluded_nodes = [2]
+}
+`
+
+var EventChannelResourceUpdateConfig = `
+resource "powerscale_event_channel" "test" {
+	name                     = "tfacc_event_channel"
+	type                     = "smtp"
+	enabled                  = false
+	address                  = ["storage@example.com"]
+	send_as                  = "cluster@example.com"
+	subject                  = "tfacc alert"
+	smtp_host                = "smtp.example.com"
+	smtp_port                = 25
+	batch                    = "SEVERITY"
+	excluded_nodes           = []
+	smtp_use_auth            = true
+	smtp_username            = "tfacc_alerts"
+	smtp_password_wo         = "tfacc_password"
+	smtp_password_wo_version = 1
+}
+`
+
+var EventChannelResourceSNMPConfig = `
+resource "powerscale_event_channel" "test" {
+	name      = "tfacc_event_channel"
+	type      = "snmp"
+	host      = "snmp.example.com"
+	community = "public"
+}
+`
+
+var EventChannelResourceInvalidTypeConfig = `
+resource "powerscale_event_channel" "test" {
+	name = "tfacc_event_channel"
+	type = "pager"
+}
+`
+
+var EventChannelResourcePasswordConflictConfig = `
+resource "powerscale_event_channel" "test" {
+	name             = "tfacc_event_channel"
+	type             = "smtp"
+	smtp_password    = "tfacc_password"
+	smtp_password_wo = "tfacc_password"
+}
+`
@@ -0,0 +1,224 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &EventGroupDataSource{}
+	_ datasource.DataSourceWithConfigure = &EventGroupDataSource{}
+)
+
+// NewEventGroupDataSource returns the EventGroup data source object.
+func NewEventGroupDataSource() datasource.DataSource {
+	return &EventGroupDataSource{}
+}
+
+// EventGroupDataSource defines the data source implementation.
+type EventGroupDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *EventGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_group"
+}
+
+// Schema describes the data source arguments.
+func (d *EventGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the event group occurrences of PowerScale Array, i.e. the related events raised by the cluster, and the alerts they raised. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description: "This datasource is used to query the event group occurrences of PowerScale Array, i.e. the related events raised by the cluster, and the alerts they raised. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"event_groups": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of event group occurrences",
+				MarkdownDescription: "List of event group occurrences",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "ID of the event group occurrence.",
+							MarkdownDescription: "ID of the event group occurrence.",
+							Computed:            true,
+						},
+						"causes": schema.ListAttribute{
+							Description:         "Messages of the causes of the event group.",
+							MarkdownDescription: "Messages of the causes of the event group.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"severity": schema.StringAttribute{
+							Description:         "Severity of the event group: emergency, critical, warning or information.",
+							MarkdownDescription: "Severity of the event group: `emergency`, `critical`, `warning` or `information`.",
+							Computed:            true,
+						},
+						"resolved": schema.BoolAttribute{
+							Description:         "Whether the event group is resolved.",
+							MarkdownDescription: "Whether the event group is resolved.",
+							Computed:            true,
+						},
+						"resolve_time": schema.Int64Attribute{
+							Description:         "Time the event group was resolved, in seconds since the epoch.",
+							MarkdownDescription: "Time the event group was resolved, in seconds since the epoch.",
+							Computed:            true,
+						},
+						"time_noticed": schema.Int64Attribute{
+							Description:         "Time the event group was first noticed, in seconds since the epoch.",
+							MarkdownDescription: "Time the event group was first noticed, in seconds since the epoch.",
+							Computed:            true,
+						},
+						"last_event": schema.Int64Attribute{
+							Description:         "Time of the last event of the event group, in seconds since the epoch.",
+							MarkdownDescription: "Time of the last event of the event group, in seconds since the epoch.",
+							Computed:            true,
+						},
+						"events": schema.Int64Attribute{
+							Description:         "Number of events of the event group.",
+							MarkdownDescription: "Number of events of the event group.",
+							Computed:            true,
+						},
+						"ignore": schema.BoolAttribute{
+							Description:         "Whether the event group is ignored, so that it raises no alert.",
+							MarkdownDescription: "Whether the event group is ignored, so that it raises no alert.",
+							Computed:            true,
+						},
+						"lnn": schema.Int64Attribute{
+							Description:         "Logical node number of the node the event group occurred on, null for the cluster wide event groups.",
+							MarkdownDescription: "Logical node number of the node the event group occurred on, null for the cluster wide event groups.",
+							Computed:            true,
+						},
+						"channels": schema.ListAttribute{
+							Description:         "Names of the event channels the alerts of the event group were sent through.",
+							MarkdownDescription: "Names of the event channels the alerts of the event group were sent through.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"severities": schema.SetAttribute{
+						Description:         "Only list the event groups of these severities: emergency, critical, warning or information.",
+						MarkdownDescription: "Only list the event groups of these severities: `emergency`, `critical`, `warning` or `information`.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators:          []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(helper.EventSeverities...))},
+					},
+					"resolved": schema.BoolAttribute{
+						Description:         "Only list the resolved event groups when true, or the unresolved ones when false.",
+						MarkdownDescription: "Only list the resolved event groups when true, or the unresolved ones when false.",
+						Optional:            true,
+					},
+					"begin": schema.Int64Attribute{
+						Description:         "Only list the event groups occurring after this time, in seconds since the epoch.",
+						MarkdownDescription: "Only list the event groups occurring after this time, in seconds since the epoch.",
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.Between(0, math.MaxInt32)},
+					},
+					"end": schema.Int64Attribute{
+						Description:         "Only list the event groups occurring before this time, in seconds since the epoch.",
+						MarkdownDescription: "Only list the event groups occurring before this time, in seconds since the epoch.",
+						Optional:            true,
+						Validators:          []validator.Int64{int64validator.Between(0, math.MaxInt32)},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *EventGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *EventGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading event group data source")
+	var config models.EventGroupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := helper.ListEventGroups(ctx, d.client, config.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading event groups", helper.GetErrorString(err, constants.ReadEventGroupErrorMsg+"with error: "))
+		return
+	}
+
+	state := models.EventGroupDataSourceModel{
+		ID:          types.StringValue("event_group_datasource"),
+		EventGroups: []models.EventGroupDataSourceEntity{},
+		Filter:      config.Filter,
+	}
+	for _, group := range groups {
+		entity, diags := helper.NewEventGroupDataSourceEntity(ctx, group)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.EventGroups = append(state.EventGroups, entity)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading event group data source")
+}
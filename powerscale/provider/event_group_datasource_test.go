@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEventGroupDatasource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + EventGroupDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_event_group.all", "event_groups.#"),
+					resource.TestCheckResourceAttrSet("data.powerscale_event_group.filtered", "event_groups.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEventGroupDatasourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + EventGroupDatasourceInvalidSeverityConfig,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				Config:      ProviderConfig + EventGroupDatasourceInvalidBeginConfig,
+				ExpectError: regexp.MustCompile("value must be between"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListEventGroups).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + EventGroupDatasourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + EventGroupDatasourceConfig,
+			},
+		},
+	})
+}
+
+var EventGroupDatasourceConfig = `
+data "powerscale_event_group" "all" {
+}
+
+data "powerscale_event_group" "filtered" {
+	filter {
+		severities = ["emergency", "critical"]
+		resolved   = false
+		begin      = 1704067200
+	}
+}
+`
+
+var EventGroupDatasourceInvalidSeverityConfig = `
+data "powerscale_event_group" "invalid" {
+	filter {
+		severities = ["fatal"]
+	}
+}
+`
+
+var EventGroupDatasourceInvalidBeginConfig = `
+data "powerscale_event_group" "invalid" {
+	filter {
+		begin = 4294967296
+	}
+}
+`
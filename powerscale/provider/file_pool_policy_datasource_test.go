@@ -31,6 +31,7 @@ import (
 var filePoolPolicyDsMocker *mockey.Mocker
 
 func TestAccFilePoolPolicyDataSource(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	var policyTerraformName = "data.powerscale_filepool_policy.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -66,6 +67,7 @@ func TestAccFilePoolPolicyDataSource(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyDataSourceInvalidNames(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -80,6 +82,7 @@ func TestAccFilePoolPolicyDataSourceInvalidNames(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyDatasourceErrorGetAll(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -99,6 +102,7 @@ func TestAccFilePoolPolicyDatasourceErrorGetAll(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyDatasourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -118,6 +122,7 @@ func TestAccFilePoolPolicyDatasourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyDatasourceReleaseMock(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -0,0 +1,135 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &FilePoolPolicyListResource{}
+	_ list.ListResourceWithConfigure = &FilePoolPolicyListResource{}
+)
+
+// NewFilePoolPolicyListResource returns the file pool policy list resource object.
+func NewFilePoolPolicyListResource() list.ListResource {
+	return &FilePoolPolicyListResource{}
+}
+
+// FilePoolPolicyListResource defines the list resource implementation.
+type FilePoolPolicyListResource struct {
+	client *client.Client
+}
+
+// Configure configures the list resource.
+func (r *FilePoolPolicyListResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the list resource arguments.
+func (r *FilePoolPolicyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_filepool_policy"
+}
+
+// ListResourceConfigSchema describes the list resource arguments.
+func (r *FilePoolPolicyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource is used to discover the file pool policies of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Description:         "This list resource is used to discover the file pool policies of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the file pool policies to list. All the file pool policies except the default policy are listed by default.",
+				Description:         "Names of the file pool policies to list. All the file pool policies except the default policy are listed by default.",
+			},
+		},
+	}
+}
+
+// List lists the file pool policies.
+func (r *FilePoolPolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.FilePoolPolicyListModel
+	var diags diag.Diagnostics
+	// the config block is optional
+	if !req.Config.Raw.IsNull() {
+		diags = req.Config.Get(ctx, &config)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	policies, err := helper.GetAllFilePoolPolicies(ctx, r.client)
+	if err != nil {
+		diags.AddError("Error getting the list of File Pool Policies", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	names := make(map[string]bool)
+	for _, name := range config.Names {
+		names[name.ValueString()] = true
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, policy := range policies {
+			if len(names) > 0 && !names[policy.GetName()] {
+				continue
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = policy.GetName()
+
+			var model models.FilePoolPolicyModel
+			if err := helper.UpdateFilePoolPolicyImportState(ctx, &model, &policy); err != nil {
+				result.Diagnostics.AddError("Error reading File Pool Policy Resource",
+					fmt.Sprintf("Error parsing File Pool Policy resource state: %s", err.Error()))
+				push(result)
+				return
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.FilePoolPolicyIdentityModel{Name: model.Name})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
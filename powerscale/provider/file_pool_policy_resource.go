@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -37,6 +38,7 @@ var (
 	_ resource.Resource                = &FilePoolPolicyResource{}
 	_ resource.ResourceWithConfigure   = &FilePoolPolicyResource{}
 	_ resource.ResourceWithImportState = &FilePoolPolicyResource{}
+	_ resource.ResourceWithIdentity    = &FilePoolPolicyResource{}
 )
 
 // NewFilePoolPolicyResource creates a new resource.
@@ -52,6 +54,20 @@ type FilePoolPolicyResource struct {
 // Metadata describes the resource arguments.
 func (r *FilePoolPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_filepool_policy"
+	// the identity changes when the file pool policy is renamed
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema describes the attributes identifying the file pool policy.
+func (r *FilePoolPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the file pool policy. The default policy is identified by the name \"Default policy\".",
+			},
+		},
+	}
 }
 
 // Schema describes the resource arguments.
@@ -416,6 +432,7 @@ func (r *FilePoolPolicyResource) Create(ctx context.Context, req resource.Create
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.FilePoolPolicyIdentityModel{Name: plan.Name})...)
 	tflog.Info(ctx, "Done with Create File Pool Policy resource")
 }
 
@@ -451,6 +468,7 @@ func (r *FilePoolPolicyResource) Read(ctx context.Context, req resource.ReadRequ
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.FilePoolPolicyIdentityModel{Name: state.Name})...)
 	tflog.Info(ctx, "Done with Read File Pool Policy resource")
 }
 
@@ -515,6 +533,7 @@ func (r *FilePoolPolicyResource) Update(ctx context.Context, req resource.Update
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.FilePoolPolicyIdentityModel{Name: plan.Name})...)
 	tflog.Info(ctx, "Done with Update File Pool Policy resource")
 }
 
@@ -544,8 +563,23 @@ func (r *FilePoolPolicyResource) ImportState(ctx context.Context, req resource.I
 	tflog.Info(ctx, "Importing File Pool Policy resource")
 	var state models.FilePoolPolicyModel
 
-	policyName := req.ID
-	if policyName == "is_default_policy=true" {
+	var policyName string
+	if req.ID == "" && req.Identity != nil {
+		var identity models.FilePoolPolicyIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		policyName = identity.Name.ValueString()
+	} else {
+		parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		policyName = parsedID.ID
+	}
+	if policyName == "is_default_policy=true" || policyName == helper.FilePoolDefaultPolicyName {
 		policyResponse, err := helper.GetFilePoolDefaultPolicy(ctx, r.client)
 		if err != nil {
 			resp.Diagnostics.AddError("Error getting Default File Pool Policy", err.Error())
@@ -570,5 +604,6 @@ func (r *FilePoolPolicyResource) ImportState(ctx context.Context, req resource.I
 	}
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.FilePoolPolicyIdentityModel{Name: state.Name})...)
 	tflog.Info(ctx, "Done with Import File Pool Policy resource")
 }
@@ -31,6 +31,7 @@ import (
 var filePoolPolicyMocker *Mocker
 
 func TestAccFilePoolPolicyResource(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	var policyResourceName = "powerscale_filepool_policy.policy_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -75,6 +76,7 @@ func TestAccFilePoolPolicyResource(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyResourceErr(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -116,6 +118,7 @@ func TestAccFilePoolPolicyResourceErr(t *testing.T) {
 }
 
 func TestAccFilePoolDefaultPolicyResource(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	var policyResourceName = "powerscale_filepool_policy.policy_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -164,6 +167,7 @@ func TestAccFilePoolDefaultPolicyResource(t *testing.T) {
 }
 
 func TestAccFilePoolDefaultPolicyResourceErr(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -205,6 +209,7 @@ func TestAccFilePoolDefaultPolicyResourceErr(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyResourceMockErr(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -287,6 +292,7 @@ func TestAccFilePoolPolicyResourceMockErr(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyResourceDeleteMockErr(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -317,6 +323,7 @@ func TestAccFilePoolPolicyResourceDeleteMockErr(t *testing.T) {
 }
 
 func TestAccFilePoolPolicyResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	var policyResourceName = "powerscale_filepool_policy.policy_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -363,6 +370,7 @@ func TestAccFilePoolPolicyResourceImportMockErr(t *testing.T) {
 }
 
 func TestAccFilePoolDefaultPolicyResourceMockErr(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -467,6 +475,7 @@ func TestAccFilePoolDefaultPolicyResourceMockErr(t *testing.T) {
 }
 
 func TestAccFilePoolDefaultPolicyResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	var policyResourceName = "powerscale_filepool_policy.policy_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -514,6 +523,7 @@ func TestAccFilePoolDefaultPolicyResourceImportMockErr(t *testing.T) {
 	})
 }
 func TestAccFilePoolPolicyReleaseMockResource(t *testing.T) {
+	skipIfSimulated(t, "file pool policies are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
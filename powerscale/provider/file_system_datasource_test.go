@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccFileSystemDataSource(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	var fsTerraform = "data.powerscale_filesystem.system"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -65,6 +66,7 @@ func TestAccFileSystemDataSource(t *testing.T) {
 }
 
 func TestAccFileSystemDataSourceFilterDefault(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	var fsTerraform = "data.powerscale_filesystem.system"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -81,6 +83,7 @@ func TestAccFileSystemDataSourceFilterDefault(t *testing.T) {
 }
 
 func TestAccFileSystemDataSourceGetAclErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -97,6 +100,7 @@ func TestAccFileSystemDataSourceGetAclErr(t *testing.T) {
 }
 
 func TestAccFileSystemDataSourceGetQuotaErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -113,6 +117,7 @@ func TestAccFileSystemDataSourceGetQuotaErr(t *testing.T) {
 }
 
 func TestAccFileSystemDataSourceGetSnapErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -129,6 +134,7 @@ func TestAccFileSystemDataSourceGetSnapErr(t *testing.T) {
 }
 
 func TestAccFileSystemDataSourceGetMetaErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -145,6 +151,7 @@ func TestAccFileSystemDataSourceGetMetaErr(t *testing.T) {
 }
 
 func TestAccFileSystemDataSourceReleaseMock(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -19,6 +19,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"terraform-provider-powerscale/client"
@@ -27,13 +28,17 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -41,6 +46,9 @@ import (
 var _ resource.Resource = &FileSystemResource{}
 var _ resource.ResourceWithImportState = &FileSystemResource{}
 
+// fileSystemTimeouts bounds the deletion, which removes the whole directory tree.
+var fileSystemTimeouts = timeouts.Opts{Delete: true}
+
 // NewFileSystemResource creates a new data source.
 func NewFileSystemResource() resource.Resource {
 	return &FileSystemResource{}
@@ -208,6 +216,23 @@ func (r *FileSystemResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Acl mode",
 				Computed:            true,
 			},
+			"worm_domain": schema.SingleNestedAttribute{
+				Description: "SmartLock (WORM) domain created on the directory, which must be empty. Adding the domain creates it in place, removing it replaces the FileSystem, " +
+					"and the deletion is refused when the domain contains committed files.(Update Supported for the retention settings)",
+				MarkdownDescription: "SmartLock (WORM) domain created on the directory, which must be empty. Adding the domain creates it in place, removing it replaces the FileSystem, " +
+					"and the deletion is refused when the domain contains committed files.(Update Supported for the retention settings)",
+				Optional:   true,
+				Attributes: wormDomainSettingsAttributes(),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+						// the SmartLock domains cannot be deleted, only with their directory
+						resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+					}, "Removing the SmartLock domain replaces the FileSystem.", "Removing the SmartLock domain replaces the FileSystem."),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, fileSystemTimeouts, fileSystemDeleteTimeout),
 		},
 	}
 }
@@ -267,6 +292,21 @@ func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 		resp.Diagnostics.AddWarning(fmt.Sprintf("Error setting the File system Resource - %s", dirPath), err.Error())
 	}
 
+	if plan.WormDomain != nil {
+		domainID, err := helper.CreateWormDomain(ctx, r.client, "/"+dirPath, *plan.WormDomain)
+		if err != nil {
+			errStr := constants.CreateWormDomainErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error creating the SmartLock domain of the filesystem", message)
+			// if err, revert create
+			if err = helper.DeleteFileSystem(ctx, r.client, dirPath); err != nil {
+				tflog.Error(ctx, fmt.Sprintf("Error deleting filesystem when reverting creation - %s", err.Error()))
+			}
+			return
+		}
+		plan.WormDomain.ID = types.StringValue(domainID)
+	}
+
 	// Get File system metadata
 	meta, err := helper.GetDirectoryMetadata(ctx, r.client, dirPath)
 	if err != nil {
@@ -297,6 +337,12 @@ func (r *FileSystemResource) Create(ctx context.Context, req resource.CreateRequ
 	if diags := helper.UpdateFileSystemResourceState(ctx, &plan, acl, meta); diags.WarningsCount() > 0 {
 		resp.Diagnostics.Append(diags...)
 	}
+	if err := helper.UpdateFileSystemWormDomainState(ctx, r.client, &plan); err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error getting the SmartLock domain of the filesystem", message)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	tflog.Info(ctx, "Done with Create File System resource")
 }
@@ -336,6 +382,12 @@ func (r *FileSystemResource) Read(ctx context.Context, req resource.ReadRequest,
 	if diags := helper.UpdateFileSystemResourceState(ctx, &plan, acl, meta); diags.WarningsCount() > 0 {
 		resp.Diagnostics.Append(diags...)
 	}
+	if err := helper.UpdateFileSystemWormDomainState(ctx, r.client, &plan); err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error getting the SmartLock domain of the filesystem", message)
+		return
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 	tflog.Info(ctx, "Read File System Resource Complete.")
 }
@@ -351,8 +403,29 @@ func (r *FileSystemResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := plan.Timeouts.Delete(ctx, fileSystemDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	dirPath := helper.GetDirectoryPath(plan.DirectoryPath.ValueString(), plan.Name.ValueString())
+	if plan.WormDomain != nil {
+		if err := helper.CheckWormDomainDeletable(ctx, r.client, "/"+dirPath); err != nil {
+			errStr := constants.DeleteWormDomainErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError("Error Deleting filesystem", message)
+			return
+		}
+	}
 	if err := helper.DeleteFileSystem(ctx, r.client, dirPath); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			resp.Diagnostics.AddError("Error Deleting filesystem",
+				fmt.Sprintf("Timed out after %s deleting filesystem %s, increase the delete timeout for large directory trees", deleteTimeout, dirPath))
+			return
+		}
 		resp.Diagnostics.AddError("Error Deleting filesystem", err.Error())
 		return
 	}
@@ -392,6 +465,25 @@ func (r *FileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if plan.WormDomain != nil && state.WormDomain != nil {
+		if err := helper.UpdateWormDomain(ctx, r.client, state.WormDomain.ID.ValueString(), *plan.WormDomain); err != nil {
+			errStr := constants.UpdateWormDomainErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating the File system Resource - %s", planDirName), message)
+			return
+		}
+		plan.WormDomain.ID = state.WormDomain.ID
+	} else if plan.WormDomain != nil {
+		domainID, err := helper.CreateWormDomain(ctx, r.client, "/"+planDirName, *plan.WormDomain)
+		if err != nil {
+			errStr := constants.CreateWormDomainErrorMsg + "with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(fmt.Sprintf("Error updating the File system Resource - %s", planDirName), message)
+			return
+		}
+		plan.WormDomain.ID = types.StringValue(domainID)
+	}
+
 	// Get metadata
 	meta, err := helper.GetDirectoryMetadata(ctx, r.client, planDirName)
 	if err != nil {
@@ -414,6 +506,12 @@ func (r *FileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 	if diags := helper.UpdateFileSystemResourceState(ctx, &plan, acl, meta); diags.WarningsCount() > 0 {
 		resp.Diagnostics.Append(diags...)
 	}
+	if err := helper.UpdateFileSystemWormDomainState(ctx, r.client, &plan); err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error getting the SmartLock domain of the filesystem", message)
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -424,7 +522,12 @@ func (r *FileSystemResource) Update(ctx context.Context, req resource.UpdateRequ
 func (r *FileSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing File System resource")
 	var state models.FileSystemResource
-	var id = req.ID
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "path"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var id = parsedID.ID
 	// Get metadata
 	meta, err := helper.GetDirectoryMetadata(ctx, r.client, id)
 	if err != nil {
@@ -446,6 +549,20 @@ func (r *FileSystemResource) ImportState(ctx context.Context, req resource.Impor
 	// copy to model
 	helper.UpdateFileSystemResourceImportState(ctx, id, &state, acl, meta)
 
+	// Get the SmartLock domain rooted at the directory, if any
+	domain, err := helper.FindWormDomainByPath(ctx, r.client, id)
+	if err != nil {
+		errStr := constants.ReadWormDomainErrorMsg + "with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError("Error getting the SmartLock domain of the filesystem", message)
+		return
+	}
+	if domain != nil {
+		state.WormDomain = &models.WormDomainSettingsModel{}
+		helper.UpdateWormDomainSettingsState(state.WormDomain, domain)
+	}
+	state.Timeouts = nullTimeouts(fileSystemTimeouts)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	tflog.Info(ctx, "Done with Import File System resource")
@@ -395,6 +395,7 @@ func TestFileSystemResourceUpdateFail(t *testing.T) {
 }
 
 func TestAccFileSystemResourceGetMetaErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -424,6 +425,7 @@ func TestAccFileSystemResourceGetMetaErr(t *testing.T) {
 }
 
 func TestAccFileSystemResourceCreateFSErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -452,6 +454,7 @@ func TestAccFileSystemResourceCreateFSErr(t *testing.T) {
 }
 
 func TestAccFileSystemResourceDeleteMockErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -500,6 +503,7 @@ func TestAccFileSystemResourceDeleteMockErr(t *testing.T) {
 }
 
 func TestAccFileSystemResourceReleaseMock(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -525,6 +529,57 @@ func TestAccFileSystemResourceReleaseMock(t *testing.T) {
 	})
 }
 
+func TestAccFileSystemResourceWormDomain(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + FileSystemResourceWormDomainConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_filesystem.file_system_worm", "worm_domain.id"),
+					resource.TestCheckResourceAttr("powerscale_filesystem.file_system_worm", "worm_domain.type", "enterprise"),
+					resource.TestCheckResourceAttr("powerscale_filesystem.file_system_worm", "worm_domain.default_retention", "P90D"),
+				),
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + FileSystemResourceWormDomainUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_filesystem.file_system_worm", "worm_domain.default_retention", "P1Y"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFileSystemResourceAddWormDomain(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + FileSystemResourceAddWormDomainConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("powerscale_filesystem.file_system_worm_add", "worm_domain"),
+				),
+			},
+			// the SmartLock domain is created on the existing directory
+			{
+				Config: ProviderConfig + FileSystemResourceAddWormDomainUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_filesystem.file_system_worm_add", "worm_domain.id"),
+					resource.TestCheckResourceAttr("powerscale_filesystem.file_system_worm_add", "worm_domain.type", "enterprise"),
+					resource.TestCheckResourceAttr("powerscale_filesystem.file_system_worm_add", "worm_domain.default_retention", "P90D"),
+				),
+			},
+		},
+	})
+}
+
 var FileSystemResourceConfig = `
 resource "powerscale_filesystem" "file_system_test" {
 	# Default set to '/ifs'
@@ -698,3 +753,79 @@ resource "powerscale_filesystem" "file_system_test" {
 	}
   }
 `
+
+var FileSystemResourceWormDomainConfig = `
+resource "powerscale_filesystem" "file_system_worm" {
+	name = "tfaccDirWorm"
+	group = {
+	  id   = "GID:0"
+	  name = "wheel"
+	  type = "group"
+	}
+	owner = {
+	  id   = "UID:0",
+	  name = "root",
+	  type = "user"
+	}
+	worm_domain = {
+	  type              = "enterprise"
+	  default_retention = "P90D"
+	}
+}
+`
+
+var FileSystemResourceWormDomainUpdateConfig = `
+resource "powerscale_filesystem" "file_system_worm" {
+	name = "tfaccDirWorm"
+	group = {
+	  id   = "GID:0"
+	  name = "wheel"
+	  type = "group"
+	}
+	owner = {
+	  id   = "UID:0",
+	  name = "root",
+	  type = "user"
+	}
+	worm_domain = {
+	  type              = "enterprise"
+	  default_retention = "P1Y"
+	}
+}
+`
+
+var FileSystemResourceAddWormDomainConfig = `
+resource "powerscale_filesystem" "file_system_worm_add" {
+	name = "tfaccDirWormAdd"
+	group = {
+	  id   = "GID:0"
+	  name = "wheel"
+	  type = "group"
+	}
+	owner = {
+	  id   = "UID:0",
+	  name = "root",
+	  type = "user"
+	}
+}
+`
+
+var FileSystemResourceAddWormDomainUpdateConfig = `
+resource "powerscale_filesystem" "file_system_worm_add" {
+	name = "tfaccDirWormAdd"
+	group = {
+	  id   = "GID:0"
+	  name = "wheel"
+	  type = "group"
+	}
+	owner = {
+	  id   = "UID:0",
+	  name = "root",
+	  type = "user"
+	}
+	worm_domain = {
+	  type              = "enterprise"
+	  default_retention = "P90D"
+	}
+}
+`
@@ -0,0 +1,126 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccProviderFunctions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// provider functions are supported from Terraform 1.8
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + FunctionsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("size", "10737418240"),
+					resource.TestCheckOutput("fractional_size", "1649267441664"),
+					resource.TestCheckOutput("path", "/ifs/data/home/tfacc"),
+					resource.TestCheckOutput("relative_path", "/ifs/data/tfacc"),
+					resource.TestCheckOutput("uid", "UID:1000"),
+					resource.TestCheckOutput("sid", "SID:S-1-5-32-544"),
+					resource.TestCheckOutput("expiry", "1714644000"),
+					resource.TestCheckOutput("never", "0"),
+				),
+			},
+			{
+				Config:      ProviderConfig + FunctionsInvalidSizeConfig,
+				ExpectError: regexp.MustCompile(`.*invalid size*.`),
+			},
+			{
+				Config:      ProviderConfig + FunctionsInvalidPathConfig,
+				ExpectError: regexp.MustCompile(`.*is outside of /ifs*.`),
+			},
+			{
+				Config:      ProviderConfig + FunctionsInvalidPersonaConfig,
+				ExpectError: regexp.MustCompile(`.*invalid UID*.`),
+			},
+			{
+				Config:      ProviderConfig + FunctionsInvalidExpiryConfig,
+				ExpectError: regexp.MustCompile(`.*invalid set_expires*.`),
+			},
+		},
+	})
+}
+
+var FunctionsConfig = `
+output "size" {
+	value = provider::powerscale::parse_size("10G")
+}
+
+output "fractional_size" {
+	value = provider::powerscale::parse_size("1.5 TiB")
+}
+
+output "path" {
+	value = provider::powerscale::join_ifs_path("/ifs/data/", "home", "./tfacc/")
+}
+
+output "relative_path" {
+	value = provider::powerscale::join_ifs_path("data//tfacc")
+}
+
+output "uid" {
+	value = provider::powerscale::persona("uid", "1000")
+}
+
+output "sid" {
+	value = provider::powerscale::persona("SID", "s-1-5-32-544")
+}
+
+output "expiry" {
+	value = provider::powerscale::snapshot_expiry("2024-05-01T10:00:00Z", "1 Day")
+}
+
+output "never" {
+	value = provider::powerscale::snapshot_expiry("2024-05-01T10:00:00Z", "Never")
+}
+`
+
+var FunctionsInvalidSizeConfig = `
+output "size" {
+	value = provider::powerscale::parse_size("10 apples")
+}
+`
+
+var FunctionsInvalidPathConfig = `
+output "path" {
+	value = provider::powerscale::join_ifs_path("/ifs", "../etc")
+}
+`
+
+var FunctionsInvalidPersonaConfig = `
+output "uid" {
+	value = provider::powerscale::persona("uid", "-1")
+}
+`
+
+var FunctionsInvalidExpiryConfig = `
+output "expiry" {
+	value = provider::powerscale::snapshot_expiry("2024-05-01T10:00:00Z", "1 Year")
+}
+`
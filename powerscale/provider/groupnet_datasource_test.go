@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccGroupnetDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var groupnetTerraformName = "data.powerscale_groupnet.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -45,6 +46,7 @@ func TestAccGroupnetDataSourceAll(t *testing.T) {
 }
 
 func TestAccGroupnetDataSourceFilterNames(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var groupnetTerraformName = "data.powerscale_groupnet.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -72,6 +74,7 @@ func TestAccGroupnetDataSourceFilterNames(t *testing.T) {
 }
 
 func TestAccGroupnetDataSourceInvalidNames(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -86,6 +89,7 @@ func TestAccGroupnetDataSourceInvalidNames(t *testing.T) {
 }
 
 func TestAccGroupnetDatasourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -102,6 +106,7 @@ func TestAccGroupnetDatasourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccGroupnetDatasourceErrorGetAll(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -118,6 +123,7 @@ func TestAccGroupnetDatasourceErrorGetAll(t *testing.T) {
 }
 
 func TestAccGroupnetDatasourceReleaseMock(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var groupnetTerraformName = "data.powerscale_groupnet.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
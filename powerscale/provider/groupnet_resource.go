@@ -312,7 +312,12 @@ func (r *GroupnetResource) ImportState(ctx context.Context, req resource.ImportS
 	tflog.Info(ctx, "Importing Groupnet resource")
 	var state models.GroupnetModel
 
-	groupnetName := req.ID
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	groupnetName := parsedID.ID
 
 	groupnetResponse, err := helper.GetGroupnet(ctx, r.client, groupnetName)
 	if err != nil {
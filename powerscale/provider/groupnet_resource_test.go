@@ -35,6 +35,7 @@ var groupnetGetMocker *Mocker
 var groupnetMocker *Mocker
 
 func TestAccGroupnetResourceCreate(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var groupnetResourceName = "powerscale_groupnet.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -95,6 +96,7 @@ func TestAccGroupnetResourceCreate(t *testing.T) {
 }
 
 func TestAccGroupnetResourceErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var groupnetResourceName = "powerscale_groupnet.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -149,6 +151,7 @@ func TestAccGroupnetResourceErr(t *testing.T) {
 }
 
 func TestAccGroupnetResourceImport(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var groupnetResourceName = "powerscale_groupnet.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -176,6 +179,7 @@ func TestAccGroupnetResourceImport(t *testing.T) {
 }
 
 func TestAccGroupnetResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -214,6 +218,7 @@ func TestAccGroupnetResourceCreateMockErr(t *testing.T) {
 }
 
 func TestAccGroupnetResourceUpdateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -263,6 +268,7 @@ func TestAccGroupnetResourceUpdateMockErr(t *testing.T) {
 }
 
 func TestAccGroupnetResourceDeleteMockErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -300,6 +306,7 @@ func TestAccGroupnetResourceDeleteMockErr(t *testing.T) {
 }
 
 func TestAccGroupnetReleaseMockResource(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var groupnetResourceName = "powerscale_groupnet.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
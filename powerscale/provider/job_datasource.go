@@ -0,0 +1,225 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &JobDataSource{}
+	_ datasource.DataSourceWithConfigure = &JobDataSource{}
+)
+
+// NewJobDataSource returns the Job data source object.
+func NewJobDataSource() datasource.DataSource {
+	return &JobDataSource{}
+}
+
+// JobDataSource defines the data source implementation.
+type JobDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *JobDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema describes the data source arguments.
+func (d *JobDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the active jobs of the PowerScale Job Engine, i.e. the running, paused and waiting jobs. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description: "This datasource is used to query the active jobs of the PowerScale Job Engine, i.e. the running, paused and waiting jobs. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"jobs": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of active jobs",
+				MarkdownDescription: "List of active jobs",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description:         "ID of the job.",
+							MarkdownDescription: "ID of the job.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Type of the job.",
+							MarkdownDescription: "Type of the job.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							Description:         "State of the job.",
+							MarkdownDescription: "State of the job.",
+							Computed:            true,
+						},
+						"control_state": schema.StringAttribute{
+							Description:         "Control state of the job.",
+							MarkdownDescription: "Control state of the job.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the job.",
+							MarkdownDescription: "Description of the job.",
+							Computed:            true,
+						},
+						"paths": schema.ListAttribute{
+							Description:         "Paths the job runs on.",
+							MarkdownDescription: "Paths the job runs on.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"policy": schema.StringAttribute{
+							Description:         "Impact policy of the job.",
+							MarkdownDescription: "Impact policy of the job.",
+							Computed:            true,
+						},
+						"impact": schema.StringAttribute{
+							Description:         "Current impact of the job.",
+							MarkdownDescription: "Current impact of the job.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							Description:         "Priority of the job.",
+							MarkdownDescription: "Priority of the job.",
+							Computed:            true,
+						},
+						"progress": schema.StringAttribute{
+							Description:         "Progress of the job.",
+							MarkdownDescription: "Progress of the job.",
+							Computed:            true,
+						},
+						"current_phase": schema.Int64Attribute{
+							Description:         "Current phase of the job.",
+							MarkdownDescription: "Current phase of the job.",
+							Computed:            true,
+						},
+						"total_phases": schema.Int64Attribute{
+							Description:         "Number of phases of the job.",
+							MarkdownDescription: "Number of phases of the job.",
+							Computed:            true,
+						},
+						"create_time": schema.Int64Attribute{
+							Description:         "Time the job was created, in seconds since the epoch.",
+							MarkdownDescription: "Time the job was created, in seconds since the epoch.",
+							Computed:            true,
+						},
+						"start_time": schema.Int64Attribute{
+							Description:         "Time the job started, in seconds since the epoch.",
+							MarkdownDescription: "Time the job started, in seconds since the epoch.",
+							Computed:            true,
+						},
+						"end_time": schema.Int64Attribute{
+							Description:         "Time the job ended, in seconds since the epoch.",
+							MarkdownDescription: "Time the job ended, in seconds since the epoch.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"state": schema.StringAttribute{
+						Description:         "Only list the jobs in this state, e.g. running or paused_user.",
+						MarkdownDescription: "Only list the jobs in this state, e.g. running or paused_user.",
+						Optional:            true,
+					},
+					"types": schema.SetAttribute{
+						Description:         "Only list the jobs of these types.",
+						MarkdownDescription: "Only list the jobs of these types.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *JobDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *JobDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading job data source")
+	var config models.JobDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobs, err := helper.ListJobs(ctx, d.client, config.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading jobs", helper.GetErrorString(err, constants.ReadJobErrorMsg+"with error: "))
+		return
+	}
+
+	state := models.JobDataSourceModel{
+		ID:     types.StringValue("job_datasource"),
+		Jobs:   []models.JobDataSourceEntity{},
+		Filter: config.Filter,
+	}
+	for _, job := range jobs {
+		entity := models.JobDataSourceEntity{}
+		if err := helper.CopyFields(ctx, job, &entity); err != nil {
+			resp.Diagnostics.AddError("Error reading jobs", fmt.Sprintf("Could not read job %d with error: %s", job.Id, err.Error()))
+			return
+		}
+		state.Jobs = append(state.Jobs, entity)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading job data source")
+}
@@ -0,0 +1,272 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &JobPolicyResource{}
+	_ resource.ResourceWithConfigure   = &JobPolicyResource{}
+	_ resource.ResourceWithImportState = &JobPolicyResource{}
+)
+
+// NewJobPolicyResource creates a new resource.
+func NewJobPolicyResource() resource.Resource {
+	return &JobPolicyResource{}
+}
+
+// JobPolicyResource defines the resource implementation.
+type JobPolicyResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *JobPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_policy"
+}
+
+// Schema describes the resource arguments.
+func (r *JobPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the Job Engine impact policies of PowerScale Array, which set the impact of the jobs in time windows. " +
+			"We can Create, Update and Delete the impact policies using this resource. We can also import an existing impact policy by its name.",
+		Description: "This resource is used to manage the Job Engine impact policies of PowerScale Array, which set the impact of the jobs in time windows. " +
+			"We can Create, Update and Delete the impact policies using this resource. We can also import an existing impact policy by its name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the impact policy.",
+				MarkdownDescription: "ID of the impact policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description:         "Name of the impact policy.",
+				MarkdownDescription: "Name of the impact policy.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description:         "Description of the impact policy.",
+				MarkdownDescription: "Description of the impact policy.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"intervals": schema.ListNestedAttribute{
+				Description:         "Time windows of the impact policy, with the impact of the jobs in each window.",
+				MarkdownDescription: "Time windows of the impact policy, with the impact of the jobs in each window.",
+				Required:            true,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"begin": schema.StringAttribute{
+							Description:         "Start of the window, as a day and time such as \"Monday 08:00\".",
+							MarkdownDescription: "Start of the window, as a day and time such as `Monday 08:00`.",
+							Required:            true,
+						},
+						"end": schema.StringAttribute{
+							Description:         "End of the window, as a day and time such as \"Friday 18:00\".",
+							MarkdownDescription: "End of the window, as a day and time such as `Friday 18:00`.",
+							Required:            true,
+						},
+						"impact": schema.StringAttribute{
+							Description:         "Impact of the jobs in the window: Low, Medium, High or Paused.",
+							MarkdownDescription: "Impact of the jobs in the window: `Low`, `Medium`, `High` or `Paused`.",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.OneOf("Low", "Medium", "High", "Paused")},
+						},
+					},
+				},
+			},
+			"system": schema.BoolAttribute{
+				Description:         "Whether the impact policy is a system policy.",
+				MarkdownDescription: "Whether the impact policy is a system policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *JobPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *JobPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating job impact policy")
+	var plan models.JobPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID, err := helper.CreateJobPolicy(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating job impact policy", helper.GetErrorString(err, constants.CreateJobPolicyErrorMsg+"with error: "))
+		return
+	}
+	tflog.Debug(ctx, "job impact policy created", map[string]interface{}{"policyID": policyID})
+
+	state, err := r.read(ctx, policyID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating job impact policy", helper.GetErrorString(err, constants.ReadJobPolicyErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating job impact policy")
+}
+
+// Read reads the resource state.
+func (r *JobPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading job impact policy")
+	var state models.JobPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyID := state.ID.ValueString()
+	state, err := r.read(ctx, policyID)
+	if err != nil {
+		if helper.IsPAPINotFound(err) {
+			tflog.Warn(ctx, "job impact policy not found, removing it from the state", map[string]interface{}{"policyID": policyID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading job impact policy", helper.GetErrorString(err, constants.ReadJobPolicyErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading job impact policy")
+}
+
+// Update updates the resource state.
+func (r *JobPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating job impact policy")
+	var plan, state models.JobPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateJobPolicy(ctx, r.client, state.ID.ValueString(), plan); err != nil {
+		resp.Diagnostics.AddError("Error updating job impact policy", helper.GetErrorString(err, constants.UpdateJobPolicyErrorMsg+"with error: "))
+		return
+	}
+
+	state, err := r.read(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating job impact policy", helper.GetErrorString(err, constants.ReadJobPolicyErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating job impact policy")
+}
+
+// Delete deletes the resource.
+func (r *JobPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting job impact policy")
+	var state models.JobPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteJobPolicy(ctx, r.client, state.ID.ValueString()); err != nil && !helper.IsPAPINotFound(err) {
+		resp.Diagnostics.AddError("Error deleting job impact policy", helper.GetErrorString(err, constants.DeleteJobPolicyErrorMsg+"with error: "))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting job impact policy")
+}
+
+// ImportState imports an impact policy by its name or ID.
+func (r *JobPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, err := r.read(ctx, parsedID.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing job impact policy", helper.GetErrorString(err, constants.ReadJobPolicyErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read returns the state of the impact policy with the given ID or name.
+func (r *JobPolicyResource) read(ctx context.Context, policyID string) (models.JobPolicyResourceModel, error) {
+	var state models.JobPolicyResourceModel
+	policy, err := helper.GetJobPolicy(ctx, r.client, policyID)
+	if err != nil {
+		return state, err
+	}
+	if err := helper.CopyFields(ctx, policy, &state); err != nil {
+		return state, err
+	}
+	if len(policy.GetIntervals()) == 0 {
+		state.Intervals = []models.JobPolicyIntervalModel{}
+	}
+	return state, nil
+}
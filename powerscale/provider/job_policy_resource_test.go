@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJobPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + JobPolicyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_job_policy.test", "name", "tfacc_job_policy"),
+					resource.TestCheckResourceAttr("powerscale_job_policy.test", "description", "business hours"),
+					resource.TestCheckResourceAttr("powerscale_job_policy.test", "intervals.#", "2"),
+					resource.TestCheckResourceAttr("powerscale_job_policy.test", "intervals.0.impact", "Low"),
+					resource.TestCheckResourceAttr("powerscale_job_policy.test", "system", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_job_policy.test",
+				ImportState:       true,
+				ImportStateId:     "tfacc_job_policy",
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + JobPolicyResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_job_policy.test", "intervals.#", "1"),
+					resource.TestCheckResourceAttr("powerscale_job_policy.test", "intervals.0.impact", "Paused"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobPolicyResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// create error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateJobPolicy).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + JobPolicyResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + JobPolicyResourceConfig,
+			},
+			// update error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateJobPolicy).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + JobPolicyResourceUpdateConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// import error
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				ResourceName:  "powerscale_job_policy.test",
+				ImportState:   true,
+				ImportStateId: "tfacc_unknown_policy",
+				ExpectError:   regexp.MustCompile("Error importing job impact policy"),
+			},
+		},
+	})
+}
+
+var JobPolicyResourceConfig = `
+resource "powerscale_job_policy" "test" {
+	name        = "tfacc_job_policy"
+	description = "business hours"
+	intervals = [
+		{
+			begin  = "Monday 08:00"
+			end    = "Friday 18:00"
+			impact = "Low"
+		},
+		{
+			begin  = "Friday 18:00"
+			end    = "Monday 08:00"
+			impact = "High"
+		},
+	]
+}
+`
+
+var JobPolicyResourceUpdateConfig = `
+resource "powerscale_job_policy" "test" {
+	name        = "tfacc_job_policy"
+	description = "business hours"
+	intervals = [
+		{
+			begin  = "Monday 00:00"
+			end    = "Monday 00:00"
+			impact = "Paused"
+		},
+	]
+}
+`
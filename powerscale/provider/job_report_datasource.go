@@ -0,0 +1,189 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &JobReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &JobReportDataSource{}
+)
+
+// NewJobReportDataSource returns the JobReport data source object.
+func NewJobReportDataSource() datasource.DataSource {
+	return &JobReportDataSource{}
+}
+
+// JobReportDataSource defines the data source implementation.
+type JobReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *JobReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_report"
+}
+
+// Schema describes the data source arguments.
+func (d *JobReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the reports of the jobs of the PowerScale Job Engine, one per job phase. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description: "This datasource is used to query the reports of the jobs of the PowerScale Job Engine, one per job phase. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"job_reports": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of job reports",
+				MarkdownDescription: "List of job reports",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"job_id": schema.Int64Attribute{
+							Description:         "ID of the job.",
+							MarkdownDescription: "ID of the job.",
+							Computed:            true,
+						},
+						"job_type": schema.StringAttribute{
+							Description:         "Type of the job.",
+							MarkdownDescription: "Type of the job.",
+							Computed:            true,
+						},
+						"phase": schema.Int64Attribute{
+							Description:         "Phase of the job the report is for.",
+							MarkdownDescription: "Phase of the job the report is for.",
+							Computed:            true,
+						},
+						"time": schema.Int64Attribute{
+							Description:         "Time of the report, in seconds since the epoch.",
+							MarkdownDescription: "Time of the report, in seconds since the epoch.",
+							Computed:            true,
+						},
+						"results": schema.StringAttribute{
+							Description:         "Results of the phase as a JSON document, whose fields depend on the job type.",
+							MarkdownDescription: "Results of the phase as a JSON document, whose fields depend on the job type.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"job_id": schema.Int64Attribute{
+						Description:         "Only list the reports of this job.",
+						MarkdownDescription: "Only list the reports of this job.",
+						Optional:            true,
+					},
+					"job_type": schema.StringAttribute{
+						Description:         "Only list the reports of the jobs of this type.",
+						MarkdownDescription: "Only list the reports of the jobs of this type.",
+						Optional:            true,
+					},
+					"begin": schema.Int64Attribute{
+						Description:         "Only list the reports after this time, in seconds since the epoch.",
+						MarkdownDescription: "Only list the reports after this time, in seconds since the epoch.",
+						Optional:            true,
+					},
+					"end": schema.Int64Attribute{
+						Description:         "Only list the reports before this time, in seconds since the epoch.",
+						MarkdownDescription: "Only list the reports before this time, in seconds since the epoch.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *JobReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *JobReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading job report data source")
+	var config models.JobReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports, err := helper.ListJobReports(ctx, d.client, config.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading job reports", helper.GetErrorString(err, constants.ReadJobReportErrorMsg+"with error: "))
+		return
+	}
+
+	state := models.JobReportDataSourceModel{
+		ID:         types.StringValue("job_report_datasource"),
+		JobReports: []models.JobReportDataSourceEntity{},
+		Filter:     config.Filter,
+	}
+	for _, report := range reports {
+		entity := models.JobReportDataSourceEntity{
+			JobID:   types.Int64Value(int64(report.GetJobId())),
+			JobType: types.StringValue(report.GetJobType()),
+			Phase:   types.Int64Value(int64(report.GetPhase())),
+			Time:    types.Int64Value(int64(report.GetTime())),
+			Results: types.StringNull(),
+		}
+		if results, err := json.Marshal(report.Results); err == nil && len(results) > 0 && string(results) != "null" {
+			entity.Results = types.StringValue(string(results))
+		}
+		state.JobReports = append(state.JobReports, entity)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading job report data source")
+}
@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJobReportDatasource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + JobResourceConfig + JobReportDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_job_report.test", "job_reports.#"),
+					resource.TestCheckResourceAttr("data.powerscale_job_report.test", "job_reports.0.job_type", "FSAnalyze"),
+					resource.TestCheckResourceAttrPair("data.powerscale_job_report.test", "job_reports.0.job_id", "powerscale_job.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobReportDatasourceErrorList(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListJobReports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + JobReportDatasourceTypeConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + JobReportDatasourceTypeConfig,
+			},
+		},
+	})
+}
+
+var JobReportDatasourceConfig = `
+data "powerscale_job_report" "test" {
+	filter {
+		job_id = powerscale_job.test.id
+	}
+}
+`
+
+var JobReportDatasourceTypeConfig = `
+data "powerscale_job_report" "test" {
+	filter {
+		job_type = "FSAnalyze"
+		begin    = 0
+	}
+}
+`
@@ -0,0 +1,407 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &JobResource{}
+	_ resource.ResourceWithConfigure      = &JobResource{}
+	_ resource.ResourceWithValidateConfig = &JobResource{}
+	_ resource.ResourceWithImportState    = &JobResource{}
+)
+
+// jobTimeout is the default time to wait for a job to end.
+const jobTimeout = 60 * time.Minute
+
+// jobTimeouts bounds the operations waiting on the job.
+var jobTimeouts = timeouts.Opts{Create: true, Update: true}
+
+// NewJobResource creates a new resource.
+func NewJobResource() resource.Resource {
+	return &JobResource{}
+}
+
+// JobResource defines the resource implementation.
+type JobResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *JobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+// Schema describes the resource arguments.
+func (r *JobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to start a job of the PowerScale Job Engine, such as TreeDelete, PermissionRepair, SmartPools or FSAnalyze, and wait for it to end. " +
+			"Changing the type, paths or parameters starts a new job. The impact policy and priority of a running job can be updated. " +
+			"On destroy, a job that has not ended is cancelled or paused, as set by delete_action. We can also import an existing job by its ID.",
+		Description: "This resource is used to start a job of the PowerScale Job Engine, such as TreeDelete, PermissionRepair, SmartPools or FSAnalyze, and wait for it to end. " +
+			"Changing the type, paths or parameters starts a new job. The impact policy and priority of a running job can be updated. " +
+			"On destroy, a job that has not ended is cancelled or paused, as set by delete_action. We can also import an existing job by its ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the job.",
+				MarkdownDescription: "ID of the job.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Type of the job, e.g. TreeDelete, PermissionRepair, SmartPools or FSAnalyze.",
+				MarkdownDescription: "Type of the job, e.g. TreeDelete, PermissionRepair, SmartPools or FSAnalyze.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				Description:         "Paths the job runs on, for the job types taking paths.",
+				MarkdownDescription: "Paths the job runs on, for the job types taking paths.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Description:         "Impact policy of the job. Defaults to the policy of the job type.",
+				MarkdownDescription: "Impact policy of the job. Defaults to the policy of the job type.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description:         "Priority of the job, from 1 (highest) to 10. Defaults to the priority of the job type.",
+				MarkdownDescription: "Priority of the job, from 1 (highest) to 10. Defaults to the priority of the job type.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.Between(1, 10)},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_dup": schema.BoolAttribute{
+				Description:         "Whether to start the job when a job of the same type is already running.",
+				MarkdownDescription: "Whether to start the job when a job of the same type is already running.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.StringAttribute{
+				Description:         "JSON object of the parameters of the job type, e.g. {\"prepair_params\": {\"mode\": \"clone\", \"template\": \"/ifs/data\"}}, merged into the request starting the job.",
+				MarkdownDescription: "JSON object of the parameters of the job type, e.g. `{\"prepair_params\": {\"mode\": \"clone\", \"template\": \"/ifs/data\"}}`, merged into the request starting the job.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Description:         "Whether to wait for the job to end, and fail when it does not succeed. Defaults to true.",
+				MarkdownDescription: "Whether to wait for the job to end, and fail when it does not succeed. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"delete_action": schema.StringAttribute{
+				Description:         "Action on the job when the resource is destroyed before the job has ended: cancel, pause or none. Defaults to cancel.",
+				MarkdownDescription: "Action on the job when the resource is destroyed before the job has ended: `cancel`, `pause` or `none`. Defaults to `cancel`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(helper.JobDeleteActionCancel),
+				Validators: []validator.String{stringvalidator.OneOf(
+					helper.JobDeleteActionCancel, helper.JobDeleteActionPause, helper.JobDeleteActionNone,
+				)},
+			},
+			"state": schema.StringAttribute{
+				Description:         "State of the job, e.g. running, paused_user, succeeded, failed or cancelled_user.",
+				MarkdownDescription: "State of the job, e.g. running, paused_user, succeeded, failed or cancelled_user.",
+				Computed:            true,
+			},
+			"progress": schema.StringAttribute{
+				Description:         "Progress of the job, as reported by the Job Engine.",
+				MarkdownDescription: "Progress of the job, as reported by the Job Engine.",
+				Computed:            true,
+			},
+			"current_phase": schema.Int64Attribute{
+				Description:         "Current phase of the job.",
+				MarkdownDescription: "Current phase of the job.",
+				Computed:            true,
+			},
+			"total_phases": schema.Int64Attribute{
+				Description:         "Number of phases of the job.",
+				MarkdownDescription: "Number of phases of the job.",
+				Computed:            true,
+			},
+			"start_time": schema.Int64Attribute{
+				Description:         "Time the job started, in seconds since the epoch.",
+				MarkdownDescription: "Time the job started, in seconds since the epoch.",
+				Computed:            true,
+			},
+			"end_time": schema.Int64Attribute{
+				Description:         "Time the job ended, in seconds since the epoch.",
+				MarkdownDescription: "Time the job ended, in seconds since the epoch.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, jobTimeouts, jobTimeout),
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *JobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// ValidateConfig validates that the parameters are a JSON object.
+func (r *JobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg models.JobResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if cfg.Parameters.IsNull() || cfg.Parameters.IsUnknown() {
+		return
+	}
+	if err := helper.ValidateJSONObject(cfg.Parameters.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("parameters"), "Invalid parameters", err.Error())
+	}
+}
+
+// Create starts the job and waits for it to end.
+func (r *JobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Starting job")
+	var plan models.JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, jobTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	jobID, err := helper.StartJob(ctx, r.client, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error starting job", helper.GetErrorString(err, constants.CreateJobErrorMsg+"with error: "))
+		return
+	}
+	tflog.Debug(ctx, "job started", map[string]interface{}{"jobID": jobID})
+	plan.ID = types.StringValue(jobID)
+
+	r.refresh(ctx, &plan, plan.WaitForCompletion.ValueBool(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with starting job")
+}
+
+// Read refreshes the progress of the job. A job no longer known to the Job Engine keeps its last state.
+func (r *JobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading job")
+	var state models.JobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := helper.GetJob(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		if helper.IsPAPINotFound(err) {
+			tflog.Warn(ctx, "job not found, keeping its last state", map[string]interface{}{"jobID": state.ID.ValueString()})
+			return
+		}
+		resp.Diagnostics.AddError("Error reading job", helper.GetErrorString(err, constants.ReadJobErrorMsg+"with error: "))
+		return
+	}
+	helper.UpdateJobResourceState(job, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading job")
+}
+
+// Update changes the impact policy and priority of a job that has not ended, and waits for it when requested.
+func (r *JobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating job")
+	var plan, state models.JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, jobTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	plan.ID = state.ID
+	if !helper.IsJobDone(state.State.ValueString()) &&
+		(!plan.Policy.Equal(state.Policy) || !plan.Priority.Equal(state.Priority)) {
+		if err := helper.UpdateJob(ctx, r.client, state.ID.ValueString(), plan); err != nil {
+			resp.Diagnostics.AddError("Error updating job", helper.GetErrorString(err, constants.UpdateJobErrorMsg+"with error: "))
+			return
+		}
+	}
+
+	r.refresh(ctx, &plan, plan.WaitForCompletion.ValueBool() && !state.WaitForCompletion.ValueBool(), &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Info(ctx, "Done with updating job")
+}
+
+// refresh reads the job into the plan, after waiting for it to end when wait is set.
+// A waited job that does not succeed is reported as an error, so that the resource is replaced on the next apply.
+func (r *JobResource) refresh(ctx context.Context, plan *models.JobResourceModel, wait bool, diags *diag.Diagnostics) {
+	var job *powerscale.V10JobJobExtended
+	if wait {
+		var waitDiags diag.Diagnostics
+		job, waitDiags = helper.WaitForJob(ctx, r.client, plan.ID.ValueString())
+		diags.Append(waitDiags...)
+		if job != nil && !waitDiags.HasError() && job.State != "succeeded" {
+			diags.AddError(fmt.Sprintf("Job %s did not succeed", plan.ID.ValueString()), helper.JobStatus(job))
+		}
+	} else {
+		var err error
+		job, err = helper.GetJob(ctx, r.client, plan.ID.ValueString())
+		if err != nil {
+			diags.AddError("Error reading job", helper.GetErrorString(err, constants.ReadJobErrorMsg+"with error: "))
+		}
+	}
+	if job != nil {
+		helper.UpdateJobResourceState(job, plan)
+		return
+	}
+	plan.State = types.StringValue("unknown")
+	plan.Progress = types.StringNull()
+	plan.CurrentPhase = types.Int64Null()
+	plan.TotalPhases = types.Int64Null()
+	plan.StartTime = types.Int64Null()
+	plan.EndTime = types.Int64Null()
+	if plan.Policy.IsUnknown() {
+		plan.Policy = types.StringNull()
+	}
+	if plan.Priority.IsUnknown() {
+		plan.Priority = types.Int64Null()
+	}
+}
+
+// Delete cancels or pauses the job when it has not ended.
+func (r *JobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting job")
+	var state models.JobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(helper.StopJob(ctx, r.client, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting job")
+}
+
+// ImportState imports a job by its ID.
+func (r *JobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job, err := helper.GetJob(ctx, r.client, parsedID.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing job", helper.GetErrorString(err, constants.ReadJobErrorMsg+"with error: "))
+		return
+	}
+	state := models.JobResourceModel{
+		Type:              types.StringValue(job.Type),
+		Paths:             types.ListNull(types.StringType),
+		WaitForCompletion: types.BoolValue(true),
+		DeleteAction:      types.StringValue(helper.JobDeleteActionCancel),
+		Timeouts:          nullTimeouts(jobTimeouts),
+	}
+	if len(job.GetPaths()) > 0 {
+		paths, diags := types.ListValueFrom(ctx, types.StringType, job.GetPaths())
+		resp.Diagnostics.Append(diags...)
+		state.Paths = paths
+	}
+	helper.UpdateJobResourceState(job, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,187 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	powerscale "dell/powerscale-go-client"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJobResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + JobResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_job.test", "id"),
+					resource.TestCheckResourceAttr("powerscale_job.test", "type", "FSAnalyze"),
+					resource.TestCheckResourceAttr("powerscale_job.test", "policy", "LOW"),
+					resource.TestCheckResourceAttr("powerscale_job.test", "state", "succeeded"),
+					resource.TestCheckResourceAttr("powerscale_job.test", "wait_for_completion", "true"),
+					resource.TestCheckResourceAttr("powerscale_job.test", "delete_action", "cancel"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "powerscale_job.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update testing, the policy of an ended job is kept in the state only
+			{
+				Config: ProviderConfig + JobResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_job.test", "policy", "MEDIUM"),
+					resource.TestCheckResourceAttr("powerscale_job.test", "wait_for_completion", "false"),
+					resource.TestCheckResourceAttr("powerscale_job.test", "delete_action", "pause"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobResourceTreeDelete(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// directory to delete
+			{
+				Config: ProviderConfig + JobResourceDirectoryConfig,
+			},
+			// delete the directory with a job, after removing it from the state
+			{
+				Config: ProviderConfig + JobResourceTreeDeleteConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_job.tree_delete", "type", "TreeDelete"),
+					resource.TestCheckResourceAttr("powerscale_job.tree_delete", "paths.0", "/ifs/tfacc_job_tree_delete"),
+					resource.TestCheckResourceAttr("powerscale_job.tree_delete", "state", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// parameters that are not a JSON object
+			{
+				Config:      ProviderConfig + JobResourceInvalidParametersConfig,
+				ExpectError: regexp.MustCompile("Invalid parameters"),
+			},
+			// start error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.StartJob).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + JobResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// job that does not succeed
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetJob).Return(&powerscale.V10JobJobExtended{Id: 1, Type: "FSAnalyze", State: "failed"}, nil).Build()
+				},
+				Config:      ProviderConfig + JobResourceConfig,
+				ExpectError: regexp.MustCompile("Job [0-9]+ did not succeed"),
+			},
+			// import of a job that does not exist
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config:        ProviderConfig + JobResourceConfig,
+				ResourceName:  "powerscale_job.test",
+				ImportState:   true,
+				ImportStateId: "999999",
+				ExpectError:   regexp.MustCompile("Error importing job"),
+			},
+		},
+	})
+}
+
+var JobResourceConfig = `
+resource "powerscale_job" "test" {
+	type   = "FSAnalyze"
+	policy = "LOW"
+}
+`
+
+var JobResourceUpdateConfig = `
+resource "powerscale_job" "test" {
+	type                = "FSAnalyze"
+	policy              = "MEDIUM"
+	wait_for_completion = false
+	delete_action       = "pause"
+}
+`
+
+var JobResourceDirectoryConfig = `
+resource "powerscale_filesystem" "tree_delete" {
+	directory_path = "/ifs"
+	name           = "tfacc_job_tree_delete"
+	recursive      = true
+	overwrite      = false
+	group = {
+		id   = "GID:0"
+		name = "wheel"
+		type = "group"
+	}
+	owner = {
+		id   = "UID:0",
+		name = "root",
+		type = "user"
+	}
+}
+`
+
+var JobResourceTreeDeleteConfig = `
+removed {
+	from = powerscale_filesystem.tree_delete
+	lifecycle {
+		destroy = false
+	}
+}
+
+resource "powerscale_job" "tree_delete" {
+	type  = "TreeDelete"
+	paths = ["/ifs/tfacc_job_tree_delete"]
+}
+`
+
+var JobResourceInvalidParametersConfig = `
+resource "powerscale_job" "test" {
+	type       = "FSAnalyze"
+	parameters = "[]"
+}
+`
@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &JobTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &JobTypeDataSource{}
+)
+
+// NewJobTypeDataSource returns the JobType data source object.
+func NewJobTypeDataSource() datasource.DataSource {
+	return &JobTypeDataSource{}
+}
+
+// JobTypeDataSource defines the data source implementation.
+type JobTypeDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *JobTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_type"
+}
+
+// Schema describes the data source arguments.
+func (d *JobTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the job types of the PowerScale Job Engine with their settings. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description: "This datasource is used to query the job types of the PowerScale Job Engine with their settings. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"job_types": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of job types",
+				MarkdownDescription: "List of job types",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "ID of the job type.",
+							MarkdownDescription: "ID of the job type.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							Description:         "Description of the job type.",
+							MarkdownDescription: "Description of the job type.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Whether the job type is enabled.",
+							MarkdownDescription: "Whether the job type is enabled.",
+							Computed:            true,
+						},
+						"policy": schema.StringAttribute{
+							Description:         "Default impact policy of the jobs of this type.",
+							MarkdownDescription: "Default impact policy of the jobs of this type.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							Description:         "Default priority of the jobs of this type.",
+							MarkdownDescription: "Default priority of the jobs of this type.",
+							Computed:            true,
+						},
+						"schedule": schema.StringAttribute{
+							Description:         "Schedule of the jobs of this type.",
+							MarkdownDescription: "Schedule of the jobs of this type.",
+							Computed:            true,
+						},
+						"exclusion_set": schema.StringAttribute{
+							Description:         "Exclusion set of the job type.",
+							MarkdownDescription: "Exclusion set of the job type.",
+							Computed:            true,
+						},
+						"allow_multiple_instances": schema.BoolAttribute{
+							Description:         "Whether jobs of this type can run together.",
+							MarkdownDescription: "Whether jobs of this type can run together.",
+							Computed:            true,
+						},
+						"hidden": schema.BoolAttribute{
+							Description:         "Whether the job type is hidden.",
+							MarkdownDescription: "Whether the job type is hidden.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Description:         "IDs of the job types to list.",
+						MarkdownDescription: "IDs of the job types to list.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"show_all": schema.BoolAttribute{
+						Description:         "Whether to list the hidden job types.",
+						MarkdownDescription: "Whether to list the hidden job types.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *JobTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *JobTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading job type data source")
+	var config models.JobTypeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+	showAll := false
+	if config.Filter != nil {
+		for _, id := range config.Filter.IDs {
+			ids = append(ids, id.ValueString())
+		}
+		showAll = config.Filter.ShowAll.ValueBool()
+	}
+	jobTypes, err := helper.ListJobTypes(ctx, d.client, showAll)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading job types", helper.GetErrorString(err, constants.ReadJobTypeErrorMsg+"with error: "))
+		return
+	}
+
+	state := models.JobTypeDataSourceModel{
+		ID:       types.StringValue("job_type_datasource"),
+		JobTypes: []models.JobTypeDataSourceEntity{},
+		Filter:   config.Filter,
+	}
+	for _, jobType := range jobTypes {
+		if len(ids) > 0 && !slices.Contains(ids, jobType.Id) {
+			continue
+		}
+		entity := models.JobTypeDataSourceEntity{}
+		if err := helper.CopyFields(ctx, jobType, &entity); err != nil {
+			resp.Diagnostics.AddError("Error reading job types", fmt.Sprintf("Could not read job type %s with error: %s", jobType.Id, err.Error()))
+			return
+		}
+		state.JobTypes = append(state.JobTypes, entity)
+	}
+	if len(state.JobTypes) < len(ids) {
+		resp.Diagnostics.AddError("Error reading job types", fmt.Sprintf("Could not find all the job types %v", ids))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading job type data source")
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJobTypeDatasource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + JobTypeDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_job_type.all", "job_types.#"),
+					resource.TestCheckResourceAttr("data.powerscale_job_type.filtered", "job_types.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.powerscale_job_type.filtered", "job_types.*", map[string]string{
+						"id": "TreeDelete",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobTypeDatasourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + JobTypeDatasourceUnknownConfig,
+				ExpectError: regexp.MustCompile("Could not find all the job types"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListJobTypes).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + JobTypeDatasourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + JobTypeDatasourceConfig,
+			},
+		},
+	})
+}
+
+var JobTypeDatasourceConfig = `
+data "powerscale_job_type" "all" {
+	filter {
+		show_all = true
+	}
+}
+
+data "powerscale_job_type" "filtered" {
+	filter {
+		ids = ["TreeDelete", "SmartPools"]
+	}
+}
+`
+
+var JobTypeDatasourceUnknownConfig = `
+data "powerscale_job_type" "unknown" {
+	filter {
+		ids = ["tfacc_unknown_type"]
+	}
+}
+`
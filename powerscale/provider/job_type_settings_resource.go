@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &JobTypeSettingsResource{}
+	_ resource.ResourceWithConfigure   = &JobTypeSettingsResource{}
+	_ resource.ResourceWithImportState = &JobTypeSettingsResource{}
+)
+
+// NewJobTypeSettingsResource creates a new resource.
+func NewJobTypeSettingsResource() resource.Resource {
+	return &JobTypeSettingsResource{}
+}
+
+// JobTypeSettingsResource defines the resource implementation.
+type JobTypeSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *JobTypeSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_job_type_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *JobTypeSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the settings of a Job Engine job type on PowerScale Array, such as its priority, default impact policy and schedule. " +
+			"We can Create, Update and Delete the job type settings using this resource. We can also import the settings of an existing job type. " +
+			"Note that deleting the resource only removes it from the Terraform state, the job type keeps its settings.",
+		Description: "This resource is used to manage the settings of a Job Engine job type on PowerScale Array, such as its priority, default impact policy and schedule. " +
+			"We can Create, Update and Delete the job type settings using this resource. We can also import the settings of an existing job type. " +
+			"Note that deleting the resource only removes it from the Terraform state, the job type keeps its settings.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the job type.",
+				MarkdownDescription: "ID of the job type.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Job type, e.g. SmartPools or FSAnalyze.",
+				MarkdownDescription: "Job type, e.g. SmartPools or FSAnalyze.",
+				Required:            true,
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description:         "Whether the job type is enabled, and runs on its schedule.",
+				MarkdownDescription: "Whether the job type is enabled, and runs on its schedule.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"policy": schema.StringAttribute{
+				Description:         "Default impact policy of the jobs of this type.",
+				MarkdownDescription: "Default impact policy of the jobs of this type.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				Description:         "Default priority of the jobs of this type, from 1 (highest) to 10.",
+				MarkdownDescription: "Default priority of the jobs of this type, from 1 (highest) to 10.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.Int64{int64validator.Between(1, 10)},
+			},
+			"schedule": schema.StringAttribute{
+				Description:         "Schedule of the jobs of this type, e.g. \"every Saturday at 12:00 AM\". An empty string removes the schedule.",
+				MarkdownDescription: "Schedule of the jobs of this type, e.g. `every Saturday at 12:00 AM`. An empty string removes the schedule.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				Description:         "Description of the job type.",
+				MarkdownDescription: "Description of the job type.",
+				Computed:            true,
+			},
+			"exclusion_set": schema.StringAttribute{
+				Description:         "Exclusion set of the job type, the jobs of the same set do not run together.",
+				MarkdownDescription: "Exclusion set of the job type, the jobs of the same set do not run together.",
+				Computed:            true,
+			},
+			"allow_multiple_instances": schema.BoolAttribute{
+				Description:         "Whether jobs of this type can run together.",
+				MarkdownDescription: "Whether jobs of this type can run together.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *JobTypeSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create updates the settings of the job type.
+func (r *JobTypeSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating job type settings")
+	var plan models.JobTypeSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating job type settings")
+}
+
+// Read reads the resource state.
+func (r *JobTypeSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading job type settings")
+	var state models.JobTypeSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobType, err := helper.GetJobType(ctx, r.client, state.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading job type settings", helper.GetErrorString(err, constants.ReadJobTypeErrorMsg+"with error: "))
+		return
+	}
+	if err := helper.UpdateJobTypeSettingsState(ctx, jobType, &state); err != nil {
+		resp.Diagnostics.AddError("Error reading job type settings", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading job type settings")
+}
+
+// Update updates the settings of the job type.
+func (r *JobTypeSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating job type settings")
+	var plan models.JobTypeSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating job type settings")
+}
+
+// Delete removes the resource from the state, the job type keeps its settings.
+func (r *JobTypeSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting job type settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting job type settings")
+}
+
+// ImportState imports the settings of a job type by its name.
+func (r *JobTypeSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "type"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobType, err := helper.GetJobType(ctx, r.client, parsedID.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing job type settings", helper.GetErrorString(err, constants.ReadJobTypeErrorMsg+"with error: "))
+		return
+	}
+	var state models.JobTypeSettingsResourceModel
+	if err := helper.UpdateJobTypeSettingsState(ctx, jobType, &state); err != nil {
+		resp.Diagnostics.AddError("Error importing job type settings", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// apply sends the settings of the plan and returns the settings read back.
+func (r *JobTypeSettingsResource) apply(ctx context.Context, plan models.JobTypeSettingsResourceModel) (models.JobTypeSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	state := plan
+	if err := helper.UpdateJobType(ctx, r.client, plan); err != nil {
+		diags.AddError("Error updating job type settings", helper.GetErrorString(err, constants.UpdateJobTypeErrorMsg+"with error: "))
+		return state, diags
+	}
+	jobType, err := helper.GetJobType(ctx, r.client, plan.Type.ValueString())
+	if err != nil {
+		diags.AddError("Error reading job type settings", helper.GetErrorString(err, constants.ReadJobTypeErrorMsg+"with error: "))
+		return state, diags
+	}
+	if err := helper.UpdateJobTypeSettingsState(ctx, jobType, &state); err != nil {
+		diags.AddError("Error reading job type settings", err.Error())
+	}
+	return state, diags
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccJobTypeSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + JobTypeSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_job_type_settings.test", "id", "FSAnalyze"),
+					resource.TestCheckResourceAttr("powerscale_job_type_settings.test", "priority", "2"),
+					resource.TestCheckResourceAttr("powerscale_job_type_settings.test", "policy", "MEDIUM"),
+					resource.TestCheckResourceAttr("powerscale_job_type_settings.test", "schedule", "every Saturday at 10:00 PM"),
+					resource.TestCheckResourceAttrSet("powerscale_job_type_settings.test", "enabled"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_job_type_settings.test",
+				ImportState:       true,
+				ImportStateId:     "FSAnalyze",
+				ImportStateVerify: true,
+			},
+			// Update testing, restoring the default settings of FSAnalyze
+			{
+				Config: ProviderConfig + JobTypeSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_job_type_settings.test", "priority", "1"),
+					resource.TestCheckResourceAttr("powerscale_job_type_settings.test", "policy", "LOW"),
+					resource.TestCheckResourceAttr("powerscale_job_type_settings.test", "schedule", "every day at 22:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobTypeSettingsResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateJobType).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + JobTypeSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetJobType).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + JobTypeSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config:        ProviderConfig + JobTypeSettingsResourceConfig,
+				ResourceName:  "powerscale_job_type_settings.test",
+				ImportState:   true,
+				ImportStateId: "tfacc_unknown_type",
+				ExpectError:   regexp.MustCompile("Error importing job type settings"),
+			},
+		},
+	})
+}
+
+var JobTypeSettingsResourceConfig = `
+resource "powerscale_job_type_settings" "test" {
+	type     = "FSAnalyze"
+	priority = 2
+	policy   = "MEDIUM"
+	schedule = "every Saturday at 10:00 PM"
+}
+`
+
+var JobTypeSettingsResourceUpdateConfig = `
+resource "powerscale_job_type_settings" "test" {
+	type     = "FSAnalyze"
+	priority = 1
+	policy   = "LOW"
+	schedule = "every day at 22:00"
+}
+`
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &JoinIfsPathFunction{}
+
+// JoinIfsPathFunction joins and normalizes OneFS paths.
+type JoinIfsPathFunction struct{}
+
+// NewJoinIfsPathFunction creates a new join_ifs_path function.
+func NewJoinIfsPathFunction() function.Function {
+	return &JoinIfsPathFunction{}
+}
+
+// Metadata describes the function.
+func (f *JoinIfsPathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "join_ifs_path"
+}
+
+// Definition describes the parameters and return value of the function.
+func (f *JoinIfsPathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Joins path elements into a normalized OneFS path.",
+		Description: "Joins path elements into a normalized absolute OneFS path, e.g. /ifs/data/home. " +
+			"Duplicate and trailing slashes, . and .. are resolved. A relative result is placed under /ifs, and the result must be under /ifs.",
+		MarkdownDescription: "Joins path elements into a normalized absolute OneFS path, e.g. `/ifs/data/home`. " +
+			"Duplicate and trailing slashes, `.` and `..` are resolved. A relative result is placed under `/ifs`, and the result must be under `/ifs`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "path",
+				Description: "The first path element, absolute or relative to /ifs.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "elements",
+			Description: "The path elements appended to the path.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run joins the path.
+func (f *JoinIfsPathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var first string
+	var elements []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &first, &elements))
+	if resp.Error != nil {
+		return
+	}
+	joined, err := helper.JoinIfsPath(append([]string{first}, elements...)...)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, joined))
+}
@@ -33,6 +33,7 @@ var ldapDsMocker *mockey.Mocker
 var ldapGetDsMocker *mockey.Mocker
 
 func TestAccLdapProviderDataSource(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	var ldapProviderTerraformName = "data.powerscale_ldap_provider.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -72,6 +73,7 @@ func TestAccLdapProviderDataSource(t *testing.T) {
 }
 
 func TestAccLdapProviderDataSourceInvalidNames(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -86,6 +88,7 @@ func TestAccLdapProviderDataSourceInvalidNames(t *testing.T) {
 }
 
 func TestAccLdapProviderDatasourceErrorGetAll(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -122,6 +125,7 @@ func TestAccLdapProviderDatasourceErrorGetAll(t *testing.T) {
 }
 
 func TestAccLdapProviderDatasourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -144,6 +148,7 @@ func TestAccLdapProviderDatasourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccLdapProviderDatasourceHelperMockErr(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	var ldapProviderTerraformName = "data.powerscale_ldap_provider.test"
 	mockV16LdapProviders, mockV11LdapProviders := getMockLdapProviderConfig()
 	resource.Test(t, resource.TestCase{
@@ -226,6 +231,7 @@ func TestAccLdapProviderDatasourceHelperMockErr(t *testing.T) {
 	})
 }
 func TestAccLdapProviderDatasourceReleaseMock(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -39,8 +40,15 @@ var (
 	_ resource.Resource                = &LdapProviderResource{}
 	_ resource.ResourceWithConfigure   = &LdapProviderResource{}
 	_ resource.ResourceWithImportState = &LdapProviderResource{}
+	_ resource.ResourceWithModifyPlan  = &LdapProviderResource{}
 )
 
+// ldapProviderVersionRequirements lists the attributes depending on the OneFS version.
+var ldapProviderVersionRequirements = []helper.VersionRequirement{
+	{Attribute: path.MatchRoot("tls_revocation_check_level"), MinVersion: "9.5.0"},
+	{Attribute: path.MatchRoot("ocsp_server_uris"), MinVersion: "9.5.0"},
+}
+
 // NewLdapProviderResource creates a new resource.
 func NewLdapProviderResource() resource.Resource {
 	return &LdapProviderResource{}
@@ -340,6 +348,21 @@ func (r *LdapProviderResource) Schema(ctx context.Context, req resource.SchemaRe
 				Optional:            true,
 				Computed:            true,
 			},
+			"bind_password_wo": schema.StringAttribute{
+				Description: "Specifies the password for the distinguished name for binding to the LDAP server, without storing it in the Terraform state." +
+					" Requires Terraform 1.11 or later. The password is sent on creation and whenever bind_password_wo_version changes.",
+				MarkdownDescription: "Specifies the password for the distinguished name for binding to the LDAP server, without storing it in the Terraform state." +
+					" Requires Terraform 1.11 or later. The password is sent on creation and whenever `bind_password_wo_version` changes.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"bind_password_wo_version": schema.Int64Attribute{
+				Description:         "The version of bind_password_wo. Change it to send bind_password_wo to the LDAP provider again.",
+				MarkdownDescription: "The version of `bind_password_wo`. Change it to send `bind_password_wo` to the LDAP provider again.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("bind_password_wo"))},
+			},
 			"bind_mechanism": schema.StringAttribute{
 				Description:         "Specifies which bind mechanism to use when connecting to an LDAP server. The only supported option is the 'simple' value.",
 				MarkdownDescription: "Specifies which bind mechanism to use when connecting to an LDAP server. The only supported option is the 'simple' value.",
@@ -637,6 +660,14 @@ func (r *LdapProviderResource) Configure(ctx context.Context, req resource.Confi
 	r.client = pscaleClient
 }
 
+// ModifyPlan validates the configuration against the OneFS version of the cluster.
+func (r *LdapProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(helper.CheckVersionRequirements(ctx, r.client, req.Config, ldapProviderVersionRequirements)...)
+}
+
 // Create allocates the resource.
 func (r *LdapProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "Creating LdapProvider resource...")
@@ -648,6 +679,13 @@ func (r *LdapProviderResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	bindPassword, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, nil, "bind_password_wo", "bind_password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.BindPasswordWO = bindPassword
+
 	ldapName := plan.Name.ValueString()
 	if err := helper.CreateLdapProvider(ctx, r.client, &plan); err != nil {
 		resp.Diagnostics.AddError(
@@ -727,6 +765,13 @@ func (r *LdapProviderResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	bindPassword, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, &req.State, "bind_password_wo", "bind_password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.BindPasswordWO = bindPassword
+
 	if err := helper.UpdateLdapProvider(ctx, r.client, &state, &plan); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error updating the LdapProvider resource - %s", state.Name.ValueString()),
@@ -783,7 +828,12 @@ func (r *LdapProviderResource) ImportState(ctx context.Context, req resource.Imp
 	tflog.Info(ctx, "Importing LdapProvider resource")
 	var state models.LdapProviderModel
 
-	ldapName := req.ID
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ldapName := parsedID.ID
 	ldapResponse, err := helper.GetLdapProvider(ctx, r.client, ldapName, "")
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -33,6 +33,7 @@ var ldapV16Mocker *Mocker
 var ldapV11Mocker *Mocker
 
 func TestAccLdapProviderResource(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	var ldapResourceName = "powerscale_ldap_provider.ldap_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -70,6 +71,7 @@ func TestAccLdapProviderResource(t *testing.T) {
 }
 
 func TestAccLdapProviderResourceErr(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	var ldapResourceName = "powerscale_ldap_provider.ldap_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -104,6 +106,7 @@ func TestAccLdapProviderResourceErr(t *testing.T) {
 }
 
 func TestAccLdapProviderResourceMockErr(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -315,6 +318,7 @@ func TestAccLdapProviderResourceMockErr(t *testing.T) {
 }
 
 func TestAccLdapProviderResourceDeleteMockErr(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -357,6 +361,7 @@ func TestAccLdapProviderResourceDeleteMockErr(t *testing.T) {
 }
 
 func TestAccLdapProviderResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	var ldapResourceName = "powerscale_ldap_provider.ldap_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -421,6 +426,7 @@ func TestAccLdapProviderResourceImportMockErr(t *testing.T) {
 }
 
 func TestAccLdapProviderResourceHelperMockErr(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	mockV16LdapProviders, mockV11LdapProviders := getMockLdapProviderConfig()
 
 	resource.Test(t, resource.TestCase{
@@ -572,6 +578,7 @@ func TestAccLdapProviderResourceHelperMockErr(t *testing.T) {
 }
 
 func TestAccLdapProviderReleaseMockResource(t *testing.T) {
+	skipIfSimulated(t, "LDAP providers are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
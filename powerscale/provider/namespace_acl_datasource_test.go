@@ -29,6 +29,7 @@ import (
 )
 
 func TestAccNamespaceAclDataSource(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	var namespaceACLTerraformName = "data.powerscale_namespace_acl.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -49,6 +50,7 @@ func TestAccNamespaceAclDataSource(t *testing.T) {
 }
 
 func TestAccNamespaceAclDataSourceMockErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -76,6 +78,7 @@ func TestAccNamespaceAclDataSourceMockErr(t *testing.T) {
 }
 
 func TestAccNamespaceAclDataSourceParamErr(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
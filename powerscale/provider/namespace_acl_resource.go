@@ -540,8 +540,13 @@ func (r *NamespaceACLResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState imports the resource state.
 func (r *NamespaceACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "namespace"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	var namespaceACLModel models.NamespaceACLResourceModel
-	namespaceACLModel.Namespace = types.StringValue(req.ID)
+	namespaceACLModel.Namespace = types.StringValue(parsedID.ID)
 
 	tflog.Debug(ctx, "calling get namespace acl")
 	namespaceACLResponse, err := helper.GetNamespaceACL(ctx, r.client, namespaceACLModel)
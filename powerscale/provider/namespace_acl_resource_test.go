@@ -26,6 +26,7 @@ import (
 )
 
 func TestAccNamespaceAclResource(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -81,6 +82,7 @@ func TestAccNamespaceAclResource(t *testing.T) {
 }
 
 func TestAccNamespaceAclResourceEmptyConfig1(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -108,6 +110,7 @@ func TestAccNamespaceAclResourceEmptyConfig1(t *testing.T) {
 }
 
 func TestAccNamespaceAclResourceEmptyConfig2(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -127,6 +130,7 @@ func TestAccNamespaceAclResourceEmptyConfig2(t *testing.T) {
 }
 
 func TestAccNamespaceAclResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -165,6 +169,7 @@ func TestAccNamespaceAclResourceErrorRead(t *testing.T) {
 }
 
 func TestAccNamespaceAclResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -233,6 +238,7 @@ func TestAccNamespaceAclResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccNamespaceAclResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -257,6 +263,7 @@ func TestAccNamespaceAclResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccNamespaceAclResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -306,6 +313,7 @@ func TestAccNamespaceAclResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccNamespaceAclResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "the namespace API is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
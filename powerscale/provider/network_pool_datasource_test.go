@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccNetworkPoolDataSourceNames(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var poolTerraformName = "data.powerscale_networkpool.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -51,6 +52,7 @@ func TestAccNetworkPoolDataSourceNames(t *testing.T) {
 }
 
 func TestAccNetworkPoolDataSourceFilter(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var poolTerraformName = "data.powerscale_networkpool.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -74,6 +76,7 @@ func TestAccNetworkPoolDataSourceFilter(t *testing.T) {
 }
 
 func TestAccNetworkPoolDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var poolTerraformName = "data.powerscale_networkpool.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -91,6 +94,7 @@ func TestAccNetworkPoolDataSourceAll(t *testing.T) {
 }
 
 func TestAccNetworkPoolDataSourceNamesErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -104,6 +108,7 @@ func TestAccNetworkPoolDataSourceNamesErr(t *testing.T) {
 }
 
 func TestAccNetworkPoolDataSourceFilterErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -117,6 +122,7 @@ func TestAccNetworkPoolDataSourceFilterErr(t *testing.T) {
 }
 
 func TestAccNetworkPoolDataSourceGettingErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -133,6 +139,7 @@ func TestAccNetworkPoolDataSourceGettingErr(t *testing.T) {
 }
 
 func TestAccNetworkPoolDataSourceMappingErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -532,17 +532,13 @@ func (r *NetworkPoolResource) Delete(ctx context.Context, req resource.DeleteReq
 
 // ImportState imports the resource state.
 func (r *NetworkPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ".")
-
-	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: groupnet_name.subnetnet_name.pool_name Got: %q", req.ID),
-		)
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name", Parents: []string{"groupnet", "subnet"}})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groupnet"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet"), idParts[1])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groupnet"), parsedID.Parents[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet"), parsedID.Parents[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parsedID.ID)...)
 }
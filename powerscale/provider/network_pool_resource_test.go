@@ -30,6 +30,7 @@ import (
 )
 
 func TestAccNetworkPoolResource(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -64,6 +65,17 @@ func TestAccNetworkPoolResource(t *testing.T) {
 					return nil
 				},
 			},
+			// ImportState testing with the JSON import identifier
+			{
+				ResourceName:  "powerscale_networkpool.pool_test",
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf(`{"groupnet":"groupnet0","subnet":"subnet0","name":"%s"}`, poolName),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, poolName, states[0].Attributes["name"])
+					assert.Equal(t, "subnet0", states[0].Attributes["subnet"])
+					return nil
+				},
+			},
 			// Update
 			{
 				Config: ProviderConfig + NetworkPoolUpdatedResourceConfig,
@@ -77,6 +89,7 @@ func TestAccNetworkPoolResource(t *testing.T) {
 }
 
 func TestAccNetworkPoolResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -114,6 +127,7 @@ func TestAccNetworkPoolResourceErrorRead(t *testing.T) {
 }
 
 func TestAccNetworkPoolResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -181,6 +195,7 @@ func TestAccNetworkPoolResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccNetworkPoolResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -194,6 +209,7 @@ func TestAccNetworkPoolResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccNetworkPoolResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -232,6 +248,7 @@ func TestAccNetworkPoolResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccNetworkPoolResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -23,6 +23,7 @@ import (
 )
 
 func TestAccRuleDatasourceGetAll(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -46,6 +47,7 @@ func TestAccRuleDatasourceGetAll(t *testing.T) {
 }
 
 func TestAccRuleDatasourceGetFilter(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -69,6 +71,7 @@ func TestAccRuleDatasourceGetFilter(t *testing.T) {
 }
 
 func TestAccRuleDatasourceGetFilterError(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
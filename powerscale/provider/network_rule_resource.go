@@ -331,18 +331,14 @@ func (r NetworkRuleResource) Delete(ctx context.Context, request resource.Delete
 // ImportState imports the resource state.
 func (r NetworkRuleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	tflog.Info(ctx, "importing network rule")
-	idParts := strings.Split(request.ID, ".")
-
-	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
-		response.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: groupnet_name.subnetnet_name.pool_name.rule_name Got: %q", request.ID),
-		)
+	parsedID, diags := helper.ParseImportID(request.ID, helper.ImportIDFormat{ID: "name", Parents: []string{"groupnet", "subnet", "pool"}})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("groupnet"), idParts[0])...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("subnet"), idParts[1])...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("pool"), idParts[2])...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), idParts[3])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("groupnet"), parsedID.Parents[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("subnet"), parsedID.Parents[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("pool"), parsedID.Parents[2])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("name"), parsedID.ID)...)
 }
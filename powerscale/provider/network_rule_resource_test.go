@@ -33,6 +33,7 @@ import (
 var ruleMocker *mockey.Mocker
 
 func TestAccRuleResource(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -71,6 +72,7 @@ func TestAccRuleResource(t *testing.T) {
 }
 
 func TestAccRuleResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -105,6 +107,7 @@ func TestAccRuleResourceErrorRead(t *testing.T) {
 }
 
 func TestAccRuleResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -121,6 +124,7 @@ func TestAccRuleResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccRuleResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -166,6 +170,7 @@ func TestAccRuleResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccRuleResourceErrorDelete(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -199,6 +204,7 @@ func TestAccRuleResourceErrorDelete(t *testing.T) {
 }
 
 func TestAccRuleResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
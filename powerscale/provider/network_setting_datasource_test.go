@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccNetworkSettingDataSource(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var settingTerraformName = "data.powerscale_network_settings.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -46,6 +47,7 @@ func TestAccNetworkSettingDataSource(t *testing.T) {
 }
 
 func TestAccNetworkSettingDatasourceErrorGetAll(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
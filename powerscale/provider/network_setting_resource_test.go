@@ -36,6 +36,7 @@ var networkSettingGetMocker *Mocker
 var networkSettingMocker *Mocker
 
 func TestAccNetworkSettingResourceImport(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var networkSettingResourceName = "powerscale_network_settings.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -79,6 +80,7 @@ func TestAccNetworkSettingResourceImport(t *testing.T) {
 }
 
 func TestAccNetworkSettingResourceErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -104,6 +106,7 @@ func TestAccNetworkSettingResourceErr(t *testing.T) {
 }
 
 func TestAccNetworkSettingResourceImportMockErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	var networkSettingResourceName = "powerscale_network_settings.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -142,6 +145,7 @@ func TestAccNetworkSettingResourceImportMockErr(t *testing.T) {
 }
 
 func TestAccNetworkSettingResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -184,6 +188,7 @@ func TestAccNetworkSettingResourceCreateMockErr(t *testing.T) {
 }
 
 func TestAccNetworkSettingResourceUpdateMockErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -225,6 +230,7 @@ func TestAccNetworkSettingResourceUpdateMockErr(t *testing.T) {
 }
 
 func TestAccNetworkSettingResourceHelperMockErr(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -262,6 +268,7 @@ func TestAccNetworkSettingResourceHelperMockErr(t *testing.T) {
 }
 
 func TestAccNetworkSettingReleaseMockResource(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -230,9 +230,13 @@ func (r *NfsAliasResource) Delete(ctx context.Context, req resource.DeleteReques
 
 // ImportState imports the resource state.
 func (r *NfsAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if req.ID == "" {
-		resp.Diagnostics.AddError("Please provide valid nfs alias ID", "Please provide valid nfs alias ID")
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name", Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parsedID.ID)...)
+	if parsedID.Zone != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), parsedID.Zone)...)
+	}
 }
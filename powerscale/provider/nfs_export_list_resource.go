@@ -0,0 +1,138 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &NfsExportListResource{}
+	_ list.ListResourceWithConfigure = &NfsExportListResource{}
+)
+
+// NewNfsExportListResource returns the NFS export list resource object.
+func NewNfsExportListResource() list.ListResource {
+	return &NfsExportListResource{}
+}
+
+// NfsExportListResource defines the list resource implementation.
+type NfsExportListResource struct {
+	client *client.Client
+}
+
+// Configure configures the list resource.
+func (r *NfsExportListResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the list resource arguments.
+func (r *NfsExportListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nfs_export"
+}
+
+// ListResourceConfigSchema describes the list resource arguments.
+func (r *NfsExportListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource is used to discover the NFS exports of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Description:         "This list resource is used to discover the NFS exports of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The access zone of the NFS exports. Defaults to the System zone.",
+				Description:         "The access zone of the NFS exports. Defaults to the System zone.",
+			},
+			"paths": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Paths of the NFS exports to list. An export is listed when it exports any of the paths. All the NFS exports of the zone are listed by default.",
+				Description:         "Paths of the NFS exports to list. An export is listed when it exports any of the paths. All the NFS exports of the zone are listed by default.",
+			},
+		},
+	}
+}
+
+// List lists the NFS exports.
+func (r *NfsExportListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.NfsExportListModel
+	var diags diag.Diagnostics
+	// the config block is optional
+	if !req.Config.Raw.IsNull() {
+		diags = req.Config.Get(ctx, &config)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	exports, err := helper.ListNFSExports(ctx, r.client, &models.NfsExportDatasourceFilter{Zone: config.Zone})
+	if err == nil {
+		*exports, err = helper.FilterExports(config.Paths, nil, *exports)
+	}
+	if err != nil {
+		diags.AddError("Error listing nfs exports", helper.GetErrorString(err, constants.ListNfsExportErrorMsg+"with error: "))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, export := range *exports {
+			result := req.NewListResult(ctx)
+			result.DisplayName = strings.Join(export.Paths, ", ")
+
+			var model models.NfsExportResource
+			if err := helper.CopyFieldsToNonNestedModel(ctx, export, &model); err != nil {
+				result.Diagnostics.AddError("Error listing nfs exports",
+					fmt.Sprintf("Could not set state for export %d with error: %s", export.GetId(), err.Error()))
+				push(result)
+				return
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.NfsExportIdentityModel{ID: model.ID, Zone: model.Zone})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
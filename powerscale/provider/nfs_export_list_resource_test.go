@@ -0,0 +1,78 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNfsExportListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Create the NFS export to list
+			{
+				Config: ProviderConfig + NFSExportResourceConfig,
+			},
+			// Query testing
+			{
+				Query:  true,
+				Config: ProviderConfig + nfsExportListResourceConfig,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("powerscale_nfs_export.test", 1),
+					querycheck.ExpectIdentity("powerscale_nfs_export.test", map[string]knownvalue.Check{
+						"id":   knownvalue.NotNull(),
+						"zone": knownvalue.StringExact("System"),
+					}),
+				},
+			},
+			// Query error testing
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListNFSExports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Query:       true,
+				Config:      ProviderConfig + nfsExportListResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+		},
+	})
+}
+
+var nfsExportListResourceConfig = `
+list "powerscale_nfs_export" "test" {
+	provider = powerscale
+	config {
+		zone  = "System"
+		paths = ["/ifs/tfacc_nfs_export"]
+	}
+}
+`
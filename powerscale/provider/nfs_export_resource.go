@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -35,6 +36,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NfsExportResource{}
 var _ resource.ResourceWithImportState = &NfsExportResource{}
+var _ resource.ResourceWithIdentity = &NfsExportResource{}
 
 // NewNfsExportResource creates a new resource.
 func NewNfsExportResource() resource.Resource {
@@ -51,6 +53,22 @@ func (r NfsExportResource) Metadata(ctx context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_nfs_export"
 }
 
+// IdentitySchema describes the attributes identifying the NFS export.
+func (r NfsExportResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "The ID of the NFS export.",
+			},
+			"zone": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The access zone of the NFS export. Defaults to the System zone.",
+			},
+		},
+	}
+}
+
 // Schema describes the resource arguments.
 func (r *NfsExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -834,6 +852,7 @@ func (r NfsExportResource) Create(ctx context.Context, request resource.CreateRe
 	helper.ResolvePersonaDiff(ctx, exportPlanBackUp, &exportPlan)
 	diags = response.State.Set(ctx, exportPlan)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.NfsExportIdentityModel{ID: exportPlan.ID, Zone: exportPlan.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -889,6 +908,7 @@ func (r NfsExportResource) Read(ctx context.Context, request resource.ReadReques
 	helper.ResolvePersonaDiff(ctx, exportStateBackUp, &exportState)
 	diags = response.State.Set(ctx, exportState)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.NfsExportIdentityModel{ID: exportState.ID, Zone: exportState.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -960,6 +980,7 @@ func (r NfsExportResource) Update(ctx context.Context, request resource.UpdateRe
 	helper.ResolvePersonaDiff(ctx, exportPlan, &exportState)
 	diags = response.State.Set(ctx, exportState)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.NfsExportIdentityModel{ID: exportState.ID, Zone: exportState.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -994,14 +1015,22 @@ func (r NfsExportResource) Delete(ctx context.Context, request resource.DeleteRe
 
 // ImportState imports the resource state.
 func (r NfsExportResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	var zoneName string
-	exportID := request.ID
-	// request.ID is form of zoneName:exportID
-	if strings.Contains(request.ID, ":") {
-		params := strings.Split(request.ID, ":")
-		exportID = strings.Trim(params[1], " ")
-		zoneName = strings.Trim(params[0], " ")
+	importID := request.ID
+	// an import by identity is converted to the zoneName:ID form
+	if importID == "" && request.Identity != nil {
+		var identity models.NfsExportIdentityModel
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		importID = fmt.Sprintf("%s:%d", identity.Zone.ValueString(), identity.ID.ValueInt64())
+	}
+	parsedID, diags := helper.ParseImportID(importID, helper.ImportIDFormat{ID: "id", Zoned: true})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
+	exportID, zoneName := parsedID.ID, parsedID.Zone
 
 	readNfsExport, err := helper.GetNFSExportByID(ctx, r.client, exportID, zoneName)
 	if err != nil {
@@ -1014,7 +1043,7 @@ func (r NfsExportResource) ImportState(ctx context.Context, request resource.Imp
 	if len(readNfsExport.Exports) <= 0 {
 		response.Diagnostics.AddError(
 			"Error reading nfs export",
-			fmt.Sprintf("Could not read nfs export %s from pscale with error: nfs export not found", request.ID),
+			fmt.Sprintf("Could not read nfs export %s from pscale with error: nfs export not found", importID),
 		)
 		return
 	}
@@ -1024,7 +1053,7 @@ func (r NfsExportResource) ImportState(ctx context.Context, request resource.Imp
 		response.Diagnostics.AddError(
 			"Error reading nfs export",
 			fmt.Sprintf("Could not set state for export %s with error: %s ",
-				request.ID, err.Error()),
+				importID, err.Error()),
 		)
 		return
 	}
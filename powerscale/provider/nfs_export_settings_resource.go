@@ -1101,7 +1101,12 @@ func (r *NfsExportSettingsResource) Delete(ctx context.Context, req resource.Del
 func (r *NfsExportSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing Nfs Export Settings resource")
 
-	zoneName := req.ID
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	zoneName := parsedID.Zone
 
 	var state models.NfsexportsettingsModel
 	settings, err := helper.GetNfsExportSettingsByZone(ctx, r.client, zoneName)
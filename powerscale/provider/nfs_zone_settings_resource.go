@@ -336,8 +336,12 @@ func (r *NfsZoneSettingsResource) Delete(ctx context.Context, req resource.Delet
 func (r *NfsZoneSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Started importing nfs zone settings")
 
-	reqID := req.ID
-	zone := strings.TrimSpace(reqID)
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	zone := parsedID.Zone
 
 	settings, err := helper.GetNfsZoneSettings(ctx, r.client, zone)
 	if err != nil {
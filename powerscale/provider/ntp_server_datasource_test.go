@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccNtpServerDataSourceNames(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	var ntpServerTerraformName = "data.powerscale_ntpserver.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -48,6 +49,7 @@ func TestAccNtpServerDataSourceNames(t *testing.T) {
 }
 
 func TestAccNtpServerDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	var ntpServerTerraformName = "data.powerscale_ntpserver.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -65,6 +67,7 @@ func TestAccNtpServerDataSourceAll(t *testing.T) {
 }
 
 func TestAccNtpServerDataSourceNamesErr(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -78,6 +81,7 @@ func TestAccNtpServerDataSourceNamesErr(t *testing.T) {
 }
 
 func TestAccNtpServerDataSourceFilterErr(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -91,6 +95,7 @@ func TestAccNtpServerDataSourceFilterErr(t *testing.T) {
 }
 
 func TestAccNtpServerDataSourceGettingErr(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -107,6 +112,7 @@ func TestAccNtpServerDataSourceGettingErr(t *testing.T) {
 }
 
 func TestAccNtpServerDataSourceMappingErr(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
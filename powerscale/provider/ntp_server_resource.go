@@ -367,5 +367,10 @@ func (r *NtpServerResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState imports the resource state.
 func (r *NtpServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parsedID.ID)...)
 }
@@ -29,6 +29,7 @@ import (
 )
 
 func TestAccNtpServerResource(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -64,6 +65,7 @@ func TestAccNtpServerResource(t *testing.T) {
 }
 
 func TestAccNtpServerResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -100,6 +102,7 @@ func TestAccNtpServerResourceErrorRead(t *testing.T) {
 }
 
 func TestAccNtpServerResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -162,6 +165,7 @@ func TestAccNtpServerResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccNtpServerResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -178,6 +182,7 @@ func TestAccNtpServerResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccNtpServerResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -215,6 +220,7 @@ func TestAccNtpServerResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccNtpServerResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
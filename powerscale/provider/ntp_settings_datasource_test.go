@@ -30,6 +30,7 @@ import (
 )
 
 func TestAccNtpSettingsDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	var ntpServerTerraformName = "data.powerscale_ntpsettings.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -47,6 +48,7 @@ func TestAccNtpSettingsDataSourceAll(t *testing.T) {
 }
 
 func TestAccNtpSettingsDataSourceGettingErr(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -66,6 +68,7 @@ func TestAccNtpSettingsDataSourceGettingErr(t *testing.T) {
 }
 
 func TestAccNtpSettingsDataSourceMappingErr(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -27,6 +27,7 @@ import (
 )
 
 func TestAccNtpSettingsResource(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -59,6 +60,7 @@ func TestAccNtpSettingsResource(t *testing.T) {
 }
 
 func TestAccNtpSettingsResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -84,6 +86,7 @@ func TestAccNtpSettingsResourceErrorRead(t *testing.T) {
 }
 
 func TestAccNtpSettingsResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -129,6 +132,7 @@ func TestAccNtpSettingsResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccNtpSettingsResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -145,6 +149,7 @@ func TestAccNtpSettingsResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccNtpSettingsResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -181,6 +186,7 @@ func TestAccNtpSettingsResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccNtpSettingsResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "the NTP configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &ParseSizeFunction{}
+
+// ParseSizeFunction converts a human-readable size to bytes.
+type ParseSizeFunction struct{}
+
+// NewParseSizeFunction creates a new parse_size function.
+func NewParseSizeFunction() function.Function {
+	return &ParseSizeFunction{}
+}
+
+// Metadata describes the function.
+func (f *ParseSizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_size"
+}
+
+// Definition describes the parameters and return value of the function.
+func (f *ParseSizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a human-readable size to bytes.",
+		Description: "Converts a human-readable size such as 10G, 500 MB or 1.5TiB to a number of bytes, e.g. for quota thresholds. " +
+			"As in OneFS, units are powers of 1024 whether written K, KB or KiB, and a size without unit is in bytes.",
+		MarkdownDescription: "Converts a human-readable size such as `10G`, `500 MB` or `1.5TiB` to a number of bytes, e.g. for quota thresholds. " +
+			"As in OneFS, units are powers of 1024 whether written `K`, `KB` or `KiB`, and a size without unit is in bytes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "size",
+				Description:         "The size, a number optionally followed by one of the units K, M, G, T, P or E.",
+				MarkdownDescription: "The size, a number optionally followed by one of the units `K`, `M`, `G`, `T`, `P` or `E`.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run converts the size.
+func (f *ParseSizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var size string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &size))
+	if resp.Error != nil {
+		return
+	}
+	bytes, err := helper.ParseSize(size)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, bytes))
+}
@@ -0,0 +1,79 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"terraform-provider-powerscale/powerscale/helper"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &PersonaFunction{}
+
+// PersonaFunction builds persona identifiers.
+type PersonaFunction struct{}
+
+// NewPersonaFunction creates a new persona function.
+func NewPersonaFunction() function.Function {
+	return &PersonaFunction{}
+}
+
+// Metadata describes the function.
+func (f *PersonaFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "persona"
+}
+
+// Definition describes the parameters and return value of the function.
+func (f *PersonaFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the persona identifier of a user or group.",
+		Description: "Builds the persona identifier used by OneFS for a user or group, e.g. UID:1000, GID:1000, SID:S-1-5-32-544, USER:admin or GROUP:Administrators. " +
+			"UIDs and GIDs must be numbers between 0 and 4294967295, and SIDs must be security identifiers starting with S-1.",
+		MarkdownDescription: "Builds the persona identifier used by OneFS for a user or group, e.g. `UID:1000`, `GID:1000`, `SID:S-1-5-32-544`, `USER:admin` or `GROUP:Administrators`. " +
+			"UIDs and GIDs must be numbers between 0 and 4294967295, and SIDs must be security identifiers starting with `S-1`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				Description:         "The type of the identifier, one of uid, gid, sid, user or group, in any case.",
+				MarkdownDescription: "The type of the identifier, one of `uid`, `gid`, `sid`, `user` or `group`, in any case.",
+			},
+			function.StringParameter{
+				Name:        "value",
+				Description: "The UID, GID, SID or name of the user or group.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the persona identifier.
+func (f *PersonaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kind, value string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &kind, &value))
+	if resp.Error != nil {
+		return
+	}
+	persona, err := helper.FormatPersona(kind, value)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, persona))
+}
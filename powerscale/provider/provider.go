@@ -20,24 +20,34 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/helper"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure PscaleProvider satisfies various provider interfaces.
 var _ provider.Provider = &PscaleProvider{}
+var _ provider.ProviderWithFunctions = &PscaleProvider{}
+var _ provider.ProviderWithEphemeralResources = &PscaleProvider{}
+var _ provider.ProviderWithListResources = &PscaleProvider{}
 
 // PscaleProvider defines the provider implementation.
 type PscaleProvider struct {
@@ -53,12 +63,29 @@ type PscaleProvider struct {
 
 // Data describes the provider data model.
 type Data struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
-	Insecure types.Bool   `tfsdk:"insecure"`
-	AuthType types.Int64  `tfsdk:"auth_type"`
-	Timeout  types.Int64  `tfsdk:"timeout"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	Insecure     types.Bool   `tfsdk:"insecure"`
+	AuthType     types.Int64  `tfsdk:"auth_type"`
+	Timeout      types.Int64  `tfsdk:"timeout"`
+	ConfigFile   types.String `tfsdk:"config_file"`
+	Profile      types.String `tfsdk:"profile"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
+
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int64 `tfsdk:"requests_per_second"`
+	ReadCacheTTL          types.Int64 `tfsdk:"read_cache_ttl"`
+
+	CACertificate          types.String `tfsdk:"ca_certificate"`
+	CertificateFingerprint types.String `tfsdk:"certificate_fingerprint"`
+	TLSServerName          types.String `tfsdk:"tls_server_name"`
+	ClientCertificate      types.String `tfsdk:"client_certificate"`
+	ClientKey              types.String `tfsdk:"client_key"`
+
+	FallbackEndpoints types.List `tfsdk:"fallback_endpoints"`
+	TraceHTTP         types.Bool `tfsdk:"trace_http"`
 }
 
 // Metadata describes the provider arguments.
@@ -74,45 +101,157 @@ func (p *PscaleProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		Description:         "The Terraform provider for Dell PowerScale can be used to interact with a Dell PowerScale array in order to manage the array resources.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "The API endpoint, ex. https://172.17.177.230:8080",
-				Description:         "The API endpoint, ex. https://172.17.177.230:8080",
-				Required:            true,
+				MarkdownDescription: "The API endpoint, ex. https://172.17.177.230:8080. Can also be set with the `POWERSCALE_ENDPOINT` environment variable or the `endpoint` key of a profile.",
+				Description:         "The API endpoint, ex. https://172.17.177.230:8080. Can also be set with the POWERSCALE_ENDPOINT environment variable or the endpoint key of a profile.",
+				Optional:            true,
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "The username",
-				Description:         "The username",
-				Required:            true,
+				MarkdownDescription: "The username. Can also be set with the `POWERSCALE_USERNAME` environment variable or the `username` key of a profile.",
+				Description:         "The username. Can also be set with the POWERSCALE_USERNAME environment variable or the username key of a profile.",
+				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password",
-				Description:         "The password",
-				Required:            true,
+				MarkdownDescription: "The password. Can also be set with the `POWERSCALE_PASSWORD` environment variable or the `password` key of a profile.",
+				Description:         "The password. Can also be set with the POWERSCALE_PASSWORD environment variable or the password key of a profile.",
+				Optional:            true,
 				Sensitive:           true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
 			"insecure": schema.BoolAttribute{
-				MarkdownDescription: "whether to skip SSL validation",
-				Description:         "whether to skip SSL validation",
-				Required:            true,
+				MarkdownDescription: "whether to skip SSL validation. Defaults to `false`. Can also be set with the `POWERSCALE_INSECURE` environment variable or the `insecure` key of a profile.",
+				Description:         "whether to skip SSL validation. Defaults to false. Can also be set with the POWERSCALE_INSECURE environment variable or the insecure key of a profile.",
+				Optional:            true,
 			},
 			"auth_type": schema.Int64Attribute{
-				MarkdownDescription: "what should be the auth type, 0 for basic and 1 for session-based",
-				Description:         "what should be the auth type, 0 for basic and 1 for session-based",
+				MarkdownDescription: "what should be the auth type, 0 for basic and 1 for session-based. Defaults to `1`. Can also be set with the `POWERSCALE_AUTH_TYPE` environment variable or the `auth_type` key of a profile.",
+				Description:         "what should be the auth type, 0 for basic and 1 for session-based. Defaults to 1. Can also be set with the POWERSCALE_AUTH_TYPE environment variable or the auth_type key of a profile.",
 				Optional:            true,
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
 			"timeout": schema.Int64Attribute{
-				MarkdownDescription: "specifies a time limit for requests",
-				Description:         "specifies a time limit for requests",
+				MarkdownDescription: "specifies a time limit for requests. Defaults to `2000`. Can also be set with the `POWERSCALE_TIMEOUT` environment variable or the `timeout` key of a profile.",
+				Description:         "specifies a time limit for requests. Defaults to 2000. Can also be set with the POWERSCALE_TIMEOUT environment variable or the timeout key of a profile.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a request failing with a transient error (connection reset, 429, 502, 503 or 504) is retried. Set to `0` to disable retries. Defaults to `3`. Can also be set with the `POWERSCALE_MAX_RETRIES` environment variable or the `max_retries` key of a profile.",
+				Description:         "Maximum number of times a request failing with a transient error (connection reset, 429, 502, 503 or 504) is retried. Set to 0 to disable retries. Defaults to 3. Can also be set with the POWERSCALE_MAX_RETRIES environment variable or the max_retries key of a profile.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 20),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum wait in seconds between two attempts of a retried request. The wait grows exponentially with jitter up to this value. Defaults to `30`. Can also be set with the `POWERSCALE_RETRY_MAX_WAIT` environment variable or the `retry_max_wait` key of a profile.",
+				Description:         "Maximum wait in seconds between two attempts of a retried request. The wait grows exponentially with jitter up to this value. Defaults to 30. Can also be set with the POWERSCALE_RETRY_MAX_WAIT environment variable or the retry_max_wait key of a profile.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to the cluster at the same time, shared by all the resources and data sources using the provider. Further requests wait in a queue, and the wait is logged at DEBUG level. Not limited when not set. Can also be set with the `POWERSCALE_MAX_CONCURRENT_REQUESTS` environment variable or the `max_concurrent_requests` key of a profile.",
+				Description:         "Maximum number of requests sent to the cluster at the same time, shared by all the resources and data sources using the provider. Further requests wait in a queue, and the wait is logged at DEBUG level. Not limited when not set. Can also be set with the POWERSCALE_MAX_CONCURRENT_REQUESTS environment variable or the max_concurrent_requests key of a profile.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to the cluster per second, spaced evenly, shared by all the resources and data sources using the provider. Useful when applying many resources at once trips the PAPI throttling of smaller clusters. Not limited when not set. Can also be set with the `POWERSCALE_REQUESTS_PER_SECOND` environment variable or the `requests_per_second` key of a profile.",
+				Description:         "Maximum number of requests sent to the cluster per second, spaced evenly, shared by all the resources and data sources using the provider. Useful when applying many resources at once trips the PAPI throttling of smaller clusters. Not limited when not set. Can also be set with the POWERSCALE_REQUESTS_PER_SECOND environment variable or the requests_per_second key of a profile.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"read_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds the results of cluster-wide lists shared by many resources, such as roles and access zones, are reused during a run. Any change made by the provider clears them. Set to `0` to disable the cache. Defaults to `30`. Can also be set with the `POWERSCALE_READ_CACHE_TTL` environment variable or the `read_cache_ttl` key of a profile.",
+				Description:         "Number of seconds the results of cluster-wide lists shared by many resources, such as roles and access zones, are reused during a run. Any change made by the provider clears them. Set to 0 to disable the cache. Defaults to 30. Can also be set with the POWERSCALE_READ_CACHE_TTL environment variable or the read_cache_ttl key of a profile.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate bundle, or the path of a PEM file, trusted in addition to the system certificates to verify the cluster certificate. Ignored when `insecure` is `true`. Can also be set with the `POWERSCALE_CA_CERTIFICATE` environment variable or the `ca_certificate` key of a profile.",
+				Description:         "PEM encoded CA certificate bundle, or the path of a PEM file, trusted in addition to the system certificates to verify the cluster certificate. Ignored when insecure is true. Can also be set with the POWERSCALE_CA_CERTIFICATE environment variable or the ca_certificate key of a profile.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"certificate_fingerprint": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 fingerprint of the cluster certificate, in hex with or without colons. When set, the connection is only accepted if the certificate matches the fingerprint, and the CA chain is not verified. Ignored when `insecure` is `true`. Can also be set with the `POWERSCALE_CERTIFICATE_FINGERPRINT` environment variable or the `certificate_fingerprint` key of a profile.",
+				Description:         "SHA-256 fingerprint of the cluster certificate, in hex with or without colons. When set, the connection is only accepted if the certificate matches the fingerprint, and the CA chain is not verified. Ignored when insecure is true. Can also be set with the POWERSCALE_CERTIFICATE_FINGERPRINT environment variable or the certificate_fingerprint key of a profile.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(?i)(sha256:)?([0-9a-f]{2}:?){31}[0-9a-f]{2}$`), "must be a hex encoded SHA-256 fingerprint"),
+				},
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "Host name used to verify the cluster certificate, e.g. when the endpoint is an IP address not listed in the certificate. Can also be set with the `POWERSCALE_TLS_SERVER_NAME` environment variable or the `tls_server_name` key of a profile.",
+				Description:         "Host name used to verify the cluster certificate, e.g. when the endpoint is an IP address not listed in the certificate. Can also be set with the POWERSCALE_TLS_SERVER_NAME environment variable or the tls_server_name key of a profile.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or the path of a PEM file, presented to the cluster for mutual TLS. Requires `client_key`. Can also be set with the `POWERSCALE_CLIENT_CERTIFICATE` environment variable or the `client_certificate` key of a profile.",
+				Description:         "PEM encoded client certificate, or the path of a PEM file, presented to the cluster for mutual TLS. Requires client_key. Can also be set with the POWERSCALE_CLIENT_CERTIFICATE environment variable or the client_certificate key of a profile.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_certificate`, or the path of a PEM file. Can also be set with the `POWERSCALE_CLIENT_KEY` environment variable or the `client_key` key of a profile.",
+				Description:         "PEM encoded private key of client_certificate, or the path of a PEM file. Can also be set with the POWERSCALE_CLIENT_KEY environment variable or the client_key key of a profile.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"fallback_endpoints": schema.ListAttribute{
+				MarkdownDescription: "Other endpoints of the same cluster, e.g. node IPs behind the SmartConnect name of `endpoint`, in the form `https://10.10.10.10:8080`. Requests fail over to them, in order, when the active endpoint is unavailable, e.g. during a node reboot. All endpoints must belong to the same cluster. Can also be set with the `POWERSCALE_FALLBACK_ENDPOINTS` environment variable or the `fallback_endpoints` key of a profile, as a comma separated list.",
+				Description:         "Other endpoints of the same cluster, e.g. node IPs behind the SmartConnect name of endpoint, in the form https://10.10.10.10:8080. Requests fail over to them, in order, when the active endpoint is unavailable, e.g. during a node reboot. All endpoints must belong to the same cluster. Can also be set with the POWERSCALE_FALLBACK_ENDPOINTS environment variable or the fallback_endpoints key of a profile, as a comma separated list.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"trace_http": schema.BoolAttribute{
+				MarkdownDescription: "Log every request sent to the cluster, with its status, latency and JSON bodies, for troubleshooting. Passwords, session cookies, CSRF tokens and secret fields such as S3 secret keys are redacted. Traces are written at DEBUG level to the `http` subsystem, whose level can be set with `TF_LOG_PROVIDER_POWERSCALE_HTTP`. Defaults to `false`. Can also be set with the `POWERSCALE_TRACE_HTTP` environment variable or the `trace_http` key of a profile.",
+				Description:         "Log every request sent to the cluster, with its status, latency and JSON bodies, for troubleshooting. Passwords, session cookies, CSRF tokens and secret fields such as S3 secret keys are redacted. Traces are written at DEBUG level to the http subsystem, whose level can be set with TF_LOG_PROVIDER_POWERSCALE_HTTP. Defaults to false. Can also be set with the POWERSCALE_TRACE_HTTP environment variable or the trace_http key of a profile.",
 				Optional:            true,
 			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a credentials file holding named profiles of provider settings. Defaults to `~/.powerscale/credentials`. Can also be set with the `POWERSCALE_CONFIG_FILE` environment variable.",
+				Description:         "Path of a credentials file holding named profiles of provider settings. Defaults to ~/.powerscale/credentials. Can also be set with the POWERSCALE_CONFIG_FILE environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile to read from the credentials file. Defaults to `default`. Can also be set with the `POWERSCALE_PROFILE` environment variable.",
+				Description:         "Name of the profile to read from the credentials file. Defaults to default. Can also be set with the POWERSCALE_PROFILE environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -127,22 +266,123 @@ func (p *PscaleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Attributes not set in the configuration fall back to the environment, then to the selected profile.
+	resolver := newConfigResolver(data.ConfigFile, data.Profile)
+	data.Endpoint = resolver.String("endpoint", EnvEndpoint, data.Endpoint)
+	data.Username = resolver.String("username", EnvUsername, data.Username)
+	data.Password = resolver.String("password", EnvPassword, data.Password)
+	data.Insecure = resolver.Bool("insecure", EnvInsecure, data.Insecure)
+	data.AuthType = resolver.Int64("auth_type", EnvAuthType, data.AuthType)
+	data.Timeout = resolver.Int64("timeout", EnvTimeout, data.Timeout)
+	data.MaxRetries = resolver.Int64("max_retries", EnvMaxRetries, data.MaxRetries)
+	data.RetryMaxWait = resolver.Int64("retry_max_wait", EnvRetryMaxWait, data.RetryMaxWait)
+	data.MaxConcurrentRequests = resolver.Int64("max_concurrent_requests", EnvMaxConcurrentRequests, data.MaxConcurrentRequests)
+	data.RequestsPerSecond = resolver.Int64("requests_per_second", EnvRequestsPerSecond, data.RequestsPerSecond)
+	data.ReadCacheTTL = resolver.Int64("read_cache_ttl", EnvReadCacheTTL, data.ReadCacheTTL)
+	data.CACertificate = resolver.String("ca_certificate", EnvCACertificate, data.CACertificate)
+	data.CertificateFingerprint = resolver.String("certificate_fingerprint", EnvCertificateFingerprint, data.CertificateFingerprint)
+	data.TLSServerName = resolver.String("tls_server_name", EnvTLSServerName, data.TLSServerName)
+	data.ClientCertificate = resolver.String("client_certificate", EnvClientCertificate, data.ClientCertificate)
+	data.ClientKey = resolver.String("client_key", EnvClientKey, data.ClientKey)
+	data.FallbackEndpoints = resolver.StringList("fallback_endpoints", EnvFallbackEndpoints, data.FallbackEndpoints)
+	data.TraceHTTP = resolver.Bool("trace_http", EnvTraceHTTP, data.TraceHTTP)
+	resp.Diagnostics.Append(resolver.diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resolver.Require("endpoint", EnvEndpoint, data.Endpoint.IsNull())...)
+	resp.Diagnostics.Append(resolver.Require("username", EnvUsername, data.Username.IsNull())...)
+	resp.Diagnostics.Append(resolver.Require("password", EnvPassword, data.Password.IsNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// if insecure is not set, verify the server certificate
+	if data.Insecure.IsNull() {
+		data.Insecure = types.BoolValue(false)
+		resolver.Default("insecure")
+	}
 	// if timeout is not set. use default value 2000
-	if data.Timeout.IsNull() || data.Timeout.IsUnknown() {
+	if data.Timeout.IsNull() {
 		data.Timeout = types.Int64Value(2000)
+		resolver.Default("timeout")
 	}
 	// If auth type is not set, use session based auth by default
-	if data.AuthType.IsNull() || data.AuthType.IsUnknown() {
+	if data.AuthType.IsNull() {
 		data.AuthType = types.Int64Value(1)
+		resolver.Default("auth_type")
+	}
+	if authType := data.AuthType.ValueInt64(); authType != client.BasicAuthType && authType != client.SessionAuthType {
+		resp.Diagnostics.AddAttributeError(path.Root("auth_type"), "Invalid PowerScale provider attribute auth_type",
+			fmt.Sprintf("auth_type must be 0 or 1, got %d from %s.", authType, resolver.sources["auth_type"]))
+		return
 	}
+	// if retries are not set, use the client defaults
+	if data.MaxRetries.IsNull() {
+		data.MaxRetries = types.Int64Value(client.DefaultMaxRetries)
+		resolver.Default("max_retries")
+	}
+	if data.RetryMaxWait.IsNull() {
+		data.RetryMaxWait = types.Int64Value(int64(client.DefaultRetryMaxWait / time.Second))
+		resolver.Default("retry_max_wait")
+	}
+	if data.MaxRetries.ValueInt64() < 0 || data.RetryMaxWait.ValueInt64() < 1 {
+		resp.Diagnostics.AddError("Invalid PowerScale provider retry settings",
+			fmt.Sprintf("max_retries must be at least 0 (got %d from %s) and retry_max_wait at least 1 (got %d from %s).",
+				data.MaxRetries.ValueInt64(), resolver.sources["max_retries"], data.RetryMaxWait.ValueInt64(), resolver.sources["retry_max_wait"]))
+		return
+	}
+	if data.ReadCacheTTL.IsNull() {
+		data.ReadCacheTTL = types.Int64Value(int64(client.DefaultReadCacheTTL / time.Second))
+		resolver.Default("read_cache_ttl")
+	}
+	if data.ReadCacheTTL.ValueInt64() < 0 {
+		resp.Diagnostics.AddError("Invalid PowerScale provider attribute read_cache_ttl",
+			fmt.Sprintf("read_cache_ttl must be at least 0, got %d from %s.", data.ReadCacheTTL.ValueInt64(), resolver.sources["read_cache_ttl"]))
+		return
+	}
+	if data.MaxConcurrentRequests.ValueInt64() < 0 || data.RequestsPerSecond.ValueInt64() < 0 {
+		resp.Diagnostics.AddError("Invalid PowerScale provider rate limits",
+			fmt.Sprintf("max_concurrent_requests (got %d from %s) and requests_per_second (got %d from %s) must be at least 1.",
+				data.MaxConcurrentRequests.ValueInt64(), resolver.sources["max_concurrent_requests"],
+				data.RequestsPerSecond.ValueInt64(), resolver.sources["requests_per_second"]))
+		return
+	}
+	if data.Insecure.ValueBool() && (!data.CACertificate.IsNull() || !data.CertificateFingerprint.IsNull()) {
+		resp.Diagnostics.AddAttributeWarning(path.Root("insecure"), "PowerScale certificate verification is disabled",
+			"ca_certificate and certificate_fingerprint are ignored because insecure is true.")
+	}
+	var fallbackEndpoints []string
+	if !data.FallbackEndpoints.IsNull() {
+		resp.Diagnostics.Append(data.FallbackEndpoints.ElementsAs(ctx, &fallbackEndpoints, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	tflog.Info(ctx, "Resolved PowerScale provider configuration sources", resolver.Sources())
+
 	// Configuration values are now available.
 	pscaleClient, err := client.NewClient(
+		ctx,
 		data.Endpoint.ValueString(),
 		data.Insecure.ValueBool(),
 		data.Username.ValueString(),
 		data.Password.ValueString(),
 		data.AuthType.ValueInt64(),
 		data.Timeout.ValueInt64(),
+		client.WithRetry(int(data.MaxRetries.ValueInt64()), time.Duration(data.RetryMaxWait.ValueInt64())*time.Second),
+		client.WithTLS(client.TLSOptions{
+			CACertificate:          data.CACertificate.ValueString(),
+			CertificateFingerprint: data.CertificateFingerprint.ValueString(),
+			ServerName:             data.TLSServerName.ValueString(),
+			ClientCertificate:      data.ClientCertificate.ValueString(),
+			ClientKey:              data.ClientKey.ValueString(),
+		}),
+		client.WithFallbackEndpoints(fallbackEndpoints),
+		client.WithTrace(data.TraceHTTP.ValueBool()),
+		client.WithRateLimit(int(data.MaxConcurrentRequests.ValueInt64()), float64(data.RequestsPerSecond.ValueInt64())),
+		client.WithReadCache(time.Duration(data.ReadCacheTTL.ValueInt64())*time.Second),
 	)
 
 	if err != nil {
@@ -154,9 +394,11 @@ func (p *PscaleProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// client configuration for data sources and resources
+	// client configuration for data sources, resources, ephemeral resources and list resources
 	resp.DataSourceData = pscaleClient
 	resp.ResourceData = pscaleClient
+	resp.EphemeralResourceData = pscaleClient
+	resp.ListResourceData = pscaleClient
 }
 
 // Resources describes the provider resources.
@@ -208,6 +450,18 @@ func (p *PscaleProvider) Resources(ctx context.Context) []func() resource.Resour
 		NewWriteableSnapshotResource,
 		NewSnapshotRestoreResource,
 		NewNfsAliasResource,
+		NewAPIObjectResource,
+		NewJobResource,
+		NewJobPolicyResource,
+		NewJobTypeSettingsResource,
+		NewWormDomainResource,
+		NewAuditGlobalSettingsResource,
+		NewAuditZoneSettingsResource,
+		NewEventChannelResource,
+		NewEventAlertConditionResource,
+		NewQuotaNotificationResource,
+		NewQuotaSettingsResource,
+		NewQuotaReportResource,
 	}
 }
 
@@ -254,6 +508,44 @@ func (p *PscaleProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewNfsAliasDataSource,
 		NewWritableSnapshotDataSource,
 		NewSyncIQReplicationJobDataSource,
+		NewAPIRequestDataSource,
+		NewJobDataSource,
+		NewJobTypeDataSource,
+		NewJobReportDataSource,
+		NewWormDomainDataSource,
+		NewAuditTopicDataSource,
+		NewEventGroupDataSource,
+		NewQuotaReportDataSource,
+	}
+}
+
+// EphemeralResources describes the provider ephemeral resources.
+func (p *PscaleProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewS3KeyEphemeralResource,
+		NewSessionEphemeralResource,
+	}
+}
+
+// ListResources describes the provider list resources.
+func (p *PscaleProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewFilePoolPolicyListResource,
+		NewNfsExportListResource,
+		NewQuotaListResource,
+		NewSmbShareListResource,
+		NewSnapshotListResource,
+		NewSynciqPolicyListResource,
+	}
+}
+
+// Functions describes the provider functions.
+func (p *PscaleProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseSizeFunction,
+		NewJoinIfsPathFunction,
+		NewPersonaFunction,
+		NewSnapshotExpiryFunction,
 	}
 }
 
@@ -299,5 +591,10 @@ func (d *commonResourceConfigurer) Metadata(ctx context.Context, req resource.Me
 
 // ImportState implements resource.ResourceWithImportState.
 func (d *commonResourceConfigurer) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parsedID.ID)...)
 }
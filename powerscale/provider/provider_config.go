@@ -0,0 +1,352 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Environment variables read by the provider when an attribute is not set in the configuration.
+const (
+	EnvEndpoint               = "POWERSCALE_ENDPOINT"
+	EnvUsername               = "POWERSCALE_USERNAME"
+	EnvPassword               = "POWERSCALE_PASSWORD"
+	EnvInsecure               = "POWERSCALE_INSECURE"
+	EnvAuthType               = "POWERSCALE_AUTH_TYPE"
+	EnvTimeout                = "POWERSCALE_TIMEOUT"
+	EnvMaxRetries             = "POWERSCALE_MAX_RETRIES"
+	EnvRetryMaxWait           = "POWERSCALE_RETRY_MAX_WAIT"
+	EnvMaxConcurrentRequests  = "POWERSCALE_MAX_CONCURRENT_REQUESTS"
+	EnvRequestsPerSecond      = "POWERSCALE_REQUESTS_PER_SECOND"
+	EnvReadCacheTTL           = "POWERSCALE_READ_CACHE_TTL"
+	EnvCACertificate          = "POWERSCALE_CA_CERTIFICATE"
+	EnvCertificateFingerprint = "POWERSCALE_CERTIFICATE_FINGERPRINT"
+	EnvTLSServerName          = "POWERSCALE_TLS_SERVER_NAME"
+	EnvClientCertificate      = "POWERSCALE_CLIENT_CERTIFICATE"
+	EnvClientKey              = "POWERSCALE_CLIENT_KEY"
+	EnvFallbackEndpoints      = "POWERSCALE_FALLBACK_ENDPOINTS"
+	EnvTraceHTTP              = "POWERSCALE_TRACE_HTTP"
+	EnvConfigFile             = "POWERSCALE_CONFIG_FILE"
+	EnvProfile                = "POWERSCALE_PROFILE"
+)
+
+// Names of the sources a provider attribute value can be resolved from.
+const (
+	sourceConfig  = "provider configuration"
+	sourceEnv     = "environment variable"
+	sourceProfile = "profile"
+	sourceDefault = "default"
+)
+
+const (
+	defaultProfileName = "default"
+	defaultConfigFile  = ".powerscale/credentials"
+)
+
+// profileFile holds the named profiles of a PowerScale credentials file.
+type profileFile map[string]map[string]string
+
+// defaultConfigFilePath returns the location of the credentials file used when none is configured.
+func defaultConfigFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, defaultConfigFile)
+}
+
+// parseProfileFile parses an INI style credentials file.
+// Each profile starts with a "[name]" header followed by "key = value" lines.
+// Blank lines and lines starting with "#" or ";" are ignored.
+func parseProfileFile(content string) (profileFile, error) {
+	profiles := profileFile{}
+	var current map[string]string
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: invalid profile header %q", lineNo, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if len(name) == 0 {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = map[string]string{}
+			}
+			current = profiles[name]
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: key %q is not inside a profile section", lineNo, strings.TrimSpace(key))
+		}
+		value = strings.TrimSpace(value)
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		current[strings.ToLower(strings.TrimSpace(key))] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// configResolver resolves each provider attribute from the provider configuration,
+// the environment and the selected profile, in that order of precedence.
+type configResolver struct {
+	profile     map[string]string
+	profileName string
+	profilePath string
+	sources     map[string]string
+	diags       diag.Diagnostics
+}
+
+// newConfigResolver loads the credentials file and selects the profile to read fallback values from.
+// A missing file is ignored unless its location or the profile was chosen explicitly.
+func newConfigResolver(configFile, profile types.String) *configResolver {
+	r := &configResolver{sources: map[string]string{}}
+
+	filePath := configFile.ValueString()
+	explicitFile := len(filePath) > 0
+	if !explicitFile {
+		filePath = os.Getenv(EnvConfigFile)
+		explicitFile = len(filePath) > 0
+	}
+	if !explicitFile {
+		filePath = defaultConfigFilePath()
+	}
+
+	r.profileName = profile.ValueString()
+	explicitProfile := len(r.profileName) > 0
+	if !explicitProfile {
+		r.profileName = os.Getenv(EnvProfile)
+		explicitProfile = len(r.profileName) > 0
+	}
+	if !explicitProfile {
+		r.profileName = defaultProfileName
+	}
+
+	if len(filePath) == 0 {
+		return r
+	}
+	// #nosec G304 -- the credentials file location is supplied by the user on purpose
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && !explicitFile && !explicitProfile {
+			return r
+		}
+		r.diags.AddAttributeError(path.Root("config_file"),
+			"Unable to read PowerScale credentials file",
+			fmt.Sprintf("Could not read credentials file %s: %s", filePath, err.Error()))
+		return r
+	}
+	profiles, err := parseProfileFile(string(content))
+	if err != nil {
+		r.diags.AddAttributeError(path.Root("config_file"),
+			"Unable to parse PowerScale credentials file",
+			fmt.Sprintf("Could not parse credentials file %s: %s", filePath, err.Error()))
+		return r
+	}
+	selected, ok := profiles[r.profileName]
+	if !ok {
+		if explicitProfile {
+			r.diags.AddAttributeError(path.Root("profile"),
+				"PowerScale profile not found",
+				fmt.Sprintf("Profile %q does not exist in credentials file %s. Available profiles: %s.",
+					r.profileName, filePath, strings.Join(profiles.names(), ", ")))
+		}
+		return r
+	}
+	r.profile = selected
+	r.profilePath = filePath
+	return r
+}
+
+func (p profileFile) names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// lookup returns the raw fallback value of an attribute and the source it came from.
+func (r *configResolver) lookup(name, env string) (string, string, bool) {
+	if len(env) > 0 {
+		if value, ok := os.LookupEnv(env); ok && len(value) > 0 {
+			return value, fmt.Sprintf("%s %s", sourceEnv, env), true
+		}
+	}
+	if value, ok := r.profile[name]; ok && len(value) > 0 {
+		return value, fmt.Sprintf("%s %q in %s", sourceProfile, r.profileName, r.profilePath), true
+	}
+	return "", "", false
+}
+
+func (r *configResolver) unknown(name, env string) {
+	r.diags.AddAttributeError(path.Root(name),
+		fmt.Sprintf("Unknown PowerScale provider attribute %s", name),
+		fmt.Sprintf("The provider cannot create the PowerScale client as there is an unknown configuration value for %s. "+
+			"Either apply the source of the value first, set the value statically in the configuration, "+
+			"or use the %s environment variable.", name, env))
+}
+
+// String resolves a string attribute.
+func (r *configResolver) String(name, env string, value types.String) types.String {
+	if value.IsUnknown() {
+		r.unknown(name, env)
+		return value
+	}
+	if !value.IsNull() {
+		r.sources[name] = sourceConfig
+		return value
+	}
+	raw, source, ok := r.lookup(name, env)
+	if !ok {
+		return value
+	}
+	r.sources[name] = source
+	return types.StringValue(raw)
+}
+
+// Bool resolves a boolean attribute.
+func (r *configResolver) Bool(name, env string, value types.Bool) types.Bool {
+	if value.IsUnknown() {
+		r.unknown(name, env)
+		return value
+	}
+	if !value.IsNull() {
+		r.sources[name] = sourceConfig
+		return value
+	}
+	raw, source, ok := r.lookup(name, env)
+	if !ok {
+		return value
+	}
+	parsed, err := strconv.ParseBool(raw)
+	if err != nil {
+		r.diags.AddAttributeError(path.Root(name),
+			fmt.Sprintf("Invalid value for PowerScale provider attribute %s", name),
+			fmt.Sprintf("The value %q from %s is not a valid boolean.", raw, source))
+		return value
+	}
+	r.sources[name] = source
+	return types.BoolValue(parsed)
+}
+
+// Int64 resolves an integer attribute.
+func (r *configResolver) Int64(name, env string, value types.Int64) types.Int64 {
+	if value.IsUnknown() {
+		r.unknown(name, env)
+		return value
+	}
+	if !value.IsNull() {
+		r.sources[name] = sourceConfig
+		return value
+	}
+	raw, source, ok := r.lookup(name, env)
+	if !ok {
+		return value
+	}
+	parsed, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		r.diags.AddAttributeError(path.Root(name),
+			fmt.Sprintf("Invalid value for PowerScale provider attribute %s", name),
+			fmt.Sprintf("The value %q from %s is not a valid number.", raw, source))
+		return value
+	}
+	r.sources[name] = source
+	return types.Int64Value(parsed)
+}
+
+// StringList resolves a list of strings attribute. Environment variables and profiles hold comma separated values.
+func (r *configResolver) StringList(name, env string, value types.List) types.List {
+	if value.IsUnknown() {
+		r.unknown(name, env)
+		return value
+	}
+	if !value.IsNull() {
+		r.sources[name] = sourceConfig
+		return value
+	}
+	raw, source, ok := r.lookup(name, env)
+	if !ok {
+		return value
+	}
+	var elements []attr.Value
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); len(item) > 0 {
+			elements = append(elements, types.StringValue(item))
+		}
+	}
+	r.sources[name] = source
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// Default records that an attribute fell back to its built-in default.
+func (r *configResolver) Default(name string) {
+	r.sources[name] = sourceDefault
+}
+
+// Require reports an error when a mandatory attribute could not be resolved from any source.
+func (r *configResolver) Require(name, env string, isNull bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !isNull {
+		return diags
+	}
+	diags.AddAttributeError(path.Root(name),
+		fmt.Sprintf("Missing PowerScale provider attribute %s", name),
+		fmt.Sprintf("The provider cannot create the PowerScale client as there is no value for %s. "+
+			"Set it in the provider configuration, with the %s environment variable, "+
+			"or in profile %q of the credentials file.", name, env, r.profileName))
+	return diags
+}
+
+// Sources returns the source each resolved attribute was read from, for logging.
+func (r *configResolver) Sources() map[string]interface{} {
+	fields := make(map[string]interface{}, len(r.sources))
+	for name, source := range r.sources {
+		fields[name] = source
+	}
+	return fields
+}
@@ -0,0 +1,185 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+var testProfileFileContent = `
+# PowerScale credentials
+[default]
+endpoint = https://profile-default:8080
+username = profile_user
+password = "profile pass"
+
+[lab]
+endpoint  = https://profile-lab:8080
+insecure  = true
+timeout   = 300
+auth_type = 0
+`
+
+func writeTestProfileFile(t *testing.T, content string) string {
+	file := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestParseProfileFile(t *testing.T) {
+	profiles, err := parseProfileFile(testProfileFileContent)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"default", "lab"}, profiles.names())
+	assert.Equal(t, "profile pass", profiles["default"]["password"])
+	assert.Equal(t, "300", profiles["lab"]["timeout"])
+
+	_, err = parseProfileFile("endpoint = https://no-section:8080")
+	assert.ErrorContains(t, err, "not inside a profile section")
+	_, err = parseProfileFile("[default\nendpoint = x")
+	assert.ErrorContains(t, err, "invalid profile header")
+	_, err = parseProfileFile("[default]\nendpoint")
+	assert.ErrorContains(t, err, "expected key = value")
+}
+
+func TestConfigResolverPrecedence(t *testing.T) {
+	file := writeTestProfileFile(t, testProfileFileContent)
+	t.Setenv(EnvConfigFile, file)
+	t.Setenv(EnvProfile, "")
+	t.Setenv(EnvEndpoint, "")
+	t.Setenv(EnvUsername, "env_user")
+	t.Setenv(EnvPassword, "")
+	t.Setenv(EnvInsecure, "")
+
+	resolver := newConfigResolver(types.StringNull(), types.StringNull())
+	assert.False(t, resolver.diags.HasError())
+
+	// configuration wins over environment and profile
+	endpoint := resolver.String("endpoint", EnvEndpoint, types.StringValue("https://config:8080"))
+	assert.Equal(t, "https://config:8080", endpoint.ValueString())
+	assert.Equal(t, sourceConfig, resolver.sources["endpoint"])
+
+	// environment wins over profile
+	username := resolver.String("username", EnvUsername, types.StringNull())
+	assert.Equal(t, "env_user", username.ValueString())
+	assert.Contains(t, resolver.sources["username"], EnvUsername)
+
+	// profile is used when nothing else is set
+	password := resolver.String("password", EnvPassword, types.StringNull())
+	assert.Equal(t, "profile pass", password.ValueString())
+	assert.Contains(t, resolver.sources["password"], `profile "default"`)
+
+	// nothing set anywhere
+	insecure := resolver.Bool("insecure", EnvInsecure, types.BoolNull())
+	assert.True(t, insecure.IsNull())
+	assert.True(t, resolver.Require("insecure", EnvInsecure, insecure.IsNull()).HasError())
+}
+
+func TestConfigResolverNamedProfile(t *testing.T) {
+	file := writeTestProfileFile(t, testProfileFileContent)
+	t.Setenv(EnvInsecure, "")
+	t.Setenv(EnvTimeout, "")
+	t.Setenv(EnvAuthType, "")
+
+	resolver := newConfigResolver(types.StringValue(file), types.StringValue("lab"))
+	assert.False(t, resolver.diags.HasError())
+	assert.True(t, resolver.Bool("insecure", EnvInsecure, types.BoolNull()).ValueBool())
+	assert.Equal(t, int64(300), resolver.Int64("timeout", EnvTimeout, types.Int64Null()).ValueInt64())
+	assert.Equal(t, int64(0), resolver.Int64("auth_type", EnvAuthType, types.Int64Null()).ValueInt64())
+
+	t.Setenv(EnvTimeout, "not-a-number")
+	resolver.Int64("timeout", EnvTimeout, types.Int64Null())
+	assert.True(t, resolver.diags.HasError())
+}
+
+func TestConfigResolverErrors(t *testing.T) {
+	file := writeTestProfileFile(t, testProfileFileContent)
+
+	resolver := newConfigResolver(types.StringValue(file), types.StringValue("missing"))
+	assert.True(t, resolver.diags.HasError())
+	assert.Contains(t, resolver.diags[0].Detail(), "Available profiles: default, lab")
+
+	resolver = newConfigResolver(types.StringValue(filepath.Join(t.TempDir(), "absent")), types.StringNull())
+	assert.True(t, resolver.diags.HasError())
+
+	// the default credentials file is optional
+	t.Setenv(EnvConfigFile, "")
+	t.Setenv(EnvProfile, "")
+	t.Setenv("HOME", t.TempDir())
+	resolver = newConfigResolver(types.StringNull(), types.StringNull())
+	assert.False(t, resolver.diags.HasError())
+
+	resolver.String("endpoint", EnvEndpoint, types.StringUnknown())
+	assert.True(t, resolver.diags.HasError())
+}
+
+func TestAccProviderProfileConfig(t *testing.T) {
+	skipIfSimulated(t, "the cluster configuration is not simulated")
+	testAccPreCheck(t)
+	file := writeTestProfileFile(t, "[acc]\n"+
+		"endpoint = "+powerscaleEndpoint+"\n"+
+		"username = "+powerscaleUsername+"\n"+
+		"password = "+powerscalePassword+"\n"+
+		"insecure = true\n")
+	// the environment takes precedence over the profile, so clear it for the provider to read the profile
+	for _, env := range []string{EnvEndpoint, EnvUsername, EnvPassword, EnvInsecure, EnvAuthType, EnvTimeout, EnvConfigFile, EnvProfile} {
+		t.Setenv(env, "")
+	}
+	resolver := newConfigResolver(types.StringValue(file), types.StringValue("acc"))
+	assert.False(t, resolver.diags.HasError())
+	assert.Equal(t, powerscaleEndpoint, resolver.String("endpoint", EnvEndpoint, types.StringNull()).ValueString())
+	assert.Equal(t, powerscaleUsername, resolver.String("username", EnvUsername, types.StringNull()).ValueString())
+	assert.True(t, resolver.Bool("insecure", EnvInsecure, types.BoolNull()).ValueBool())
+	for _, name := range []string{"endpoint", "username", "insecure"} {
+		assert.Contains(t, resolver.sources[name], sourceProfile)
+	}
+
+	profileConfig := `
+		provider "powerscale" {
+			config_file = "` + filepath.ToSlash(file) + `"
+			profile     = "acc"
+		}
+	`
+	missingProfileConfig := `
+		provider "powerscale" {
+			config_file = "` + filepath.ToSlash(file) + `"
+			profile     = "missing"
+		}
+	`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      missingProfileConfig + testAccClusterDataSourceConfig,
+				ExpectError: regexp.MustCompile(`.*PowerScale profile not found*.`),
+			},
+			{
+				Config: profileConfig + testAccClusterDataSourceConfig,
+				Check:  resource.TestCheckResourceAttr("data.powerscale_cluster.test", "id", "cluster-data-source"),
+			},
+		},
+	})
+}
@@ -19,27 +19,45 @@ package provider
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
 	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	powerscale "dell/powerscale-go-client"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math/big"
 	"net/http"
 	"net/http/cookiejar"
+	"net/http/httptest"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/simulator"
 	"testing"
+	"time"
 
 	"github.com/bytedance/mockey"
 	. "github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 )
@@ -52,6 +70,12 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"powerscale": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccProtoV6EchoProviderFactories add the echo provider, which exposes the values of ephemeral resources to the checks.
+var testAccProtoV6EchoProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"powerscale": providerserver.NewProtocol6WithError(New("test")()),
+	"echo":       echoprovider.NewProviderServer(),
+}
+
 var powerscaleUsername = ""
 var powerscalePassword = ""
 var powerscaleEndpoint = ""
@@ -72,9 +96,16 @@ var SessionAuthProviderConfig = ""
 var BasicAuthProviderErrorConfig = ""
 var FunctionMocker *mockey.Mocker
 
+// EnvSimulator runs the acceptance tests against the in-process PAPI simulator instead of the cluster of powerscale.env.
+const EnvSimulator = "POWERSCALE_SIMULATOR"
+
+// papiSimulator is the simulated cluster the acceptance tests run against when EnvSimulator is true.
+var papiSimulator *simulator.Server
+
 func init() {
-	_, err := loadEnvFile("powerscale.env")
-	if err != nil {
+	if simulated, _ := strconv.ParseBool(os.Getenv(EnvSimulator)); simulated {
+		startSimulator()
+	} else if _, err := loadEnvFile("powerscale.env"); err != nil {
 		log.Fatal("Error loading .env file")
 		return
 	}
@@ -157,6 +188,31 @@ func init() {
 	`, powerscaleUsername, powerscaleEndpoint, client.BasicAuthType, timeout)
 }
 
+// startSimulator starts the PAPI simulator and points the provider configuration of the tests to it.
+func startSimulator() {
+	papiSimulator = simulator.New()
+	for key, value := range map[string]string{
+		"TF_ACC":               "1",
+		"POWERSCALE_ENDPOINT":  papiSimulator.Endpoint(),
+		"POWERSCALE_USERNAME":  simulator.DefaultUsername,
+		"POWERSCALE_PASSWORD":  simulator.DefaultPassword,
+		"POWERSCALE_INSECURE":  "true",
+		"POWERSCALE_AUTH_TYPE": "1",
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			log.Fatalf("Error setting environment variable %s: %s", key, err.Error())
+		}
+	}
+}
+
+// skipIfSimulated skips tests needing more than the simulated PAPI, e.g. SSH access to the cluster or an API the simulator
+// does not serve, when running against the simulator.
+func skipIfSimulated(t *testing.T, reason string) {
+	if papiSimulator != nil {
+		t.Skipf("Skipping test against the PAPI simulator: %s", reason)
+	}
+}
+
 var sweepClient *client.Client
 
 // getClientForRegion returns a common provider client configured for the specified region.
@@ -165,6 +221,7 @@ func getClientForRegion(_ string) (*client.Client, error) {
 		return sweepClient, nil
 	}
 	client, err := client.NewClient(
+		context.Background(),
 		powerscaleEndpoint,
 		powerscaleInsecure,
 		powerscaleUsername,
@@ -181,9 +238,27 @@ func getClientForRegion(_ string) (*client.Client, error) {
 
 // this is required for initializing sweepers.
 func TestMain(m *testing.M) {
+	if papiSimulator != nil {
+		resource.TestMain(simulatedRun{m})
+		return
+	}
 	resource.TestMain(m)
 }
 
+// simulatedRun runs the tests against the PAPI simulator, and reports the APIs it is missing.
+type simulatedRun struct {
+	m *testing.M
+}
+
+func (r simulatedRun) Run() int {
+	defer papiSimulator.Close()
+	code := r.m.Run()
+	if unhandled := papiSimulator.Unhandled(); len(unhandled) > 0 {
+		log.Printf("Requests not handled by the PAPI simulator: %s", strings.Join(unhandled, ", "))
+	}
+	return code
+}
+
 func testAccPreCheck(t *testing.T) {
 	// Check that the required environment variables are set.
 	if os.Getenv("POWERSCALE_ENDPOINT") == "" {
@@ -222,6 +297,8 @@ func TestSessionAuthError(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				PreConfig: func() {
+					// drop the sessions shared by previous tests so that the provider has to log in
+					client.CloseSessions(context.Background())
 					FunctionMocker = mockey.Mock(client.RequestSession).Return(nil, fmt.Errorf("mock error")).Build()
 				},
 				Config:      SessionAuthProviderConfig + testAccClusterDataSourceConfig,
@@ -252,6 +329,7 @@ func TestSessionAuthUnauthorizedError(t *testing.T) {
 						ContentLength: -1,
 						Body:          io.NopCloser(strings.NewReader("abcdef")),
 					}
+					client.CloseSessions(context.Background())
 					FunctionMocker = mockey.Mock(client.RequestSession).Return(response, nil).Build()
 				},
 				Config:      SessionAuthProviderConfig + testAccClusterDataSourceConfig,
@@ -301,6 +379,130 @@ func TestSessionRefresh(t *testing.T) {
 	}
 }
 
+// fakeSessionServer is a minimal PAPI answering session requests and one authenticated endpoint.
+type fakeSessionServer struct {
+	*httptest.Server
+	mu              sync.Mutex
+	valid           map[string]bool
+	logins          int
+	logouts         int
+	unauthorized    int
+	timeoutInactive int
+}
+
+func newFakeSessionServer(timeoutInactive int) *fakeSessionServer {
+	f := &fakeSessionServer{valid: map[string]bool{}, timeoutInactive: timeoutInactive}
+	f.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		cookie, _ := r.Cookie("isisessid")
+		switch {
+		case r.URL.Path == "/"+client.SessionEndpoint && r.Method == http.MethodPost:
+			f.logins++
+			id := fmt.Sprintf("session-%d", f.logins)
+			f.valid[id] = true
+			http.SetCookie(w, &http.Cookie{Name: "isisessid", Value: id})
+			http.SetCookie(w, &http.Cookie{Name: "isicsrf", Value: "csrf-" + id})
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"services":["platform","namespace"],"timeout_absolute":14400,"timeout_inactive":%d}`, f.timeoutInactive)
+		case r.URL.Path == "/"+client.SessionEndpoint && r.Method == http.MethodDelete:
+			f.logouts++
+			delete(f.valid, cookie.Value)
+			w.WriteHeader(http.StatusNoContent)
+		case cookie == nil || !f.valid[cookie.Value] || r.Header.Get("X-CSRF-Token") != "csrf-"+cookie.Value:
+			f.unauthorized++
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	return f
+}
+
+func (f *fakeSessionServer) expireAll() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.valid = map[string]bool{}
+}
+
+func TestSessionSharedAcrossClients(t *testing.T) {
+	server := newFakeSessionServer(900)
+	defer server.Close()
+	defer client.CloseSessions(context.Background())
+
+	first, err := client.NewOpenAPIClient(context.Background(), server.URL, true, "user", "pass", client.SessionAuthType, 30)
+	assert.Nil(t, err)
+	second, err := client.NewOpenAPIClient(context.Background(), server.URL, true, "user", "pass", client.SessionAuthType, 30)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, server.logins)
+
+	for _, apiClient := range []*powerscale.APIClient{first, second} {
+		resp, err := apiClient.GetConfig().HTTPClient.Get(server.URL + "/platform/1/protocols/smb/shares")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, 1, server.logins)
+
+	// other credentials never reuse the session
+	_, err = client.NewOpenAPIClient(context.Background(), server.URL, true, "user", "other", client.SessionAuthType, 30)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, server.logins)
+
+	// a session rejected by PAPI is renewed once and the request sent again
+	server.expireAll()
+	resp, err := first.GetConfig().HTTPClient.Get(server.URL + "/platform/1/protocols/smb/shares")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, server.unauthorized)
+	assert.Equal(t, 3, server.logins)
+	resp, err = second.GetConfig().HTTPClient.Get(server.URL + "/platform/1/protocols/smb/shares")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, server.logins)
+
+	client.CloseSessions(context.Background())
+	assert.Equal(t, 2, server.logouts)
+}
+
+func TestSessionProactiveRefresh(t *testing.T) {
+	// sessions are renewed a minute before they become inactive
+	server := newFakeSessionServer(61)
+	defer server.Close()
+	defer client.CloseSessions(context.Background())
+
+	apiClient, err := client.NewOpenAPIClient(context.Background(), server.URL, true, "user", "pass", client.SessionAuthType, 30)
+	assert.Nil(t, err)
+	time.Sleep(1100 * time.Millisecond)
+	resp, err := apiClient.GetConfig().HTTPClient.Get(server.URL + "/platform/1/protocols/smb/shares")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, server.logins)
+	assert.Equal(t, 0, server.unauthorized)
+}
+
+func TestSessionToken(t *testing.T) {
+	server := newFakeSessionServer(900)
+	defer server.Close()
+
+	pscaleClient, err := client.NewClient(context.Background(), server.URL, true, "user", "pass", client.BasicAuthType, 30)
+	assert.Nil(t, err)
+	token, err := pscaleClient.CreateSessionToken(context.Background(), "auditor", "secret")
+	assert.Nil(t, err)
+	assert.Equal(t, "session-1", token.ID)
+	assert.Equal(t, "csrf-session-1", token.CSRFToken)
+	assert.Equal(t, 4*time.Hour, token.TimeoutAbsolute)
+	assert.Equal(t, 15*time.Minute, token.TimeoutInactive)
+
+	// the session does not authenticate the requests of the client
+	_, status, err := pscaleClient.Request(context.Background(), http.MethodGet, "/platform/1/protocols/smb/shares", nil, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	assert.Nil(t, pscaleClient.DeleteSessionToken(context.Background(), *token))
+	assert.Equal(t, 1, server.logouts)
+	assert.False(t, server.valid[token.ID])
+}
+
 func TestUnauthorizedErrorParse(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -382,7 +584,7 @@ func TestGetOnefsVersionErrorResponse(t *testing.T) {
 	}
 	clusterConfigMocker := Mock(powerscale.ApiGetClusterv3ClusterConfigRequest.Execute).Return(nil, nil, errors.New("mock REST error")).Build()
 	defer clusterConfigMocker.UnPatch()
-	_, err = client.GetOnefsVersion()
+	_, err = client.GetOnefsVersion(context.Background())
 	assert.True(t, err != nil)
 }
 
@@ -405,7 +607,7 @@ func TestSetOnefsVersion(t *testing.T) {
 		PscaleOpenAPIClient: openAPIClient,
 	}
 	client.SetOnefsVersion(9, 4, 0)
-	version, _ := client.GetOnefsVersion()
+	version, _ := client.GetOnefsVersion(context.Background())
 	assert.True(t, version.IsEqualTo("9.4.0"))
 }
 
@@ -440,6 +642,392 @@ func TestInsecureClientWithInsecureParam(t *testing.T) {
 	assert.NotNil(t, openAPIClient)
 }
 
+func TestRetryTransportTransientStatus(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: client.NewRetryTransport(http.DefaultTransport, 3, 10*time.Millisecond)}
+	resp, err := httpClient.Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: client.NewRetryTransport(http.DefaultTransport, 2, 10*time.Millisecond)}
+	resp, err := httpClient.Get(server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestRetryTransportPost(t *testing.T) {
+	var bodies []string
+	status := http.StatusBadGateway
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: client.NewRetryTransport(http.DefaultTransport, 3, 10*time.Millisecond)}
+	// a POST may have been processed behind a 502, so it is not retried
+	resp, err := httpClient.Post(server.URL, "application/json", strings.NewReader(`{"name":"a"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 1, len(bodies))
+
+	// a 503 means PAPI refused the request, so the POST is sent again with the same body
+	bodies = nil
+	status = http.StatusServiceUnavailable
+	_, err = httpClient.Post(server.URL, "application/json", strings.NewReader(`{"name":"a"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`{"name":"a"}`, `{"name":"a"}`, `{"name":"a"}`, `{"name":"a"}`}, bodies)
+}
+
+func TestRetryTransportCancel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	httpClient := &http.Client{Transport: client.NewRetryTransport(http.DefaultTransport, 10, time.Minute)}
+	start := time.Now()
+	_, err := httpClient.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 10*time.Second)
+}
+
+func TestClientReadCache(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	pscaleClient, err := client.NewClient(context.Background(), server.URL, true, "user", "pass", client.BasicAuthType, 300)
+	assert.Nil(t, err)
+
+	var mu sync.Mutex
+	loads := 0
+	load := func(ctx context.Context) ([]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		loads++
+		return []string{"role"}, nil
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			roles, err := client.Cached(context.Background(), pscaleClient, "auth/roles", load)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"role"}, roles)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, loads)
+
+	// a write clears the cache
+	_, _, err = pscaleClient.Request(context.Background(), http.MethodPut, "/platform/1/auth/roles/role", nil, []byte(`{}`))
+	assert.Nil(t, err)
+	_, _ = client.Cached(context.Background(), pscaleClient, "auth/roles", load)
+	assert.Equal(t, 2, loads)
+
+	// failed loads are not cached
+	failures := 0
+	for i := 0; i < 2; i++ {
+		_, err = client.Cached(context.Background(), pscaleClient, "zones", func(ctx context.Context) (*string, error) {
+			failures++
+			return nil, errors.New("mock error")
+		})
+		assert.NotNil(t, err)
+	}
+	assert.Equal(t, 2, failures)
+
+	uncached, err := client.NewClient(context.Background(), server.URL, true, "user", "pass", client.BasicAuthType, 300, client.WithReadCache(0))
+	assert.Nil(t, err)
+	_, _ = client.Cached(context.Background(), uncached, "auth/roles", load)
+	_, _ = client.Cached(context.Background(), uncached, "auth/roles", load)
+	assert.Equal(t, 4, loads)
+}
+
+func TestLimitTransportConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: client.NewLimitTransport(http.DefaultTransport, 2, 0)}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := httpClient.Get(server.URL)
+			if assert.Nil(t, err) {
+				_ = resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2, maxInFlight)
+}
+
+func TestLimitTransportRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: client.NewLimitTransport(http.DefaultTransport, 0, 20)}
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		resp, err := httpClient.Get(server.URL)
+		assert.Nil(t, err)
+		_ = resp.Body.Close()
+	}
+	// the first request is sent at once, the next ones every 50ms
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+
+	// a queued request gives up when its context is done
+	limited := &http.Client{Transport: client.NewLimitTransport(http.DefaultTransport, 0, 0.1)}
+	resp, err := limited.Get(server.URL)
+	assert.Nil(t, err)
+	_ = resp.Body.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err = limited.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestTraceTransportRedaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "isicsrf", Value: "csrf-cookie"})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"keys":{"access_id":"1_tfacc_accid","secret_key":"s3-secret"}}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/platform/10/protocols/s3/keys/tfacc",
+		strings.NewReader(`{"name":"tfacc","password":"user-pass","description":"provider-pass","snmp_v3_priv_password":"snmp-pass"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Cookie", "isisessid=session-id")
+	req.Header.Set("X-CSRF-Token", "csrf-token")
+	httpClient := &http.Client{Transport: client.NewTraceTransport(http.DefaultTransport, "provider-pass")}
+	resp, err := httpClient.Do(req)
+	assert.Nil(t, err)
+	// the traced response is still read whole by the caller
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "s3-secret")
+
+	logs := output.String()
+	assert.Contains(t, logs, "/platform/10/protocols/s3/keys/tfacc")
+	assert.Contains(t, logs, "1_tfacc_accid")
+	assert.Contains(t, logs, `"status":200`)
+	for _, secret := range []string{"s3-secret", "user-pass", "provider-pass", "snmp-pass", "session-id", "csrf-token", "csrf-cookie"} {
+		assert.NotContains(t, logs, secret)
+	}
+}
+
+func TestFailoverTransportDeadEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	// a closed server refuses connections like a rebooting node
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+
+	failover, err := client.NewFailoverTransport(http.DefaultTransport, []string{dead.URL, server.URL})
+	assert.Nil(t, err)
+	httpClient := &http.Client{Transport: failover}
+	resp, err := httpClient.Post(dead.URL+"/platform/1/protocols/nfs/exports", "application/json", strings.NewReader(`{}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, server.URL, failover.ActiveEndpoint())
+
+	// a request pinned to an endpoint does not fail over
+	req, _ := http.NewRequestWithContext(client.WithEndpoint(context.Background(), 0), http.MethodGet, server.URL, nil)
+	_, err = httpClient.Do(req)
+	assert.NotNil(t, err)
+}
+
+func TestFailoverTransportUnavailable(t *testing.T) {
+	var calls []string
+	first := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "first")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer first.Close()
+	second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, "second "+string(body))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer second.Close()
+
+	failover, err := client.NewFailoverTransport(http.DefaultTransport, []string{first.URL, second.URL})
+	assert.Nil(t, err)
+	httpClient := &http.Client{Transport: failover}
+	resp, err := httpClient.Post(first.URL, "application/json", strings.NewReader(`{"name":"a"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{"first", `second {"name":"a"}`}, calls)
+
+	// the active endpoint sticks for the following requests
+	calls = nil
+	_, err = httpClient.Get(first.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"second "}, calls)
+
+	// endpoints excluded from failover are skipped
+	failover.SetUsable(1, false)
+	calls = nil
+	resp, err = httpClient.Get(first.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, []string{"first"}, calls)
+}
+
+func TestFailoverTransportInvalidEndpoint(t *testing.T) {
+	_, err := client.NewFailoverTransport(http.DefaultTransport, []string{"https://10.10.10.10:8080", "10.10.10.11"})
+	assert.NotNil(t, err)
+	_, err = client.NewFailoverTransport(http.DefaultTransport, nil)
+	assert.NotNil(t, err)
+}
+
+func TestTLSConfigCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	// the test server certificate is not trusted by the system pool
+	tlsConfig, err := client.NewTLSConfig(false, client.TLSOptions{})
+	assert.Nil(t, err)
+	_, err = tlsGet(tlsConfig, server.URL)
+	assert.NotNil(t, err)
+
+	tlsConfig, err = client.NewTLSConfig(false, client.TLSOptions{CACertificate: caPEM})
+	assert.Nil(t, err)
+	_, err = tlsGet(tlsConfig, server.URL)
+	assert.Nil(t, err)
+
+	// the bundle can also be read from a file, and the name to verify overridden
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.Nil(t, os.WriteFile(caFile, []byte(caPEM), 0600))
+	tlsConfig, err = client.NewTLSConfig(false, client.TLSOptions{CACertificate: caFile, ServerName: "example.com"})
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", tlsConfig.ServerName)
+	_, err = tlsGet(tlsConfig, server.URL)
+	assert.Nil(t, err)
+
+	tlsConfig, err = client.NewTLSConfig(false, client.TLSOptions{CACertificate: caFile, ServerName: "other.example.org"})
+	assert.Nil(t, err)
+	_, err = tlsGet(tlsConfig, server.URL)
+	assert.NotNil(t, err)
+
+	_, err = client.NewTLSConfig(false, client.TLSOptions{CACertificate: "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----"})
+	assert.NotNil(t, err)
+}
+
+func TestTLSConfigFingerprint(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	sum := sha256.Sum256(server.Certificate().Raw)
+	fingerprint := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	tlsConfig, err := client.NewTLSConfig(false, client.TLSOptions{CertificateFingerprint: fingerprint})
+	assert.Nil(t, err)
+	_, err = tlsGet(tlsConfig, server.URL)
+	assert.Nil(t, err)
+
+	wrong := sha256.Sum256([]byte("other certificate"))
+	tlsConfig, err = client.NewTLSConfig(false, client.TLSOptions{CertificateFingerprint: hex.EncodeToString(wrong[:])})
+	assert.Nil(t, err)
+	_, err = tlsGet(tlsConfig, server.URL)
+	assert.ErrorContains(t, err, "does not match the pinned fingerprint")
+
+	_, err = client.NewTLSConfig(false, client.TLSOptions{CertificateFingerprint: "abcd"})
+	assert.ErrorContains(t, err, "invalid SHA-256 certificate fingerprint")
+}
+
+func TestTLSConfigClientCertificate(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+
+	tlsConfig, err := client.NewTLSConfig(true, client.TLSOptions{ClientCertificate: certPEM, ClientKey: keyPEM})
+	assert.Nil(t, err)
+	resp, err := tlsGet(tlsConfig, server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = client.NewTLSConfig(true, client.TLSOptions{ClientCertificate: certPEM})
+	assert.ErrorContains(t, err, "must be set together")
+}
+
+func tlsGet(tlsConfig *tls.Config, url string) (*http.Response, error) {
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	resp, err := httpClient.Get(url)
+	if err == nil {
+		resp.Body.Close()
+	}
+	return resp, err
+}
+
 // loadEnvFile used to read env file and set params
 func loadEnvFile(path string) (map[string]string, error) {
 	envMap := make(map[string]string)
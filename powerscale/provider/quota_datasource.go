@@ -93,309 +93,319 @@ func (d *QuotaDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest
 				Description:         "List of Quotas",
 				MarkdownDescription: "List of Quotas",
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"container": schema.BoolAttribute{
-							Description:         "If true, SMB shares using the quota directory see the quota thresholds as share size.",
-							MarkdownDescription: "If true, SMB shares using the quota directory see the quota thresholds as share size.",
-							Computed:            true,
-						},
-						"efficiency_ratio": schema.NumberAttribute{
-							Description:         "Represents the ratio of logical space provided to physical space used. This accounts for protection overhead, metadata, and compression ratios for the data.",
-							MarkdownDescription: "Represents the ratio of logical space provided to physical space used. This accounts for protection overhead, metadata, and compression ratios for the data.",
-							Computed:            true,
-						},
-						"enforced": schema.BoolAttribute{
-							Description:         "True if the quota provides enforcement, otherwise an accounting quota.",
-							MarkdownDescription: "True if the quota provides enforcement, otherwise an accounting quota.",
-							Computed:            true,
-						},
-						"id": schema.StringAttribute{
-							Description:         "The system ID given to the quota.",
-							MarkdownDescription: "The system ID given to the quota.",
-							Computed:            true,
-						},
-						"include_snapshots": schema.BoolAttribute{
-							Description:         "If true, quota governs snapshot data as well as head data.",
-							MarkdownDescription: "If true, quota governs snapshot data as well as head data.",
-							Computed:            true,
-						},
-						"linked": schema.BoolAttribute{
-							Description:         "For user, group and directory quotas, true if the quota is linked and controlled by a parent default-* quota. Linked quotas cannot be modified until they are unlinked.",
-							MarkdownDescription: "For user, group and directory quotas, true if the quota is linked and controlled by a parent default-* quota. Linked quotas cannot be modified until they are unlinked.",
-							Computed:            true,
-						},
-						"notifications": schema.StringAttribute{
-							Description:         "Summary of notifications: 'custom' indicates one or more notification rules available from the notifications sub-resource; 'default' indicates system default rules are used; 'disabled' indicates that no notifications will be used for this quota.; 'badmap' indicates that notification rule has problem in rule map.",
-							MarkdownDescription: "Summary of notifications: 'custom' indicates one or more notification rules available from the notifications sub-resource; 'default' indicates system default rules are used; 'disabled' indicates that no notifications will be used for this quota.; 'badmap' indicates that notification rule has problem in rule map.",
-							Computed:            true,
-						},
-						"path": schema.StringAttribute{
-							Description:         "The ifs path governed.",
-							MarkdownDescription: "The ifs path governed.",
-							Computed:            true,
-						},
-						"persona": schema.SingleNestedAttribute{
-							Description:         "Specifies the persona of the file group.",
-							MarkdownDescription: "Specifies the persona of the file group.",
-							Computed:            true,
-							Attributes: map[string]schema.Attribute{
-								"id": schema.StringAttribute{
-									Description:         "Specifies the serialized form of a persona, which can be 'UID:0', 'USER:name', 'GID:0', 'GROUP:wheel', or 'SID:S-1-1'.",
-									MarkdownDescription: "Specifies the serialized form of a persona, which can be 'UID:0', 'USER:name', 'GID:0', 'GROUP:wheel', or 'SID:S-1-1'.",
-									Computed:            true,
-								},
-								"name": schema.StringAttribute{
-									Description:         "Specifies the persona name, which must be combined with a type.",
-									MarkdownDescription: "Specifies the persona name, which must be combined with a type.",
-									Computed:            true,
-								},
-								"type": schema.StringAttribute{
-									Description:         "Specifies the type of persona, which must be combined with a name.",
-									MarkdownDescription: "Specifies the type of persona, which must be combined with a name.",
-									Computed:            true,
-								},
-							},
-						},
-						"ready": schema.BoolAttribute{
-							Description:         "True if the default resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-							MarkdownDescription: "True if the default resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-							Computed:            true,
-						},
-						"reduction_ratio": schema.NumberAttribute{
-							Description:         "Represents the ratio of logical space provided to physical data space used. This accounts for compression and data deduplication effects.",
-							MarkdownDescription: "Represents the ratio of logical space provided to physical data space used. This accounts for compression and data deduplication effects.",
-							Computed:            true,
-						},
-						"thresholds": schema.SingleNestedAttribute{
-							Description:         "The thresholds of quota",
-							MarkdownDescription: "The thresholds of quota",
-							Computed:            true,
-							Attributes: map[string]schema.Attribute{
-								"advisory": schema.Int64Attribute{
-									Description:         "Usage bytes at which notifications will be sent but writes will not be denied.",
-									MarkdownDescription: "Usage bytes at which notifications will be sent but writes will not be denied.",
-									Computed:            true,
-								},
-								"advisory_exceeded": schema.BoolAttribute{
-									Description:         "True if the advisory threshold has been hit.",
-									MarkdownDescription: "True if the advisory threshold has been hit.",
-									Computed:            true,
-								},
-								"advisory_last_exceeded": schema.Int64Attribute{
-									Description:         "Time at which advisory threshold was hit.",
-									MarkdownDescription: "Time at which advisory threshold was hit.",
-									Computed:            true,
-								},
-								"hard": schema.Int64Attribute{
-									Description:         "Usage bytes at which further writes will be denied.",
-									MarkdownDescription: "Usage bytes at which further writes will be denied.",
-									Computed:            true,
-								},
-								"hard_exceeded": schema.BoolAttribute{
-									Description:         "True if the hard threshold has been hit.",
-									MarkdownDescription: "True if the hard threshold has been hit.",
-									Computed:            true,
-								},
-								"hard_last_exceeded": schema.Int64Attribute{
-									Description:         "Time at which hard threshold was hit.",
-									MarkdownDescription: "Time at which hard threshold was hit.",
-									Computed:            true,
-								},
-								"percent_advisory": schema.NumberAttribute{
-									Description:         "Advisory threshold as percent of hard threshold. Usage bytes at which notifications will be sent but writes will not be denied.",
-									MarkdownDescription: "Advisory threshold as percent of hard threshold. Usage bytes at which notifications will be sent but writes will not be denied.",
-									Computed:            true,
-								},
-								"percent_soft": schema.NumberAttribute{
-									Description:         "Soft threshold as percent of hard threshold. Usage bytes at which notifications will be sent and soft grace time will be started.",
-									MarkdownDescription: "Soft threshold as percent of hard threshold. Usage bytes at which notifications will be sent and soft grace time will be started.",
-									Computed:            true,
-								},
-								"soft": schema.Int64Attribute{
-									Description:         "Usage bytes at which notifications will be sent and soft grace time will be started.",
-									MarkdownDescription: "Usage bytes at which notifications will be sent and soft grace time will be started.",
-									Computed:            true,
-								},
-								"soft_exceeded": schema.BoolAttribute{
-									Description:         "True if the soft threshold has been hit.",
-									MarkdownDescription: "True if the soft threshold has been hit.",
-									Computed:            true,
-								},
-								"soft_grace": schema.Int64Attribute{
-									Description:         "Time in seconds after which the soft threshold has been hit before writes will be denied.",
-									MarkdownDescription: "Time in seconds after which the soft threshold has been hit before writes will be denied.",
-									Computed:            true,
-								},
-								"soft_last_exceeded": schema.Int64Attribute{
-									Description:         "Time at which soft threshold was hit",
-									MarkdownDescription: "Time at which soft threshold was hit",
-									Computed:            true,
-								},
-							},
-						},
-						"thresholds_on": schema.StringAttribute{
-							Description:         "Thresholds apply on quota accounting metric.",
-							MarkdownDescription: "Thresholds apply on quota accounting metric.",
-							Computed:            true,
-						},
-						"type": schema.StringAttribute{
-							Description:         "The type of quota.",
-							MarkdownDescription: "The type of quota.",
-							Computed:            true,
-						},
-						"usage": schema.SingleNestedAttribute{
-							Description:         "The usage of quota",
-							MarkdownDescription: "The usage of quota",
-							Computed:            true,
-							Attributes: map[string]schema.Attribute{
-								"applogical": schema.Int64Attribute{
-									Description:         "Bytes used by governed data apparent to application.",
-									MarkdownDescription: "Bytes used by governed data apparent to application.",
-									Computed:            true,
-								},
-								"applogical_ready": schema.BoolAttribute{
-									Description:         "True if applogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if applogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-								"fslogical": schema.Int64Attribute{
-									Description:         "Bytes used by governed data apparent to filesystem.",
-									MarkdownDescription: "Bytes used by governed data apparent to filesystem.",
-									Computed:            true,
-								},
-								"fslogical_ready": schema.BoolAttribute{
-									Description:         "True if fslogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if fslogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-								"fsphysical": schema.Int64Attribute{
-									Description:         "Physical data usage adjusted to account for shadow store efficiency",
-									MarkdownDescription: "Physical data usage adjusted to account for shadow store efficiency",
-									Computed:            true,
-								},
-								"fsphysical_ready": schema.BoolAttribute{
-									Description:         "True if fsphysical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if fsphysical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-								"inodes": schema.Int64Attribute{
-									Description:         "Number of inodes (filesystem entities) used by governed data.",
-									MarkdownDescription: "Number of inodes (filesystem entities) used by governed data.",
-									Computed:            true,
-								},
-								"inodes_ready": schema.BoolAttribute{
-									Description:         "True if inodes resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if inodes resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-								"physical": schema.Int64Attribute{
-									Description:         "Bytes used for governed data and filesystem overhead.",
-									MarkdownDescription: "Bytes used for governed data and filesystem overhead.",
-									Computed:            true,
-								},
-								"physical_data": schema.Int64Attribute{
-									Description:         "Number of physical blocks for file data",
-									MarkdownDescription: "Number of physical blocks for file data",
-									Computed:            true,
-								},
-								"physical_data_ready": schema.BoolAttribute{
-									Description:         "True if physical_data resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if physical_data resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-								"physical_protection": schema.Int64Attribute{
-									Description:         "Number of physical blocks for file protection",
-									MarkdownDescription: "Number of physical blocks for file protection",
-									Computed:            true,
-								},
-								"physical_protection_ready": schema.BoolAttribute{
-									Description:         "True if physical_protection resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if physical_protection resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-								"physical_ready": schema.BoolAttribute{
-									Description:         "True if physical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if physical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-								"shadow_refs": schema.Int64Attribute{
-									Description:         "Number of shadow references (cloned, deduplicated or packed filesystem blocks) used by governed data.",
-									MarkdownDescription: "Number of shadow references (cloned, deduplicated or packed filesystem blocks) used by governed data.",
-									Computed:            true,
-								},
-								"shadow_refs_ready": schema.BoolAttribute{
-									Description:         "True if shadow_refs resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									MarkdownDescription: "True if shadow_refs resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
-									Computed:            true,
-								},
-							},
-						},
-					},
+					Attributes: quotaDatasourceEntityAttributes(),
 				},
 			},
 		},
 		Blocks: map[string]schema.Block{
 			"filter": schema.SingleNestedBlock{
-				Attributes: map[string]schema.Attribute{
-					"enforced": schema.BoolAttribute{
-						Description:         "Only list quotas with this enforcement (non-accounting).",
-						MarkdownDescription: "Only list quotas with this enforcement (non-accounting).",
-						Optional:            true,
-					},
-					"exceeded": schema.BoolAttribute{
-						Description:         "Set to true to only list quotas which have exceeded one or more of their thresholds.",
-						MarkdownDescription: "Set to true to only list quotas which have exceeded one or more of their thresholds.",
-						Optional:            true,
-					},
-					"include_snapshots": schema.BoolAttribute{
-						Description:         "Only list quotas with this setting for include_snapshots.",
-						MarkdownDescription: "Only list quotas with this setting for include_snapshots.",
-						Optional:            true,
-					},
-					"path": schema.StringAttribute{
-						Description:         "Only list quotas matching this path (see also recurse_path_*).",
-						MarkdownDescription: "Only list quotas matching this path (see also recurse_path_*).",
-						Optional:            true,
-					},
-					"persona": schema.StringAttribute{
-						Description:         "Only list user or group quotas matching this persona (must be used with the corresponding type argument).",
-						MarkdownDescription: "Only list user or group quotas matching this persona (must be used with the corresponding type argument).",
-						Optional:            true,
-					},
-					"recurse_path_children": schema.BoolAttribute{
-						Description:         "If used with the path argument, match all quotas at that path or any descendent sub-directory.",
-						MarkdownDescription: "If used with the path argument, match all quotas at that path or any descendent sub-directory.",
-						Optional:            true,
-					},
-					"recurse_path_parents": schema.BoolAttribute{
-						Description:         "If used with the path argument, match all quotas at that path or any parent directory.",
-						MarkdownDescription: "If used with the path argument, match all quotas at that path or any parent directory.",
-						Optional:            true,
-					},
-					"report_id": schema.StringAttribute{
-						Description:         "Use the named report as a source rather than the live quotas.",
-						MarkdownDescription: "Use the named report as a source rather than the live quotas.",
-						Optional:            true,
-					},
-					"type": schema.StringAttribute{
-						Description:         "Only list quotas matching this type.",
-						MarkdownDescription: "Only list quotas matching this type.",
-						Optional:            true,
-						Validators: []validator.String{
-							stringvalidator.OneOf("directory", "user", "group",
-								"default-directory", "default-user", "default-group"),
-						},
-					},
-					"zone": schema.StringAttribute{
-						Description:         "Optional named zone to use for user and group resolution.",
-						MarkdownDescription: "Optional named zone to use for user and group resolution.",
-						Optional:            true,
-					},
+				Attributes: quotaDatasourceFilterAttributes(),
+			},
+		},
+	}
+
+}
+
+// quotaDatasourceEntityAttributes returns the schema of a quota, shared by the quota and quota report data sources.
+func quotaDatasourceEntityAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"container": schema.BoolAttribute{
+			Description:         "If true, SMB shares using the quota directory see the quota thresholds as share size.",
+			MarkdownDescription: "If true, SMB shares using the quota directory see the quota thresholds as share size.",
+			Computed:            true,
+		},
+		"efficiency_ratio": schema.NumberAttribute{
+			Description:         "Represents the ratio of logical space provided to physical space used. This accounts for protection overhead, metadata, and compression ratios for the data.",
+			MarkdownDescription: "Represents the ratio of logical space provided to physical space used. This accounts for protection overhead, metadata, and compression ratios for the data.",
+			Computed:            true,
+		},
+		"enforced": schema.BoolAttribute{
+			Description:         "True if the quota provides enforcement, otherwise an accounting quota.",
+			MarkdownDescription: "True if the quota provides enforcement, otherwise an accounting quota.",
+			Computed:            true,
+		},
+		"id": schema.StringAttribute{
+			Description:         "The system ID given to the quota.",
+			MarkdownDescription: "The system ID given to the quota.",
+			Computed:            true,
+		},
+		"include_snapshots": schema.BoolAttribute{
+			Description:         "If true, quota governs snapshot data as well as head data.",
+			MarkdownDescription: "If true, quota governs snapshot data as well as head data.",
+			Computed:            true,
+		},
+		"linked": schema.BoolAttribute{
+			Description:         "For user, group and directory quotas, true if the quota is linked and controlled by a parent default-* quota. Linked quotas cannot be modified until they are unlinked.",
+			MarkdownDescription: "For user, group and directory quotas, true if the quota is linked and controlled by a parent default-* quota. Linked quotas cannot be modified until they are unlinked.",
+			Computed:            true,
+		},
+		"notifications": schema.StringAttribute{
+			Description:         "Summary of notifications: 'custom' indicates one or more notification rules available from the notifications sub-resource; 'default' indicates system default rules are used; 'disabled' indicates that no notifications will be used for this quota.; 'badmap' indicates that notification rule has problem in rule map.",
+			MarkdownDescription: "Summary of notifications: 'custom' indicates one or more notification rules available from the notifications sub-resource; 'default' indicates system default rules are used; 'disabled' indicates that no notifications will be used for this quota.; 'badmap' indicates that notification rule has problem in rule map.",
+			Computed:            true,
+		},
+		"path": schema.StringAttribute{
+			Description:         "The ifs path governed.",
+			MarkdownDescription: "The ifs path governed.",
+			Computed:            true,
+		},
+		"persona": schema.SingleNestedAttribute{
+			Description:         "Specifies the persona of the file group.",
+			MarkdownDescription: "Specifies the persona of the file group.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Description:         "Specifies the serialized form of a persona, which can be 'UID:0', 'USER:name', 'GID:0', 'GROUP:wheel', or 'SID:S-1-1'.",
+					MarkdownDescription: "Specifies the serialized form of a persona, which can be 'UID:0', 'USER:name', 'GID:0', 'GROUP:wheel', or 'SID:S-1-1'.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					Description:         "Specifies the persona name, which must be combined with a type.",
+					MarkdownDescription: "Specifies the persona name, which must be combined with a type.",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					Description:         "Specifies the type of persona, which must be combined with a name.",
+					MarkdownDescription: "Specifies the type of persona, which must be combined with a name.",
+					Computed:            true,
+				},
+			},
+		},
+		"ready": schema.BoolAttribute{
+			Description:         "True if the default resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+			MarkdownDescription: "True if the default resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+			Computed:            true,
+		},
+		"reduction_ratio": schema.NumberAttribute{
+			Description:         "Represents the ratio of logical space provided to physical data space used. This accounts for compression and data deduplication effects.",
+			MarkdownDescription: "Represents the ratio of logical space provided to physical data space used. This accounts for compression and data deduplication effects.",
+			Computed:            true,
+		},
+		"thresholds": schema.SingleNestedAttribute{
+			Description:         "The thresholds of quota",
+			MarkdownDescription: "The thresholds of quota",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"advisory": schema.Int64Attribute{
+					Description:         "Usage bytes at which notifications will be sent but writes will not be denied.",
+					MarkdownDescription: "Usage bytes at which notifications will be sent but writes will not be denied.",
+					Computed:            true,
+				},
+				"advisory_exceeded": schema.BoolAttribute{
+					Description:         "True if the advisory threshold has been hit.",
+					MarkdownDescription: "True if the advisory threshold has been hit.",
+					Computed:            true,
+				},
+				"advisory_last_exceeded": schema.Int64Attribute{
+					Description:         "Time at which advisory threshold was hit.",
+					MarkdownDescription: "Time at which advisory threshold was hit.",
+					Computed:            true,
+				},
+				"hard": schema.Int64Attribute{
+					Description:         "Usage bytes at which further writes will be denied.",
+					MarkdownDescription: "Usage bytes at which further writes will be denied.",
+					Computed:            true,
+				},
+				"hard_exceeded": schema.BoolAttribute{
+					Description:         "True if the hard threshold has been hit.",
+					MarkdownDescription: "True if the hard threshold has been hit.",
+					Computed:            true,
+				},
+				"hard_last_exceeded": schema.Int64Attribute{
+					Description:         "Time at which hard threshold was hit.",
+					MarkdownDescription: "Time at which hard threshold was hit.",
+					Computed:            true,
+				},
+				"percent_advisory": schema.NumberAttribute{
+					Description:         "Advisory threshold as percent of hard threshold. Usage bytes at which notifications will be sent but writes will not be denied.",
+					MarkdownDescription: "Advisory threshold as percent of hard threshold. Usage bytes at which notifications will be sent but writes will not be denied.",
+					Computed:            true,
+				},
+				"percent_soft": schema.NumberAttribute{
+					Description:         "Soft threshold as percent of hard threshold. Usage bytes at which notifications will be sent and soft grace time will be started.",
+					MarkdownDescription: "Soft threshold as percent of hard threshold. Usage bytes at which notifications will be sent and soft grace time will be started.",
+					Computed:            true,
+				},
+				"soft": schema.Int64Attribute{
+					Description:         "Usage bytes at which notifications will be sent and soft grace time will be started.",
+					MarkdownDescription: "Usage bytes at which notifications will be sent and soft grace time will be started.",
+					Computed:            true,
+				},
+				"soft_exceeded": schema.BoolAttribute{
+					Description:         "True if the soft threshold has been hit.",
+					MarkdownDescription: "True if the soft threshold has been hit.",
+					Computed:            true,
+				},
+				"soft_grace": schema.Int64Attribute{
+					Description:         "Time in seconds after which the soft threshold has been hit before writes will be denied.",
+					MarkdownDescription: "Time in seconds after which the soft threshold has been hit before writes will be denied.",
+					Computed:            true,
+				},
+				"soft_last_exceeded": schema.Int64Attribute{
+					Description:         "Time at which soft threshold was hit",
+					MarkdownDescription: "Time at which soft threshold was hit",
+					Computed:            true,
+				},
+			},
+		},
+		"thresholds_on": schema.StringAttribute{
+			Description:         "Thresholds apply on quota accounting metric.",
+			MarkdownDescription: "Thresholds apply on quota accounting metric.",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "The type of quota.",
+			MarkdownDescription: "The type of quota.",
+			Computed:            true,
+		},
+		"usage": schema.SingleNestedAttribute{
+			Description:         "The usage of quota",
+			MarkdownDescription: "The usage of quota",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"applogical": schema.Int64Attribute{
+					Description:         "Bytes used by governed data apparent to application.",
+					MarkdownDescription: "Bytes used by governed data apparent to application.",
+					Computed:            true,
+				},
+				"applogical_ready": schema.BoolAttribute{
+					Description:         "True if applogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if applogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
+				},
+				"fslogical": schema.Int64Attribute{
+					Description:         "Bytes used by governed data apparent to filesystem.",
+					MarkdownDescription: "Bytes used by governed data apparent to filesystem.",
+					Computed:            true,
+				},
+				"fslogical_ready": schema.BoolAttribute{
+					Description:         "True if fslogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if fslogical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
+				},
+				"fsphysical": schema.Int64Attribute{
+					Description:         "Physical data usage adjusted to account for shadow store efficiency",
+					MarkdownDescription: "Physical data usage adjusted to account for shadow store efficiency",
+					Computed:            true,
+				},
+				"fsphysical_ready": schema.BoolAttribute{
+					Description:         "True if fsphysical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if fsphysical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
+				},
+				"inodes": schema.Int64Attribute{
+					Description:         "Number of inodes (filesystem entities) used by governed data.",
+					MarkdownDescription: "Number of inodes (filesystem entities) used by governed data.",
+					Computed:            true,
+				},
+				"inodes_ready": schema.BoolAttribute{
+					Description:         "True if inodes resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if inodes resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
+				},
+				"physical": schema.Int64Attribute{
+					Description:         "Bytes used for governed data and filesystem overhead.",
+					MarkdownDescription: "Bytes used for governed data and filesystem overhead.",
+					Computed:            true,
+				},
+				"physical_data": schema.Int64Attribute{
+					Description:         "Number of physical blocks for file data",
+					MarkdownDescription: "Number of physical blocks for file data",
+					Computed:            true,
+				},
+				"physical_data_ready": schema.BoolAttribute{
+					Description:         "True if physical_data resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if physical_data resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
+				},
+				"physical_protection": schema.Int64Attribute{
+					Description:         "Number of physical blocks for file protection",
+					MarkdownDescription: "Number of physical blocks for file protection",
+					Computed:            true,
+				},
+				"physical_protection_ready": schema.BoolAttribute{
+					Description:         "True if physical_protection resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if physical_protection resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
+				},
+				"physical_ready": schema.BoolAttribute{
+					Description:         "True if physical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if physical resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
+				},
+				"shadow_refs": schema.Int64Attribute{
+					Description:         "Number of shadow references (cloned, deduplicated or packed filesystem blocks) used by governed data.",
+					MarkdownDescription: "Number of shadow references (cloned, deduplicated or packed filesystem blocks) used by governed data.",
+					Computed:            true,
+				},
+				"shadow_refs_ready": schema.BoolAttribute{
+					Description:         "True if shadow_refs resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					MarkdownDescription: "True if shadow_refs resource accounting is accurate on the quota. If false, this quota is waiting on completion of a QuotaScan job.",
+					Computed:            true,
 				},
 			},
 		},
 	}
+}
 
+// quotaDatasourceFilterAttributes returns the schema of the quota filter, shared by the quota and quota report data sources.
+func quotaDatasourceFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"enforced": schema.BoolAttribute{
+			Description:         "Only list quotas with this enforcement (non-accounting).",
+			MarkdownDescription: "Only list quotas with this enforcement (non-accounting).",
+			Optional:            true,
+		},
+		"exceeded": schema.BoolAttribute{
+			Description:         "Set to true to only list quotas which have exceeded one or more of their thresholds.",
+			MarkdownDescription: "Set to true to only list quotas which have exceeded one or more of their thresholds.",
+			Optional:            true,
+		},
+		"include_snapshots": schema.BoolAttribute{
+			Description:         "Only list quotas with this setting for include_snapshots.",
+			MarkdownDescription: "Only list quotas with this setting for include_snapshots.",
+			Optional:            true,
+		},
+		"path": schema.StringAttribute{
+			Description:         "Only list quotas matching this path (see also recurse_path_*).",
+			MarkdownDescription: "Only list quotas matching this path (see also recurse_path_*).",
+			Optional:            true,
+		},
+		"persona": schema.StringAttribute{
+			Description:         "Only list user or group quotas matching this persona (must be used with the corresponding type argument).",
+			MarkdownDescription: "Only list user or group quotas matching this persona (must be used with the corresponding type argument).",
+			Optional:            true,
+		},
+		"recurse_path_children": schema.BoolAttribute{
+			Description:         "If used with the path argument, match all quotas at that path or any descendent sub-directory.",
+			MarkdownDescription: "If used with the path argument, match all quotas at that path or any descendent sub-directory.",
+			Optional:            true,
+		},
+		"recurse_path_parents": schema.BoolAttribute{
+			Description:         "If used with the path argument, match all quotas at that path or any parent directory.",
+			MarkdownDescription: "If used with the path argument, match all quotas at that path or any parent directory.",
+			Optional:            true,
+		},
+		"report_id": schema.StringAttribute{
+			Description:         "Use the named report as a source rather than the live quotas.",
+			MarkdownDescription: "Use the named report as a source rather than the live quotas.",
+			Optional:            true,
+		},
+		"type": schema.StringAttribute{
+			Description:         "Only list quotas matching this type.",
+			MarkdownDescription: "Only list quotas matching this type.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("directory", "user", "group",
+					"default-directory", "default-user", "default-group"),
+			},
+		},
+		"zone": schema.StringAttribute{
+			Description:         "Optional named zone to use for user and group resolution.",
+			MarkdownDescription: "Optional named zone to use for user and group resolution.",
+			Optional:            true,
+		},
+	}
 }
 
 // Read reads data from the data source.
@@ -0,0 +1,183 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &QuotaListResource{}
+	_ list.ListResourceWithConfigure = &QuotaListResource{}
+)
+
+// NewQuotaListResource returns the quota list resource object.
+func NewQuotaListResource() list.ListResource {
+	return &QuotaListResource{}
+}
+
+// QuotaListResource defines the list resource implementation.
+type QuotaListResource struct {
+	client *client.Client
+}
+
+// Configure configures the list resource.
+func (r *QuotaListResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the list resource arguments.
+func (r *QuotaListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota"
+}
+
+// ListResourceConfigSchema describes the list resource arguments.
+func (r *QuotaListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource is used to discover the quotas of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Description:         "This list resource is used to discover the quotas of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Attributes: map[string]schema.Attribute{
+			"enforced": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list quotas with this enforcement (non-accounting).",
+				Description:         "Only list quotas with this enforcement (non-accounting).",
+			},
+			"exceeded": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Set to true to only list quotas which have exceeded one or more of their thresholds.",
+				Description:         "Set to true to only list quotas which have exceeded one or more of their thresholds.",
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list quotas matching this path (see also recurse_path_children).",
+				Description:         "Only list quotas matching this path (see also recurse_path_children).",
+			},
+			"persona": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list user or group quotas matching this persona (must be used with the corresponding type argument).",
+				Description:         "Only list user or group quotas matching this persona (must be used with the corresponding type argument).",
+			},
+			"recurse_path_children": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "If used with the path argument, match all quotas at that path or any descendent sub-directory.",
+				Description:         "If used with the path argument, match all quotas at that path or any descendent sub-directory.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list quotas matching this type.",
+				Description:         "Only list quotas matching this type.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("directory", "user", "group",
+						"default-directory", "default-user", "default-group"),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional named zone to use for user and group resolution. The zone is also set on the listed quotas.",
+				Description:         "Optional named zone to use for user and group resolution. The zone is also set on the listed quotas.",
+			},
+		},
+	}
+}
+
+// List lists the quotas.
+func (r *QuotaListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.QuotaListModel
+	var diags diag.Diagnostics
+	// the config block is optional
+	if !req.Config.Raw.IsNull() {
+		diags = req.Config.Get(ctx, &config)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	quotas, err := helper.ListQuotas(ctx, r.client, &models.QuotaDatasourceFilter{
+		Enforced:            config.Enforced,
+		Exceeded:            config.Exceeded,
+		IncludeSnapshots:    types.BoolNull(),
+		Path:                config.Path,
+		Persona:             config.Persona,
+		RecursePathChildren: config.RecursePathChildren,
+		RecursePathParents:  types.BoolNull(),
+		ReportID:            types.StringNull(),
+		Type:                config.Type,
+		Zone:                config.Zone,
+	})
+	if err != nil {
+		diags.AddError("Error getting the list of quotas", helper.GetErrorString(err, constants.ListQuotaErrorMsg+"with error: "))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, quota := range quotas {
+			result := req.NewListResult(ctx)
+
+			var model models.QuotaResource
+			if err := helper.CopyFieldsToNonNestedModel(ctx, quota, &model); err != nil {
+				result.Diagnostics.AddError("Error getting the list of quotas",
+					fmt.Sprintf("Could not read quota struct %s with error: %s", quota.Id, err.Error()))
+				push(result)
+				return
+			}
+			result.DisplayName = fmt.Sprintf("%s quota on %s", model.Type.ValueString(), model.Path.ValueString())
+			// persona and zone are set as in an import
+			if model.Type.ValueString() == "directory" {
+				model.Persona = types.ObjectNull(model.Persona.AttributeTypes(ctx))
+			}
+			model.Zone = types.StringNull()
+			if config.Zone.ValueString() != "" {
+				model.Zone = config.Zone
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.QuotaIdentityModel{ID: model.ID, Zone: model.Zone})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &QuotaNotificationResource{}
+	_ resource.ResourceWithConfigure   = &QuotaNotificationResource{}
+	_ resource.ResourceWithImportState = &QuotaNotificationResource{}
+)
+
+// NewQuotaNotificationResource creates a new resource.
+func NewQuotaNotificationResource() resource.Resource {
+	return &QuotaNotificationResource{}
+}
+
+// QuotaNotificationResource defines the resource implementation.
+type QuotaNotificationResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaNotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_notification"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaNotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := quotaNotificationActionAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description:         "ID of the notification rule.",
+		MarkdownDescription: "ID of the notification rule.",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["quota_id"] = schema.StringAttribute{
+		Description:         "ID of the quota the notification rule applies to.",
+		MarkdownDescription: "ID of the quota the notification rule applies to.",
+		Required:            true,
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["threshold"] = schema.StringAttribute{
+		Description:         "Threshold of the quota the notification rule applies to: hard, soft or advisory.",
+		MarkdownDescription: "Threshold of the quota the notification rule applies to: `hard`, `soft` or `advisory`.",
+		Required:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(helper.QuotaNotificationThresholds...)},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["condition"] = schema.StringAttribute{
+		Description:         "Condition on the threshold sending the notification: exceeded, denied, violated or expired.",
+		MarkdownDescription: "Condition on the threshold sending the notification: `exceeded`, `denied`, `violated` or `expired`.",
+		Required:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(helper.QuotaNotificationConditions...)},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the custom notification rules of a quota of PowerScale Array, which notify when a threshold of the quota meets a condition. " +
+			"A quota with custom notification rules no longer uses the default rules of the quota settings. " +
+			"We can Create, Update and Delete the notification rules using this resource. We can also import an existing notification rule by its quota ID and ID.",
+		Description: "This resource is used to manage the custom notification rules of a quota of PowerScale Array, which notify when a threshold of the quota meets a condition. " +
+			"A quota with custom notification rules no longer uses the default rules of the quota settings. " +
+			"We can Create, Update and Delete the notification rules using this resource. We can also import an existing notification rule by its quota ID and ID.",
+		Attributes: attributes,
+	}
+}
+
+// quotaNotificationActionAttributes returns the schema of the actions of a notification rule,
+// shared by the quota notification rules and the default rules of the quota settings.
+func quotaNotificationActionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"action_alert": schema.BoolAttribute{
+			Description:         "Whether an alert is raised through the event channels.",
+			MarkdownDescription: "Whether an alert is raised through the event channels.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"action_email_owner": schema.BoolAttribute{
+			Description:         "Whether the owner of the quota is notified by email.",
+			MarkdownDescription: "Whether the owner of the quota is notified by email.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"action_email_address": schema.StringAttribute{
+			Description:         "Email address notified, in addition to the owner of the quota.",
+			MarkdownDescription: "Email address notified, in addition to the owner of the quota.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"email_template": schema.StringAttribute{
+			Description:         "Path of a custom template laying out the notification emails. The default template is used when empty.",
+			MarkdownDescription: "Path of a custom template laying out the notification emails. The default template is used when empty.",
+			Optional:            true,
+			Computed:            true,
+		},
+		"holdoff": schema.Int64Attribute{
+			Description:         "Seconds the condition must last before the notification is sent.",
+			MarkdownDescription: "Seconds the condition must last before the notification is sent.",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(0)},
+		},
+		"schedule": schema.StringAttribute{
+			Description:         "Schedule of the notifications repeated while the condition lasts, such as \"every day at 9:00\". The notification is sent once when empty.",
+			MarkdownDescription: "Schedule of the notifications repeated while the condition lasts, such as `every day at 9:00`. The notification is sent once when empty.",
+			Optional:            true,
+			Computed:            true,
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaNotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *QuotaNotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating quota notification rule")
+	var plan models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quotaID := plan.QuotaID.ValueString()
+	notificationID, err := helper.CreateQuotaNotification(ctx, r.client, quotaID, helper.QuotaNotificationRuleOf(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating quota notification rule", helper.GetErrorString(err, constants.CreateQuotaNotificationErrorMsg+"with error: "))
+		return
+	}
+	tflog.Debug(ctx, "quota notification rule created", map[string]interface{}{"quotaID": quotaID, "notificationID": notificationID})
+
+	rule, err := helper.GetQuotaNotification(ctx, r.client, quotaID, notificationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating quota notification rule", helper.GetErrorString(err, constants.ReadQuotaNotificationErrorMsg+"with error: "))
+		return
+	}
+	var state models.QuotaNotificationResourceModel
+	helper.UpdateQuotaNotificationState(quotaID, rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating quota notification rule")
+}
+
+// Read reads the resource state.
+func (r *QuotaNotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota notification rule")
+	var state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quotaID, notificationID := state.QuotaID.ValueString(), state.ID.ValueString()
+	rule, err := helper.GetQuotaNotification(ctx, r.client, quotaID, notificationID)
+	if err != nil {
+		if helper.IsPAPINotFound(err) {
+			tflog.Warn(ctx, "quota notification rule not found, removing it from the state", map[string]interface{}{"quotaID": quotaID, "notificationID": notificationID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading quota notification rule", helper.GetErrorString(err, constants.ReadQuotaNotificationErrorMsg+"with error: "))
+		return
+	}
+	helper.UpdateQuotaNotificationState(quotaID, rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading quota notification rule")
+}
+
+// Update updates the resource state.
+func (r *QuotaNotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating quota notification rule")
+	var plan, state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quotaID, notificationID := state.QuotaID.ValueString(), state.ID.ValueString()
+	if err := helper.UpdateQuotaNotification(ctx, r.client, quotaID, notificationID, helper.QuotaNotificationRuleOf(plan)); err != nil {
+		resp.Diagnostics.AddError("Error updating quota notification rule", helper.GetErrorString(err, constants.UpdateQuotaNotificationErrorMsg+"with error: "))
+		return
+	}
+
+	rule, err := helper.GetQuotaNotification(ctx, r.client, quotaID, notificationID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating quota notification rule", helper.GetErrorString(err, constants.ReadQuotaNotificationErrorMsg+"with error: "))
+		return
+	}
+	helper.UpdateQuotaNotificationState(quotaID, rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating quota notification rule")
+}
+
+// Delete deletes the resource.
+func (r *QuotaNotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota notification rule")
+	var state models.QuotaNotificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteQuotaNotification(ctx, r.client, state.QuotaID.ValueString(), state.ID.ValueString()); err != nil && !helper.IsPAPINotFound(err) {
+		resp.Diagnostics.AddError("Error deleting quota notification rule", helper.GetErrorString(err, constants.DeleteQuotaNotificationErrorMsg+"with error: "))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting quota notification rule")
+}
+
+// ImportState imports a notification rule by its quota ID and ID.
+func (r *QuotaNotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id", Parents: []string{"quota_id"}})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quotaID := parsedID.Parents[0]
+	rule, err := helper.GetQuotaNotification(ctx, r.client, quotaID, parsedID.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing quota notification rule", helper.GetErrorString(err, constants.ReadQuotaNotificationErrorMsg+"with error: "))
+		return
+	}
+	var state models.QuotaNotificationResourceModel
+	helper.UpdateQuotaNotificationState(quotaID, rule, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
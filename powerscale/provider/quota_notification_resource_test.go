@@ -0,0 +1,165 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccQuotaNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + QuotaNotificationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerscale_quota_notification.test", "quota_id", "powerscale_quota.notification_test", "id"),
+					resource.TestCheckResourceAttrSet("powerscale_quota_notification.test", "id"),
+					resource.TestCheckResourceAttr("powerscale_quota_notification.test", "threshold", "hard"),
+					resource.TestCheckResourceAttr("powerscale_quota_notification.test", "condition", "exceeded"),
+					resource.TestCheckResourceAttr("powerscale_quota_notification.test", "action_email_owner", "true"),
+					resource.TestCheckResourceAttr("powerscale_quota_notification.test", "holdoff", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_quota_notification.test",
+				ImportState:       true,
+				ImportStateIdFunc: quotaNotificationImportID,
+				ImportStateVerify: true,
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + QuotaNotificationResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_quota_notification.test", "action_email_address", "admin@example.com"),
+					resource.TestCheckResourceAttr("powerscale_quota_notification.test", "holdoff", "600"),
+					resource.TestCheckResourceAttr("powerscale_quota_notification.test", "schedule", "every day at 9:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuotaNotificationResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + QuotaNotificationResourceInvalidConditionConfig,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateQuotaNotification).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaNotificationResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetQuotaNotification).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaNotificationResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaNotificationResourceConfig,
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaNotification).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaNotificationResourceUpdateConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				ResourceName:  "powerscale_quota_notification.test",
+				ImportState:   true,
+				ImportStateId: "notificationId",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func quotaNotificationImportID(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["powerscale_quota_notification.test"]
+	if !ok {
+		return "", fmt.Errorf("powerscale_quota_notification.test not found")
+	}
+	return rs.Primary.Attributes["quota_id"] + "." + rs.Primary.Attributes["id"], nil
+}
+
+var QuotaNotificationQuotaConfig = `
+resource "powerscale_quota" "notification_test" {
+	path              = "/ifs/tfacc_quota_notification"
+	type              = "directory"
+	include_snapshots = false
+	zone              = "System"
+	thresholds = {
+		hard = 4000
+	}
+}
+`
+
+var QuotaNotificationResourceConfig = QuotaNotificationQuotaConfig + `
+resource "powerscale_quota_notification" "test" {
+	quota_id           = powerscale_quota.notification_test.id
+	threshold          = "hard"
+	condition          = "exceeded"
+	action_email_owner = true
+}
+`
+
+var QuotaNotificationResourceUpdateConfig = QuotaNotificationQuotaConfig + `
+resource "powerscale_quota_notification" "test" {
+	quota_id             = powerscale_quota.notification_test.id
+	threshold            = "hard"
+	condition            = "exceeded"
+	action_email_owner   = true
+	action_email_address = "admin@example.com"
+	holdoff              = 600
+	schedule             = "every day at 9:00"
+}
+`
+
+var QuotaNotificationResourceInvalidConditionConfig = `
+resource "powerscale_quota_notification" "test" {
+	quota_id  = "quotaId"
+	threshold = "hard"
+	condition = "full"
+}
+`
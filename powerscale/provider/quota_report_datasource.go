@@ -0,0 +1,214 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &QuotaReportDataSource{}
+	_ datasource.DataSourceWithConfigure = &QuotaReportDataSource{}
+)
+
+// NewQuotaReportDataSource returns the QuotaReport data source object.
+func NewQuotaReportDataSource() datasource.DataSource {
+	return &QuotaReportDataSource{}
+}
+
+// QuotaReportDataSource defines the data source implementation.
+type QuotaReportDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *QuotaReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_report"
+}
+
+// Schema describes the data source arguments.
+func (d *QuotaReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	quotaFilterAttributes := quotaDatasourceFilterAttributes()
+	quotaFilterAttributes["report_id"] = schema.StringAttribute{
+		Description:         "ID of the quota report the quotas are read from.",
+		MarkdownDescription: "ID of the quota report the quotas are read from.",
+		Required:            true,
+		Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the quota reports of PowerScale Array, and the usage of the quotas in a report. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description: "This datasource is used to query the quota reports of PowerScale Array, and the usage of the quotas in a report. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"quota_reports": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of quota reports",
+				MarkdownDescription: "List of quota reports",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "ID of the quota report.",
+							MarkdownDescription: "ID of the quota report.",
+							Computed:            true,
+						},
+						"time": schema.Int64Attribute{
+							Description:         "Time the quota report was generated, in Unix epoch seconds.",
+							MarkdownDescription: "Time the quota report was generated, in Unix epoch seconds.",
+							Computed:            true,
+						},
+						"generated": schema.StringAttribute{
+							Description:         "How the quota report was generated: live, manual or scheduled.",
+							MarkdownDescription: "How the quota report was generated: `live`, `manual` or `scheduled`.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Type of the quota report: summary or detail.",
+							MarkdownDescription: "Type of the quota report: `summary` or `detail`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"quotas": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of the quotas in the report of the quota filter",
+				MarkdownDescription: "List of the quotas in the report of the quota filter",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: quotaDatasourceEntityAttributes(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Description:         "IDs of the quota reports to list.",
+						MarkdownDescription: "IDs of the quota reports to list.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"generated": schema.StringAttribute{
+						Description:         "Only list the quota reports generated this way: live, manual or scheduled.",
+						MarkdownDescription: "Only list the quota reports generated this way: `live`, `manual` or `scheduled`.",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.OneOf("live", "manual", "scheduled")},
+					},
+					"type": schema.StringAttribute{
+						Description:         "Only list the quota reports of this type: summary or detail.",
+						MarkdownDescription: "Only list the quota reports of this type: `summary` or `detail`.",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.OneOf("summary", "detail")},
+					},
+				},
+			},
+			"quota_filter": schema.SingleNestedBlock{
+				Description:         "Filter of the quotas read from a quota report. The quotas are only read when set.",
+				MarkdownDescription: "Filter of the quotas read from a quota report. The quotas are only read when set.",
+				Attributes:          quotaFilterAttributes,
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *QuotaReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *QuotaReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota report data source")
+	var config models.QuotaReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reports, err := helper.ListQuotaReports(ctx, d.client, config.Filter)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading quota reports", helper.GetErrorString(err, constants.ReadQuotaReportErrorMsg+"with error: "))
+		return
+	}
+
+	state := models.QuotaReportDataSourceModel{
+		ID:           types.StringValue("quota_report_datasource"),
+		QuotaReports: []models.QuotaReportDataSourceEntity{},
+		Filter:       config.Filter,
+		QuotaFilter:  config.QuotaFilter,
+	}
+	state.QuotaReports = append(state.QuotaReports, reports...)
+	if config.Filter != nil && len(state.QuotaReports) < len(config.Filter.IDs) {
+		resp.Diagnostics.AddError("Error reading quota reports", fmt.Sprintf("Could not find all the quota reports %v", config.Filter.IDs))
+		return
+	}
+
+	if config.QuotaFilter != nil {
+		quotas, err := helper.ListQuotas(ctx, d.client, config.QuotaFilter)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading quota report", helper.GetErrorString(err, constants.ListQuotaErrorMsg+"with error: "))
+			return
+		}
+		state.Quotas = []models.QuotaDatasourceEntity{}
+		for _, quota := range quotas {
+			entity := models.QuotaDatasourceEntity{}
+			if err := helper.CopyFields(ctx, quota, &entity); err != nil {
+				resp.Diagnostics.AddError("Error reading quota report",
+					fmt.Sprintf("Could not list the quotas of the report with error: %s", err.Error()))
+				return
+			}
+			state.Quotas = append(state.Quotas, entity)
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading quota report data source")
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQuotaReportDatasource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + QuotaReportDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_quota_report.all", "quota_reports.#"),
+					resource.TestCheckResourceAttr("data.powerscale_quota_report.filtered", "quota_reports.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_quota_report.filtered", "quota_reports.0.generated", "manual"),
+					resource.TestCheckResourceAttrSet("data.powerscale_quota_report.filtered", "quotas.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuotaReportDatasourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      ProviderConfig + QuotaReportDatasourceInvalidTypeConfig,
+				ExpectError: regexp.MustCompile("value must be one of"),
+			},
+			{
+				Config:      ProviderConfig + QuotaReportDatasourceUnknownIDConfig,
+				ExpectError: regexp.MustCompile("Could not find all the quota reports"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListQuotaReports).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaReportDatasourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.ListQuotas).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaReportDatasourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaReportDatasourceConfig,
+			},
+		},
+	})
+}
+
+var QuotaReportDatasourceConfig = `
+resource "powerscale_quota_report" "test" {
+}
+
+data "powerscale_quota_report" "all" {
+	depends_on = [powerscale_quota_report.test]
+}
+
+data "powerscale_quota_report" "filtered" {
+	filter {
+		ids       = [powerscale_quota_report.test.id]
+		generated = "manual"
+	}
+	quota_filter {
+		report_id = powerscale_quota_report.test.id
+		type      = "directory"
+	}
+}
+`
+
+var QuotaReportDatasourceInvalidTypeConfig = `
+data "powerscale_quota_report" "invalid" {
+	filter {
+		type = "full"
+	}
+}
+`
+
+var QuotaReportDatasourceUnknownIDConfig = `
+data "powerscale_quota_report" "unknown" {
+	filter {
+		ids = ["unknown_report"]
+	}
+}
+`
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &QuotaReportResource{}
+	_ resource.ResourceWithConfigure   = &QuotaReportResource{}
+	_ resource.ResourceWithImportState = &QuotaReportResource{}
+)
+
+// quotaReportTimeouts bounds the creation, as the cluster generates a manual quota report asynchronously.
+var quotaReportTimeouts = timeouts.Opts{Create: true}
+
+// NewQuotaReportResource creates a new resource.
+func NewQuotaReportResource() resource.Resource {
+	return &QuotaReportResource{}
+}
+
+// QuotaReportResource defines the resource implementation.
+type QuotaReportResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaReportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_report"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaReportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to generate a manual quota usage report of PowerScale Array. " +
+			"The usage in the report can be read with the quota report data source. " +
+			"We can Create and Delete the quota report using this resource. We can also import an existing quota report by its ID.",
+		Description: "This resource is used to generate a manual quota usage report of PowerScale Array. " +
+			"The usage in the report can be read with the quota report data source. " +
+			"We can Create and Delete the quota report using this resource. We can also import an existing quota report by its ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the quota report.",
+				MarkdownDescription: "ID of the quota report.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"time": schema.Int64Attribute{
+				Description:         "Time the quota report was generated, in Unix epoch seconds.",
+				MarkdownDescription: "Time the quota report was generated, in Unix epoch seconds.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"generated": schema.StringAttribute{
+				Description:         "How the quota report was generated: live, manual or scheduled.",
+				MarkdownDescription: "How the quota report was generated: `live`, `manual` or `scheduled`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         "Type of the quota report: summary or detail.",
+				MarkdownDescription: "Type of the quota report: `summary` or `detail`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, quotaReportTimeouts, quotaReportTimeout),
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaReportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create generates a quota report.
+func (r *QuotaReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating quota report")
+	var plan models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, quotaReportTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	reportID, err := helper.CreateQuotaReport(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating quota report", helper.GetErrorString(err, constants.CreateQuotaReportErrorMsg+"with error: "))
+		return
+	}
+	tflog.Debug(ctx, "quota report created", map[string]interface{}{"reportID": reportID})
+
+	state := models.QuotaReportResourceModel{
+		ID:        types.StringValue(reportID),
+		Time:      types.Int64Null(),
+		Generated: types.StringNull(),
+		Type:      types.StringNull(),
+		Timeouts:  plan.Timeouts,
+	}
+	report, err := helper.WaitForQuotaReport(ctx, r.client, reportID)
+	if err != nil {
+		// keep the generated report in the state, so that it is replaced and deleted by the next apply
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		resp.Diagnostics.AddError("Error creating quota report", helper.GetErrorString(err, constants.ReadQuotaReportErrorMsg+"with error: "))
+		return
+	}
+	helper.UpdateQuotaReportState(report, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating quota report")
+}
+
+// Read reads the resource state.
+func (r *QuotaReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota report")
+	var state models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := helper.FindQuotaReport(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading quota report", helper.GetErrorString(err, constants.ReadQuotaReportErrorMsg+"with error: "))
+		return
+	}
+	if report == nil {
+		tflog.Warn(ctx, "quota report not found, removing it from the state", map[string]interface{}{"reportID": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	helper.UpdateQuotaReportState(report, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading quota report")
+}
+
+// Update only saves the timeouts, all the other attributes of a quota report are computed.
+func (r *QuotaReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete deletes the quota report.
+func (r *QuotaReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota report")
+	var state models.QuotaReportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.DeleteQuotaReport(ctx, r.client, state.ID.ValueString()); err != nil && !helper.IsPAPINotFound(err) {
+		resp.Diagnostics.AddError("Error deleting quota report", helper.GetErrorString(err, constants.DeleteQuotaReportErrorMsg+"with error: "))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting quota report")
+}
+
+// ImportState imports a quota report by its ID.
+func (r *QuotaReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := helper.FindQuotaReport(ctx, r.client, parsedID.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing quota report", helper.GetErrorString(err, constants.ReadQuotaReportErrorMsg+"with error: "))
+		return
+	}
+	if report == nil {
+		resp.Diagnostics.AddError("Error importing quota report", fmt.Sprintf("Could not find the quota report %s", parsedID.ID))
+		return
+	}
+	state := models.QuotaReportResourceModel{Timeouts: nullTimeouts(quotaReportTimeouts)}
+	helper.UpdateQuotaReportState(report, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
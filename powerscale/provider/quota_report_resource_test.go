@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQuotaReportResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + QuotaReportResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_quota_report.test", "id"),
+					resource.TestCheckResourceAttrSet("powerscale_quota_report.test", "time"),
+					resource.TestCheckResourceAttr("powerscale_quota_report.test", "generated", "manual"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_quota_report.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccQuotaReportResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateQuotaReport).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaReportResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FindQuotaReport).Return(nil, nil).Build()
+				},
+				Config:      ProviderConfig + QuotaReportResourceShortTimeoutConfig,
+				ExpectError: regexp.MustCompile("timed out waiting for the operation to complete"),
+			},
+			// the report left behind by the timed out creation is in the state and gets replaced
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaReportResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_quota_report.test", "time"),
+					resource.TestCheckResourceAttr("powerscale_quota_report.test", "generated", "manual"),
+				),
+			},
+			{
+				ResourceName:  "powerscale_quota_report.test",
+				ImportState:   true,
+				ImportStateId: "unknown_report",
+				ExpectError:   regexp.MustCompile("Could not find the quota report"),
+			},
+		},
+	})
+}
+
+var QuotaReportResourceConfig = `
+resource "powerscale_quota_report" "test" {
+}
+`
+
+var QuotaReportResourceShortTimeoutConfig = `
+resource "powerscale_quota_report" "test" {
+	timeouts {
+		create = "5s"
+	}
+}
+`
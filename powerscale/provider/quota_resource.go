@@ -30,6 +30,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -40,6 +41,7 @@ import (
 var (
 	_ resource.Resource                = &QuotaResource{}
 	_ resource.ResourceWithImportState = &QuotaResource{}
+	_ resource.ResourceWithIdentity    = &QuotaResource{}
 )
 
 // NewQuotaResource returns the Quota resource object.
@@ -73,6 +75,22 @@ func (r *QuotaResource) Metadata(ctx context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_quota"
 }
 
+// IdentitySchema describes the attributes identifying the quota.
+func (r *QuotaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the quota.",
+			},
+			"zone": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The access zone of the quota. Defaults to the System zone.",
+			},
+		},
+	}
+}
+
 // Schema describes the resource arguments.
 func (r *QuotaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -447,6 +465,7 @@ func (r *QuotaResource) Create(ctx context.Context, request resource.CreateReque
 
 	diags = response.State.Set(ctx, quotaPlan)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.QuotaIdentityModel{ID: quotaPlan.ID, Zone: quotaPlan.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -547,6 +566,7 @@ func (r *QuotaResource) Update(ctx context.Context, request resource.UpdateReque
 
 	diags = response.State.Set(ctx, quotaState)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.QuotaIdentityModel{ID: quotaState.ID, Zone: quotaState.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -610,6 +630,7 @@ func (r *QuotaResource) Read(ctx context.Context, request resource.ReadRequest,
 
 	diags = response.State.Set(ctx, quotaState)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.QuotaIdentityModel{ID: quotaState.ID, Zone: quotaState.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -649,14 +670,22 @@ func (r QuotaResource) Delete(ctx context.Context, request resource.DeleteReques
 // ImportState imports the resource state.
 func (r QuotaResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	tflog.Info(ctx, "importing Quota resource")
-	var zoneName string
-	quotaID := request.ID
-	// request.ID is form of zoneName:exportID
-	if strings.Contains(request.ID, ":") {
-		params := strings.Split(request.ID, ":")
-		quotaID = strings.Trim(params[1], " ")
-		zoneName = strings.Trim(params[0], " ")
+	importID := request.ID
+	// an import by identity is converted to the zoneName:ID form
+	if importID == "" && request.Identity != nil {
+		var identity models.QuotaIdentityModel
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.Zone.ValueString() + ":" + identity.ID.ValueString()
+	}
+	parsedID, diags := helper.ParseImportID(importID, helper.ImportIDFormat{ID: "id", Zoned: true})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
+	quotaID, zoneName := parsedID.ID, parsedID.Zone
 
 	tflog.Debug(ctx, "calling get quota by ID", map[string]interface{}{
 		"QuotaID": quotaID,
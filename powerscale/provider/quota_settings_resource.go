@@ -0,0 +1,293 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &QuotaSettingsResource{}
+	_ resource.ResourceWithConfigure   = &QuotaSettingsResource{}
+	_ resource.ResourceWithImportState = &QuotaSettingsResource{}
+)
+
+// NewQuotaSettingsResource creates a new resource.
+func NewQuotaSettingsResource() resource.Resource {
+	return &QuotaSettingsResource{}
+}
+
+// QuotaSettingsResource defines the resource implementation.
+type QuotaSettingsResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *QuotaSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota_settings"
+}
+
+// Schema describes the resource arguments.
+func (r *QuotaSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ruleAttributes := quotaNotificationActionAttributes()
+	ruleAttributes["id"] = schema.StringAttribute{
+		Description:         "ID of the notification rule.",
+		MarkdownDescription: "ID of the notification rule.",
+		Computed:            true,
+	}
+	ruleAttributes["threshold"] = schema.StringAttribute{
+		Description:         "Threshold of the quotas the notification rule applies to: hard, soft or advisory.",
+		MarkdownDescription: "Threshold of the quotas the notification rule applies to: `hard`, `soft` or `advisory`.",
+		Required:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(helper.QuotaNotificationThresholds...)},
+	}
+	ruleAttributes["condition"] = schema.StringAttribute{
+		Description:         "Condition on the threshold sending the notification: exceeded, denied, violated or expired.",
+		MarkdownDescription: "Condition on the threshold sending the notification: `exceeded`, `denied`, `violated` or `expired`.",
+		Required:            true,
+		Validators:          []validator.String{stringvalidator.OneOf(helper.QuotaNotificationConditions...)},
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the global quota settings of PowerScale Array: the quota reports, the default notification rules " +
+			"applying to the quotas without custom notification rules, and the mappings of authentication domains to email domains. " +
+			"Only the sections set in the configuration are managed. " +
+			"We can Create, Update and Delete the quota settings using this resource. We can also import the existing quota settings. " +
+			"Note that the quota settings are the native functionality of PowerScale, deleting the resource only removes it from the Terraform state.",
+		Description: "This resource is used to manage the global quota settings of PowerScale Array: the quota reports, the default notification rules " +
+			"applying to the quotas without custom notification rules, and the mappings of authentication domains to email domains. " +
+			"Only the sections set in the configuration are managed. " +
+			"We can Create, Update and Delete the quota settings using this resource. We can also import the existing quota settings. " +
+			"Note that the quota settings are the native functionality of PowerScale, deleting the resource only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "ID of the quota settings.",
+				MarkdownDescription: "ID of the quota settings.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reports": schema.SingleNestedAttribute{
+				Description:         "Settings of the quota reports.",
+				MarkdownDescription: "Settings of the quota reports.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"schedule": schema.StringAttribute{
+						Description:         "Schedule of the scheduled reports, such as \"every day at 2:00\". No report is scheduled when empty.",
+						MarkdownDescription: "Schedule of the scheduled reports, such as `every day at 2:00`. No report is scheduled when empty.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"scheduled_dir": schema.StringAttribute{
+						Description:         "Directory the scheduled reports are written to.",
+						MarkdownDescription: "Directory the scheduled reports are written to.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"scheduled_retain": schema.Int64Attribute{
+						Description:         "Number of scheduled reports kept.",
+						MarkdownDescription: "Number of scheduled reports kept.",
+						Optional:            true,
+						Computed:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"manual_dir": schema.StringAttribute{
+						Description:         "Directory the manual reports are written to.",
+						MarkdownDescription: "Directory the manual reports are written to.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"manual_retain": schema.Int64Attribute{
+						Description:         "Number of manual reports kept.",
+						MarkdownDescription: "Number of manual reports kept.",
+						Optional:            true,
+						Computed:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(1)},
+					},
+					"live_dir": schema.StringAttribute{
+						Description:         "Directory the live reports are written to.",
+						MarkdownDescription: "Directory the live reports are written to.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"live_retain": schema.Int64Attribute{
+						Description:         "Number of live reports kept.",
+						MarkdownDescription: "Number of live reports kept.",
+						Optional:            true,
+						Computed:            true,
+						Validators:          []validator.Int64{int64validator.AtLeast(1)},
+					},
+				},
+			},
+			"notifications": schema.ListNestedAttribute{
+				Description:         "Default notification rules of the quotas. The rules of the cluster not in the list are deleted.",
+				MarkdownDescription: "Default notification rules of the quotas. The rules of the cluster not in the list are deleted.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ruleAttributes,
+				},
+			},
+			"mappings": schema.SetNestedAttribute{
+				Description:         "Mappings of authentication domains to the email domains of the notified owners. The mappings of the cluster not in the set are deleted.",
+				MarkdownDescription: "Mappings of authentication domains to the email domains of the notified owners. The mappings of the cluster not in the set are deleted.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							Description:         "Authentication domain of the owners.",
+							MarkdownDescription: "Authentication domain of the owners.",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+						"mapping": schema.StringAttribute{
+							Description:         "Email domain the owners are notified at.",
+							MarkdownDescription: "Email domain the owners are notified at.",
+							Required:            true,
+							Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the resource.
+func (r *QuotaSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create updates the quota settings.
+func (r *QuotaSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating quota settings")
+	var plan models.QuotaSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating quota settings")
+}
+
+// Read reads the resource state.
+func (r *QuotaSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading quota settings")
+	var state models.QuotaSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := helper.GetQuotaSettings(ctx, r.client, state, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading quota settings", helper.GetErrorString(err, constants.ReadQuotaSettingsErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+	tflog.Info(ctx, "Done with reading quota settings")
+}
+
+// Update updates the quota settings.
+func (r *QuotaSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating quota settings")
+	var plan models.QuotaSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating quota settings")
+}
+
+// Delete removes the resource from the state, the cluster keeps its quota settings.
+func (r *QuotaSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting quota settings")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting quota settings")
+}
+
+// ImportState imports all the sections of the quota settings.
+func (r *QuotaSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing quota settings")
+	settings, err := helper.GetQuotaSettings(ctx, r.client, models.QuotaSettingsResourceModel{}, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing quota settings", helper.GetErrorString(err, constants.ReadQuotaSettingsErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &settings)...)
+}
+
+// apply sends the settings of the plan and returns the settings read back.
+func (r *QuotaSettingsResource) apply(ctx context.Context, plan models.QuotaSettingsResourceModel) (models.QuotaSettingsResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if err := helper.UpdateQuotaSettings(ctx, r.client, plan); err != nil {
+		diags.AddError("Error updating quota settings", helper.GetErrorString(err, constants.UpdateQuotaSettingsErrorMsg+"with error: "))
+		return plan, diags
+	}
+	state, err := helper.GetQuotaSettings(ctx, r.client, plan, false)
+	if err != nil {
+		diags.AddError("Error reading quota settings", helper.GetErrorString(err, constants.ReadQuotaSettingsErrorMsg+"with error: "))
+		return plan, diags
+	}
+	return state, diags
+}
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQuotaSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + QuotaSettingsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "id", "quota_settings"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "reports.schedule", "every day at 2:00"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "reports.manual_retain", "10"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "notifications.#", "2"),
+					resource.TestCheckResourceAttrSet("powerscale_quota_settings.test", "notifications.0.id"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "notifications.1.condition", "violated"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "mappings.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "powerscale_quota_settings.test",
+				ImportState:       true,
+				ImportStateId:     "quota_settings",
+				ImportStateVerify: true,
+			},
+			// Update testing, restoring the default settings
+			{
+				Config: ProviderConfig + QuotaSettingsResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "reports.schedule", ""),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "reports.manual_retain", "5"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "notifications.#", "1"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "notifications.0.holdoff", "300"),
+					resource.TestCheckResourceAttr("powerscale_quota_settings.test", "mappings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQuotaSettingsResourceErrors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateQuotaSettings).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetQuotaSettings).Return(models.QuotaSettingsResourceModel{}, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + QuotaSettingsResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + QuotaSettingsResourceUpdateConfig,
+			},
+		},
+	})
+}
+
+var QuotaSettingsResourceConfig = `
+resource "powerscale_quota_settings" "test" {
+	reports = {
+		schedule      = "every day at 2:00"
+		manual_retain = 10
+	}
+	notifications = [
+		{
+			threshold          = "hard"
+			condition          = "exceeded"
+			action_email_owner = true
+		},
+		{
+			threshold = "soft"
+			condition = "violated"
+			schedule  = "every day at 9:00"
+		},
+	]
+	mappings = [
+		{
+			domain  = "TFACC.EXAMPLE.COM"
+			mapping = "example.com"
+		},
+	]
+}
+`
+
+var QuotaSettingsResourceUpdateConfig = `
+resource "powerscale_quota_settings" "test" {
+	reports = {
+		schedule      = ""
+		manual_retain = 5
+	}
+	notifications = [
+		{
+			threshold = "hard"
+			condition = "exceeded"
+			holdoff   = 300
+		},
+	]
+	mappings = []
+}
+`
@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccRoleDataSourceNames(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	var roleTerraformName = "data.powerscale_role.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -52,6 +53,7 @@ func TestAccRoleDataSourceNames(t *testing.T) {
 }
 
 func TestAccRoleDataSourceFilter(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	var roleTerraformName = "data.powerscale_role.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -76,6 +78,7 @@ func TestAccRoleDataSourceFilter(t *testing.T) {
 }
 
 func TestAccRoleDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	var roleTerraformName = "data.powerscale_role.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -93,6 +96,7 @@ func TestAccRoleDataSourceAll(t *testing.T) {
 }
 
 func TestAccRoleDataSourceNamesErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -106,6 +110,7 @@ func TestAccRoleDataSourceNamesErr(t *testing.T) {
 }
 
 func TestAccRoleDataSourceFilterErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -119,6 +124,7 @@ func TestAccRoleDataSourceFilterErr(t *testing.T) {
 }
 
 func TestAccRoleDataSourceGettingErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -135,6 +141,7 @@ func TestAccRoleDataSourceGettingErr(t *testing.T) {
 }
 
 func TestAccRoleDataSourceMappingErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
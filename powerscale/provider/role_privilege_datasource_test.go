@@ -28,6 +28,7 @@ import (
 )
 
 func TestAccRolePrivilegeDataSourceNames(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	var ntpServerTerraformName = "data.powerscale_roleprivilege.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -52,6 +53,7 @@ func TestAccRolePrivilegeDataSourceNames(t *testing.T) {
 }
 
 func TestAccRolePrivilegeDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	var ntpServerTerraformName = "data.powerscale_roleprivilege.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -69,6 +71,7 @@ func TestAccRolePrivilegeDataSourceAll(t *testing.T) {
 }
 
 func TestAccRolePrivilegeDataSourceNamesErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -82,6 +85,7 @@ func TestAccRolePrivilegeDataSourceNamesErr(t *testing.T) {
 }
 
 func TestAccRolePrivilegeDataSourceFilterErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -95,6 +99,7 @@ func TestAccRolePrivilegeDataSourceFilterErr(t *testing.T) {
 }
 
 func TestAccRolePrivilegeDataSourceGettingErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -111,6 +116,7 @@ func TestAccRolePrivilegeDataSourceGettingErr(t *testing.T) {
 }
 
 func TestAccRolePrivilegeDataSourceMappingErr(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
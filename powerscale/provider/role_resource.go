@@ -535,13 +535,12 @@ func (r *RoleResource) ImportState(ctx context.Context, req resource.ImportState
 	tflog.Info(ctx, "importing role")
 	var roleState models.RoleResourceModel
 
-	roleID := req.ID
-	var zoneID string
-	if strings.Contains(req.ID, ":") {
-		params := strings.Split(req.ID, ":")
-		zoneID = strings.Trim(params[0], " ")
-		roleID = strings.Trim(params[1], " ")
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id", Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	roleID, zoneID := parsedID.ID, parsedID.Zone
 
 	roleState.ID = types.StringValue(roleID)
 	roleState.Zone = types.StringValue(zoneID)
@@ -31,6 +31,7 @@ import (
 )
 
 func TestAccRoleResource(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -76,6 +77,7 @@ func TestAccRoleResource(t *testing.T) {
 }
 
 func TestAccRoleResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -141,6 +143,7 @@ func TestAccRoleResourceErrorRead(t *testing.T) {
 }
 
 func TestAccRoleResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -154,6 +157,7 @@ func TestAccRoleResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccRoleResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -196,6 +200,7 @@ func TestAccRoleResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccRoleResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -213,6 +218,7 @@ func TestAccRoleResourceErrorReadState(t *testing.T) {
 }
 
 func TestAccRoleResourceReorderMemberError(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -247,6 +253,7 @@ func TestAccRoleResourceReorderMemberError(t *testing.T) {
 }
 
 func TestAccRoleResourceReorderPrivilegeError(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -281,6 +288,7 @@ func TestAccRoleResourceReorderPrivilegeError(t *testing.T) {
 }
 
 func TestAccRoleResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "role privileges are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -448,14 +448,12 @@ func (r S3BucketResource) Delete(ctx context.Context, request resource.DeleteReq
 // ImportState imports the resource state.
 func (r S3BucketResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing S3 Bucket resource")
-	var zoneName string
-	bucketID := request.ID
-	// request.ID is form of zoneName:bucketID
-	if strings.Contains(request.ID, ":") {
-		params := strings.Split(request.ID, ":")
-		bucketID = strings.Trim(params[1], " ")
-		zoneName = strings.Trim(params[0], " ")
+	parsedID, diags := helper.ParseImportID(request.ID, helper.ImportIDFormat{ID: "id", Zoned: true})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
+	bucketID, zoneName := parsedID.ID, parsedID.Zone
 
 	bucketResponse, err := helper.GetS3Bucket(ctx, r.client, bucketID, zoneName)
 	if err != nil {
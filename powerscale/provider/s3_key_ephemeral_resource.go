@@ -0,0 +1,194 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &S3KeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &S3KeyEphemeralResource{}
+)
+
+// NewS3KeyEphemeralResource returns the S3 Key ephemeral resource object.
+func NewS3KeyEphemeralResource() ephemeral.EphemeralResource {
+	return &S3KeyEphemeralResource{}
+}
+
+// S3KeyEphemeralResource defines the ephemeral resource implementation.
+type S3KeyEphemeralResource struct {
+	client *client.Client
+}
+
+// Configure configures the ephemeral resource.
+func (r *S3KeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, res *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the ephemeral resource arguments.
+func (r *S3KeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_s3_key"
+}
+
+// Schema describes the ephemeral resource arguments.
+func (r *S3KeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This ephemeral resource is used to read or generate an S3 Key of PowerScale Array without storing it in the Terraform state or plan." +
+			" By default the existing key of the user is returned, PowerScale does not return its secret key." +
+			" When generate is true, a new secret key is generated every time the ephemeral resource is opened, i.e. in each plan and apply," +
+			" and the previous secret key of the user remains valid for existing_key_expiry_time minutes.",
+		Description: "This ephemeral resource is used to read or generate an S3 Key of PowerScale Array without storing it in the Terraform state or plan." +
+			" By default the existing key of the user is returned, PowerScale does not return its secret key." +
+			" When generate is true, a new secret key is generated every time the ephemeral resource is opened, i.e. in each plan and apply," +
+			" and the previous secret key of the user remains valid for existing_key_expiry_time minutes.",
+		Attributes: map[string]schema.Attribute{
+			"access_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Unique identifier of the S3 key.",
+				Description:         "Unique identifier of the S3 key.",
+			},
+			"user": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username to generate the S3 key for.",
+				Description:         "The username to generate the S3 key for.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(?:\S.*\S|\S)$`), "must contain atleast one character and no leading or trailing spaces"),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The zone of the user.",
+				Description:         "The zone of the user.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(?:\S.*\S|\S)$`), "must contain atleast one character and no leading or trailing spaces"),
+				},
+			},
+			"generate": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to generate a new key, rotating the secret key of the user on every open. Defaults to false, returning the existing key.",
+				Description:         "Whether to generate a new key, rotating the secret key of the user on every open. Defaults to false, returning the existing key.",
+			},
+			"existing_key_expiry_time": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "The expiry of the old secret key in minutes. It will be applicable only if generate is true and old_secret_key is exist.",
+				Description:         "The expiry of the old secret key in minutes. It will be applicable only if generate is true and old_secret_key is exist.",
+			},
+			"secret_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key of the key. Only returned when generate is true.",
+				Description:         "The secret key of the key. Only returned when generate is true.",
+			},
+			"secret_key_timestamp": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp of the secret key.",
+				Description:         "The timestamp of the secret key.",
+			},
+			"old_secret_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The secret key of the old key.",
+				Description:         "The secret key of the old key.",
+			},
+			"old_key_expiry": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The expiry of the old key.",
+				Description:         "The expiry of the old key.",
+			},
+			"old_key_timestamp": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The timestamp of the old key.",
+				Description:         "The timestamp of the old key.",
+			},
+		},
+	}
+}
+
+// Open reads the S3 key, or generates a new one when generate is true.
+func (r *S3KeyEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var s3key models.S3KeyEphemeralData
+	diags := request.Config.Get(ctx, &s3key)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	keyData := models.S3KeyResourceData{
+		User:                  s3key.User,
+		Zone:                  s3key.Zone,
+		ExistingKeyExpiryTime: s3key.ExistingKeyExpiryTime,
+	}
+
+	if !s3key.Generate.ValueBool() {
+		resp, err := helper.GetS3Key(ctx, r.client, keyData)
+		if err != nil {
+			response.Diagnostics.AddError("Error reading s3 key ", err.Error())
+			return
+		}
+		if resp.Keys.GetAccessId() == "" {
+			response.Diagnostics.AddError("Error reading s3 key ",
+				fmt.Sprintf("User %s of zone %s has no s3 key, set generate to true to generate one", s3key.User.ValueString(), s3key.Zone.ValueString()))
+			return
+		}
+		err = helper.CopyFieldsToNonNestedModel(ctx, resp.Keys, &s3key)
+		if err != nil {
+			response.Diagnostics.AddError("Error reading s3 key ", err.Error())
+			return
+		}
+		diags = response.Result.Set(ctx, s3key)
+		response.Diagnostics.Append(diags...)
+		return
+	}
+
+	resp, err := helper.GenerateS3Key(ctx, r.client, keyData)
+	if err != nil {
+		response.Diagnostics.AddError("Error generating s3 key ", err.Error())
+		return
+	}
+	err = helper.CopyFieldsToNonNestedModel(ctx, resp.Keys, &s3key)
+	if err != nil {
+		response.Diagnostics.AddError("Error generating s3 key ", err.Error())
+		return
+	}
+	diags = response.Result.Set(ctx, s3key)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccS3KeyEphemeralResource(t *testing.T) {
+	skipIfSimulated(t, "S3 keys are not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6EchoProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// ephemeral resources are supported from Terraform 1.10
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + S3KeyEphemeralResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.s3_key", "data.user", "admin"),
+					resource.TestCheckResourceAttrSet("echo.s3_key", "data.access_id"),
+					resource.TestCheckResourceAttrSet("echo.s3_key", "data.secret_key"),
+				),
+			},
+			{
+				// reading the existing key must not rotate it
+				Config: ProviderConfig + S3KeyEphemeralResourceReadConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.s3_key", "data.user", "admin"),
+					resource.TestCheckResourceAttrSet("echo.s3_key", "data.access_id"),
+					resource.TestCheckNoResourceAttr("echo.s3_key", "data.secret_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3KeyEphemeralResourceMockErr(t *testing.T) {
+	skipIfSimulated(t, "S3 keys are not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6EchoProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GenerateS3Key).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + S3KeyEphemeralResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.CopyFieldsToNonNestedModel).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + S3KeyEphemeralResourceConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetS3Key).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + S3KeyEphemeralResourceReadConfig,
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
+		},
+	})
+}
+
+var S3KeyEphemeralResourceConfig = `
+ephemeral "powerscale_s3_key" "tf_test" {
+	user = "admin"
+	zone = "System"
+	generate = true
+	existing_key_expiry_time = 10
+}
+
+provider "echo" {
+	data = ephemeral.powerscale_s3_key.tf_test
+}
+
+resource "echo" "s3_key" {}
+`
+
+var S3KeyEphemeralResourceReadConfig = `
+ephemeral "powerscale_s3_key" "tf_test" {
+	user = "admin"
+	zone = "System"
+}
+
+provider "echo" {
+	data = ephemeral.powerscale_s3_key.tf_test
+}
+
+resource "echo" "s3_key" {}
+`
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
@@ -32,11 +33,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &S3KeyResource{}
+	_ resource.Resource                = &S3KeyResource{}
+	_ resource.ResourceWithImportState = &S3KeyResource{}
 )
 
 // NewS3KeyResource returns the S3 Key resource object.
@@ -76,10 +79,10 @@ func (r *S3KeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "This resource is used to manage the S3 Key Entity of PowerScale Array." +
 			" PowerScale S3 keys are used to sign the requests you send to the S3 protocol." +
-			" We can Create, Update and Delete the S3 Key using this resource.",
+			" We can Create, Update, Delete and Import the S3 Key using this resource.",
 		Description: "This resource is used to manage the S3 Key Entity of PowerScale Array." +
 			" PowerScale S3 keys are used to sign the requests you send to the S3 protocol." +
-			" We can Create, Update and Delete the S3 Key using this resource.",
+			" We can Create, Update, Delete and Import the S3 Key using this resource.",
 		Attributes: S3KeyResourceSchema(),
 	}
 }
@@ -195,11 +198,12 @@ func (r *S3KeyResource) Read(ctx context.Context, request resource.ReadRequest,
 
 	// precheck to invalidate the refresh
 	errMsg := "[UNKNOWN KEY] Key Generated Outside of Terraform"
-	// A discrepancy between the old key timestamp and the secret key timestamp indicates that the key was created externally, not through Terraform.
-	if resp.Keys.GetOldKeyTimestamp() != int32(s3key.SecretKeyTimestamp.ValueInt64()) {
-		s3key.OldSecretKey = types.StringValue(errMsg)
-	} else {
+	// The old key is kept when it did not change, and is the secret key of the state when the key was rotated since.
+	// Otherwise the old key was created externally, not through Terraform.
+	if resp.Keys.GetOldKeyTimestamp() == int32(s3key.SecretKeyTimestamp.ValueInt64()) {
 		s3key.OldSecretKey = s3key.SecretKey
+	} else if resp.Keys.GetOldKeyTimestamp() != int32(s3key.OldKeyTimestamp.ValueInt64()) {
+		s3key.OldSecretKey = types.StringValue(errMsg)
 	}
 	// the secret key's timestamp not align post get key, it implies the key was generated outside of Terraform.
 	if resp.Keys.GetSecretKeyTimestamp() != int32(s3key.SecretKeyTimestamp.ValueInt64()) {
@@ -268,3 +272,51 @@ func (r S3KeyResource) Delete(ctx context.Context, request resource.DeleteReques
 
 	response.State.RemoveResource(ctx)
 }
+
+// ImportState imports the S3 key of a user by <zone>/<user>.
+// The secret keys are only returned when a key is generated, so they are left empty,
+// and existing_key_expiry_time is derived from the expiry of the old key.
+func (r *S3KeyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing S3 Key resource")
+	importID := request.ID
+	// <zone>/<user> is turned into the <zone>:<user> form of the other zoned resources
+	if zone, user, ok := strings.Cut(importID, "/"); ok && !strings.ContainsAny(importID, ":{") {
+		importID = zone + ":" + user
+	}
+	parsedID, diags := helper.ParseImportID(importID, helper.ImportIDFormat{ID: "user", Zoned: true})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	s3key := models.S3KeyResourceData{
+		User: types.StringValue(parsedID.ID),
+		Zone: types.StringValue(parsedID.Zone),
+	}
+	if parsedID.Zone == "" {
+		s3key.Zone = types.StringValue("System")
+	}
+
+	resp, err := helper.GetS3Key(ctx, r.client, s3key)
+	if err != nil {
+		response.Diagnostics.AddError("Error importing s3 key ", err.Error())
+		return
+	}
+	if resp.Keys.GetAccessId() == "" {
+		response.Diagnostics.AddError("Error importing s3 key ",
+			fmt.Sprintf("User %s of zone %s has no s3 key", parsedID.ID, s3key.Zone.ValueString()))
+		return
+	}
+	err = helper.CopyFieldsToNonNestedModel(ctx, resp.Keys, &s3key)
+	if err != nil {
+		response.Diagnostics.AddError("Error importing s3 key ", err.Error())
+		return
+	}
+	s3key.SecretKey = types.StringNull()
+	s3key.OldSecretKey = types.StringNull()
+	s3key.ExistingKeyExpiryTime = types.Int64Null()
+	// the old key expires existing_key_expiry_time minutes after the current key was generated
+	if expiry, generated := resp.Keys.GetOldKeyExpiry(), resp.Keys.GetSecretKeyTimestamp(); expiry > 0 && expiry >= generated {
+		s3key.ExistingKeyExpiryTime = types.Int64Value((int64(expiry-generated) + 30) / 60)
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, s3key)...)
+}
@@ -29,6 +29,7 @@ import (
 )
 
 func TestAccS3KeyResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "S3 keys are not simulated")
 	var S3KeyResourceConfigCreateError = tfConfig("tf_err_test", "invalid", "invalid", 80)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -43,6 +44,7 @@ func TestAccS3KeyResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccS3KeyResource(t *testing.T) {
+	skipIfSimulated(t, "S3 keys are not simulated")
 	var S3KeyResourceConfig = tfConfig("tf_test", "admin", "System", 40)
 	var S3KeyResourceConfigUpdate = tfConfig("tf_test", "admin", "System", 80)
 	var S3KeyResourceConfigUpdateError = tfConfig("tf_test", "admin", "System", -80)
@@ -102,6 +104,31 @@ func TestAccS3KeyResource(t *testing.T) {
 					resource.TestCheckResourceAttr("powerscale_s3_key.tf_test", "user", "admin"),
 				),
 			},
+			// Import testing, the secret keys are only returned on generation
+			{
+				ResourceName:                         "powerscale_s3_key.tf_test",
+				ImportState:                          true,
+				ImportStateId:                        "System/admin",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"existing_key_expiry_time", "secret_key", "old_secret_key"},
+				ImportStateVerifyIdentifierAttribute: "access_id",
+			},
+			{
+				ResourceName:  "powerscale_s3_key.tf_test",
+				ImportState:   true,
+				ImportStateId: "System/admin",
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.GetS3Key).Return(nil, fmt.Errorf("import error")).Build()
+				},
+				ExpectError: regexp.MustCompile("import error"),
+			},
+			{
+				ResourceName:  "powerscale_s3_key.tf_test",
+				ImportState:   true,
+				ImportStateId: "System/",
+				PreConfig:     func() { FunctionMocker.UnPatch() },
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
 			// Update Error testing
 			{
 				Config:      ProviderConfig + S3KeyResourceConfigUpdateError,
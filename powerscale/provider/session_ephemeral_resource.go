@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// sessionPrivateKey is the private data key holding the session to delete on close.
+const sessionPrivateKey = "session"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &SessionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &SessionEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &SessionEphemeralResource{}
+)
+
+// NewSessionEphemeralResource returns the PAPI session ephemeral resource object.
+func NewSessionEphemeralResource() ephemeral.EphemeralResource {
+	return &SessionEphemeralResource{}
+}
+
+// SessionEphemeralResource defines the ephemeral resource implementation.
+type SessionEphemeralResource struct {
+	client *client.Client
+}
+
+// Configure configures the ephemeral resource.
+func (r *SessionEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, res *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the ephemeral resource arguments.
+func (r *SessionEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session"
+}
+
+// Schema describes the ephemeral resource arguments.
+func (r *SessionEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This ephemeral resource is used to create a short-lived PowerScale Platform API (PAPI) session without storing its token in the Terraform state or plan." +
+			" The session is created on the endpoint of the provider when the ephemeral resource is opened, and deleted when it is closed at the end of the Terraform run.",
+		Description: "This ephemeral resource is used to create a short-lived PowerScale Platform API (PAPI) session without storing its token in the Terraform state or plan." +
+			" The session is created on the endpoint of the provider when the ephemeral resource is opened, and deleted when it is closed at the end of the Terraform run.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username to create the session for.",
+				Description:         "The username to create the session for.",
+				Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the user.",
+				Description:         "The password of the user.",
+			},
+			"session_id": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The identifier of the session, sent as isisessid cookie.",
+				Description:         "The identifier of the session, sent as isisessid cookie.",
+			},
+			"csrf_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The CSRF token of the session, sent as X-CSRF-Token header.",
+				Description:         "The CSRF token of the session, sent as X-CSRF-Token header.",
+			},
+			"cookie": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The value of the Cookie header authenticating a request with the session.",
+				Description:         "The value of the Cookie header authenticating a request with the session.",
+			},
+			"timeout_absolute": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of seconds after which the session expires.",
+				Description:         "The number of seconds after which the session expires.",
+			},
+			"timeout_inactive": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The number of seconds after which the session expires when it is not used.",
+				Description:         "The number of seconds after which the session expires when it is not used.",
+			},
+		},
+	}
+}
+
+// Open creates the session.
+func (r *SessionEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var session models.SessionEphemeralResourceData
+	diags := request.Config.Get(ctx, &session)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	token, err := r.client.CreateSessionToken(ctx, session.Username.ValueString(), session.Password.ValueString())
+	if err != nil {
+		response.Diagnostics.AddError("Error creating session ", err.Error())
+		return
+	}
+	private, err := json.Marshal(token)
+	if err != nil {
+		response.Diagnostics.AddError("Error creating session ", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Private.SetKey(ctx, sessionPrivateKey, private)...)
+
+	session.SessionID = types.StringValue(token.ID)
+	session.CSRFToken = types.StringValue(token.CSRFToken)
+	session.Cookie = types.StringValue("isisessid=" + token.ID)
+	session.TimeoutAbsolute = types.Int64Value(int64(token.TimeoutAbsolute.Seconds()))
+	session.TimeoutInactive = types.Int64Value(int64(token.TimeoutInactive.Seconds()))
+	diags = response.Result.Set(ctx, session)
+	response.Diagnostics.Append(diags...)
+}
+
+// Close deletes the session.
+func (r *SessionEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	private, diags := request.Private.GetKey(ctx, sessionPrivateKey)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || private == nil {
+		return
+	}
+	var token client.SessionToken
+	if err := json.Unmarshal(private, &token); err != nil {
+		response.Diagnostics.AddError("Error deleting session ", err.Error())
+		return
+	}
+	if err := r.client.DeleteSessionToken(ctx, token); err != nil {
+		response.Diagnostics.AddError("Error deleting session ", err.Error())
+	}
+}
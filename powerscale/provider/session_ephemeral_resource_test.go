@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSessionEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6EchoProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// ephemeral resources are supported from Terraform 1.10
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + sessionEphemeralResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.session", "data.session_id"),
+					resource.TestCheckResourceAttrSet("echo.session", "data.csrf_token"),
+					resource.TestMatchResourceAttr("echo.session", "data.cookie", regexp.MustCompile(`^isisessid=`)),
+				),
+			},
+			{
+				Config:      ProviderConfig + SessionEphemeralResourceInvalidConfig,
+				ExpectError: regexp.MustCompile(`.*Error creating session*.`),
+			},
+		},
+	})
+}
+
+func TestAccSessionEphemeralResourceMockErr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6EchoProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock((*client.Client).DeleteSessionToken).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + sessionEphemeralResourceConfig(),
+				ExpectError: regexp.MustCompile(`.*Error deleting session*.`),
+			},
+		},
+	})
+}
+
+func sessionEphemeralResourceConfig() string {
+	return fmt.Sprintf(`
+ephemeral "powerscale_session" "tf_test" {
+	username = "%s"
+	password = "%s"
+}
+
+provider "echo" {
+	data = ephemeral.powerscale_session.tf_test
+}
+
+resource "echo" "session" {}
+`, powerscaleUsername, powerscalePassword)
+}
+
+var SessionEphemeralResourceInvalidConfig = `
+ephemeral "powerscale_session" "tf_test" {
+	username = "invalid"
+	password = "invalid"
+}
+
+provider "echo" {
+	data = ephemeral.powerscale_session.tf_test
+}
+
+resource "echo" "session" {}
+`
@@ -34,6 +34,7 @@ import (
 
 // TestAccSmartPoolSettingsDatasource UT for SmartPoolSettingsDatasource, currently the test is against PowerScale 9.4.
 func TestAccSmartPoolSettingsDatasource(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "data.powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -69,6 +70,7 @@ func TestAccSmartPoolSettingsDatasource(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsDatasourceNone(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "data.powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -98,6 +100,7 @@ func TestAccSmartPoolSettingsDatasourceNone(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsDatasourceAll(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "data.powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -127,6 +130,7 @@ func TestAccSmartPoolSettingsDatasourceAll(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsDatasourceErrorRequest(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -150,6 +154,7 @@ func TestAccSmartPoolSettingsDatasourceErrorRequest(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsDatasourceErrorType(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -174,6 +179,7 @@ func TestAccSmartPoolSettingsDatasourceErrorType(t *testing.T) {
 
 // TestAccSmartPoolSettingsDatasourceV16 UT for SmartPoolSettingsDatasource using mock response from PowerScale 9.5.
 func TestAccSmartPoolSettingsDatasourceV16(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "data.powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -184,7 +190,7 @@ func TestAccSmartPoolSettingsDatasourceV16(t *testing.T) {
 				PreConfig: func() {
 					FunctionMocker = Mock(helper.GetSmartPoolSettings).Build().
 						When(func(ctx context.Context, powerscaleClient *client.Client) bool {
-							onefsVersion, _ := powerscaleClient.GetOnefsVersion()
+							onefsVersion, _ := powerscaleClient.GetOnefsVersion(context.Background())
 
 							if strings.Contains(powerscaleClient.PscaleOpenAPIClient.GetConfig().Servers[0].URL, "localhost") {
 								// enforce 9.5 (i.e. v16) endpoint in mock server
@@ -236,6 +242,7 @@ func TestAccSmartPoolSettingsDatasourceV16(t *testing.T) {
 
 // TestAccSmartPoolSettingsDatasourceV16_files_at_default UT for SmartPoolSettingsDatasource using mock response from PowerScale 9.5.
 func TestAccSmartPoolSettingsDatasourceV16FilesAtDefault(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "data.powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -266,6 +273,7 @@ func TestAccSmartPoolSettingsDatasourceV16FilesAtDefault(t *testing.T) {
 
 // TestAccSmartPoolSettingsDatasourceV16_none UT for SmartPoolSettingsDatasource using mock response from PowerScale 9.5.
 func TestAccSmartPoolSettingsDatasourceV16None(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "data.powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -296,6 +304,7 @@ func TestAccSmartPoolSettingsDatasourceV16None(t *testing.T) {
 
 // TestAccSmartPoolSettingsDatasourceV16_all UT for SmartPoolSettingsDatasource using mock response from PowerScale 9.5.
 func TestAccSmartPoolSettingsDatasourceV16All(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "data.powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -27,6 +27,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -35,10 +36,17 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource              = &SmartPoolSettingResource{}
-	_ resource.ResourceWithConfigure = &SmartPoolSettingResource{}
+	_ resource.Resource               = &SmartPoolSettingResource{}
+	_ resource.ResourceWithConfigure  = &SmartPoolSettingResource{}
+	_ resource.ResourceWithModifyPlan = &SmartPoolSettingResource{}
 )
 
+// smartPoolSettingVersionRequirements lists the attributes depending on the OneFS version.
+var smartPoolSettingVersionRequirements = []helper.VersionRequirement{
+	{Attribute: path.MatchRoot("default_transfer_limit_state"), MinVersion: "9.5.0"},
+	{Attribute: path.MatchRoot("default_transfer_limit_pct"), MinVersion: "9.5.0"},
+}
+
 // NewSmartPoolSettingResource creates a new resource.
 func NewSmartPoolSettingResource() resource.Resource {
 	return &SmartPoolSettingResource{}
@@ -243,6 +251,14 @@ func (r *SmartPoolSettingResource) Configure(ctx context.Context, req resource.C
 	r.client = pscaleClient
 }
 
+// ModifyPlan validates the configuration against the OneFS version of the cluster.
+func (r *SmartPoolSettingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(helper.CheckVersionRequirements(ctx, r.client, req.Config, smartPoolSettingVersionRequirements)...)
+}
+
 // Create allocates the resource.
 func (r *SmartPoolSettingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Info(ctx, "Creating SmartPoolSettings resource...")
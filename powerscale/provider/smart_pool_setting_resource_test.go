@@ -35,6 +35,7 @@ import (
 )
 
 func TestAccSmartPoolSettingsResourceCreate(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -69,6 +70,7 @@ func TestAccSmartPoolSettingsResourceCreate(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceUpdate(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var restV5UpdateFuncMocker *Mocker
 	var restV16UpdateFuncMocker *Mocker
 	resource.Test(t, resource.TestCase{
@@ -82,7 +84,7 @@ func TestAccSmartPoolSettingsResourceUpdate(t *testing.T) {
 					restV5UpdateFuncMocker = Mock(powerscale.ApiUpdateStoragepoolv5StoragepoolSettingsRequest.Execute).Return(nil, nil).Build()
 					restV16UpdateFuncMocker = Mock(powerscale.ApiUpdateStoragepoolv16StoragepoolSettingsRequest.Execute).Return(nil, nil).Build()
 					FunctionMocker = Mock(helper.GetSmartPoolSettings).To(func(ctx context.Context, powerscaleClient *client.Client) (any, error) {
-						onefsVersion, _ := powerscaleClient.GetOnefsVersion()
+						onefsVersion, _ := powerscaleClient.GetOnefsVersion(context.Background())
 						if restV5UpdateFuncMocker.MockTimes() > 0 {
 							return mockV5StoragepoolSettingsAfterUpdate, nil
 						}
@@ -113,6 +115,7 @@ func TestAccSmartPoolSettingsResourceUpdate(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceUpdateIoOptimizationErr(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -131,6 +134,7 @@ func TestAccSmartPoolSettingsResourceUpdateIoOptimizationErr(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceUpdateManageProtectionErr(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -148,7 +152,31 @@ func TestAccSmartPoolSettingsResourceUpdateManageProtectionErr(t *testing.T) {
 	})
 }
 
+func TestAccSmartPoolSettingsResourceVersionRequirement(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = Mock((*client.Client).GetOnefsVersion).Return(&client.OnefsVersion{Major: 9, Minor: 4, Patch: 0, Release: "9.4.0.17"}, nil).Build()
+				},
+				Config:      ProviderConfig + transferLimitPoolSettingResourceConfig,
+				ExpectError: regexp.MustCompile(`.*Unsupported OneFS version*.`),
+			},
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if FunctionMocker != nil {
+				FunctionMocker.UnPatch()
+			}
+			return nil
+		},
+	})
+}
+
 func TestAccSmartPoolSettingsResourceCreateErrorRequest(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -171,6 +199,7 @@ func TestAccSmartPoolSettingsResourceCreateErrorRequest(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceUpdateErrorRequest(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var updateFuncMocker *Mocker
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -184,7 +213,7 @@ func TestAccSmartPoolSettingsResourceUpdateErrorRequest(t *testing.T) {
 							if updateFuncMocker.MockTimes() > 0 {
 								return nil, fmt.Errorf("mock error")
 							}
-							onefsVersion, _ := powerscaleClient.GetOnefsVersion()
+							onefsVersion, _ := powerscaleClient.GetOnefsVersion(context.Background())
 							if onefsVersion.IsGreaterThan("9.4.0") {
 								return mockV16StoragepoolSettingsBeforeUpdate, nil
 							}
@@ -209,6 +238,7 @@ func TestAccSmartPoolSettingsResourceUpdateErrorRequest(t *testing.T) {
 
 // TestAccSmartPoolSettingsResourceV16 UT for SmartPoolSettingsResource using mock response from PowerScale 9.5.
 func TestAccSmartPoolSettingsResourceV16(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "powerscale_smartpool_settings.settings"
 	var restV5UpdateFuncMocker *Mocker
 	var restV16UpdateFuncMocker *Mocker
@@ -223,7 +253,7 @@ func TestAccSmartPoolSettingsResourceV16(t *testing.T) {
 					restV16UpdateFuncMocker = Mock(powerscale.ApiUpdateStoragepoolv16StoragepoolSettingsRequest.Execute).Return(nil, nil).Build()
 					FunctionMocker = Mock(helper.GetSmartPoolSettings).Build().
 						When(func(ctx context.Context, powerscaleClient *client.Client) bool {
-							onefsVersion, _ := powerscaleClient.GetOnefsVersion()
+							onefsVersion, _ := powerscaleClient.GetOnefsVersion(context.Background())
 							if strings.Contains(powerscaleClient.PscaleOpenAPIClient.GetConfig().Servers[0].URL, "localhost") {
 								powerscaleClient.SetOnefsVersion(9, 5, 0)
 								return false
@@ -277,6 +307,7 @@ func TestAccSmartPoolSettingsResourceV16(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceUpdateErrorPutRequest(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var updateFuncMocker *Mocker
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -315,6 +346,7 @@ func TestAccSmartPoolSettingsResourceUpdateErrorPutRequest(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceUpdateErrorGetRequest(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -343,6 +375,7 @@ func TestAccSmartPoolSettingsResourceUpdateErrorGetRequest(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceUpdateErrorUpdatingModel(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -366,6 +399,7 @@ func TestAccSmartPoolSettingsResourceUpdateErrorUpdatingModel(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceImport(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -406,6 +440,7 @@ func TestAccSmartPoolSettingsResourceImport(t *testing.T) {
 }
 
 func TestAccSmartPoolSettingsResourceImportErr(t *testing.T) {
+	skipIfSimulated(t, "the SmartPools settings are not simulated")
 	var data = "powerscale_smartpool_settings.settings"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -481,6 +516,12 @@ resource "powerscale_smartpool_settings" "settings" {
 }
 `
 
+var transferLimitPoolSettingResourceConfig = `
+resource "powerscale_smartpool_settings" "settings" {
+    default_transfer_limit_pct = 90
+}
+`
+
 var errUpdateManageProtectionConfig = `
 resource "powerscale_smartpool_settings" "settings" {
     manage_protection                     = false
@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &SmbShareListResource{}
+	_ list.ListResourceWithConfigure = &SmbShareListResource{}
+)
+
+// NewSmbShareListResource returns the SMB share list resource object.
+func NewSmbShareListResource() list.ListResource {
+	return &SmbShareListResource{}
+}
+
+// SmbShareListResource defines the list resource implementation.
+type SmbShareListResource struct {
+	client *client.Client
+}
+
+// Configure configures the list resource.
+func (r *SmbShareListResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the list resource arguments.
+func (r *SmbShareListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smb_share"
+}
+
+// ListResourceConfigSchema describes the list resource arguments.
+func (r *SmbShareListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource is used to discover the SMB shares of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Description:         "This list resource is used to discover the SMB shares of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The access zone of the SMB shares. Defaults to the System zone.",
+				Description:         "The access zone of the SMB shares. Defaults to the System zone.",
+			},
+			"names": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the SMB shares to list. All the SMB shares of the zone are listed by default.",
+				Description:         "Names of the SMB shares to list. All the SMB shares of the zone are listed by default.",
+			},
+		},
+	}
+}
+
+// List lists the SMB shares.
+func (r *SmbShareListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.SmbShareListModel
+	var diags diag.Diagnostics
+	// the config block is optional
+	if !req.Config.Raw.IsNull() {
+		diags = req.Config.Get(ctx, &config)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	shares, err := helper.ListSmbShares(ctx, r.client, &models.SmbShareDatasourceFilter{Zone: config.Zone})
+	if err != nil {
+		diags.AddError("Error listing smb shares", helper.GetErrorString(err, constants.ListSmbShareErrorMsg+"with error: "))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	names := make(map[string]bool)
+	for _, name := range config.Names {
+		names[name.ValueString()] = true
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, share := range *shares {
+			if len(names) > 0 && !names[share.Name] {
+				continue
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = share.Name
+
+			var model models.SmbShareResource
+			if err := helper.CopyFieldsToNonNestedModel(ctx, share, &model); err != nil {
+				result.Diagnostics.AddError("Error listing smb shares",
+					fmt.Sprintf("Could not read smb share struct %s with error: %s", share.Name, err.Error()))
+				push(result)
+				return
+			}
+			// zone is set as in an import
+			model.Zone = types.StringValue(config.Zone.ValueString())
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.SmbShareIdentityModel{ID: model.ID, Zone: model.Zone})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
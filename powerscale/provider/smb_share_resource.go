@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -46,6 +47,7 @@ var (
 	_ resource.Resource                = &SmbShareResource{}
 	_ resource.ResourceWithConfigure   = &SmbShareResource{}
 	_ resource.ResourceWithImportState = &SmbShareResource{}
+	_ resource.ResourceWithIdentity    = &SmbShareResource{}
 )
 
 // NewSmbShareResource is a helper function to simplify the provider implementation.
@@ -56,6 +58,24 @@ func NewSmbShareResource() resource.Resource {
 // Metadata describes the resource arguments.
 func (r SmbShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_smb_share"
+	// the identity changes when the SMB share is renamed
+	resp.ResourceBehavior.MutableIdentity = true
+}
+
+// IdentitySchema describes the attributes identifying the SMB share.
+func (r SmbShareResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the SMB share.",
+			},
+			"zone": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The access zone of the SMB share. Defaults to the System zone.",
+			},
+		},
+	}
 }
 
 // Schema describes the resource arguments.
@@ -469,6 +489,7 @@ func (r SmbShareResource) Create(ctx context.Context, request resource.CreateReq
 
 	diags = response.State.Set(ctx, sharePlan)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.SmbShareIdentityModel{ID: sharePlan.ID, Zone: sharePlan.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -519,6 +540,7 @@ func (r SmbShareResource) Read(ctx context.Context, request resource.ReadRequest
 	}
 	diags = response.State.Set(ctx, shareState)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.SmbShareIdentityModel{ID: shareState.ID, Zone: shareState.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -613,6 +635,7 @@ func (r SmbShareResource) Update(ctx context.Context, request resource.UpdateReq
 	shareState.Zone = sharePlan.Zone
 	diags = response.State.Set(ctx, shareState)
 	response.Diagnostics.Append(diags...)
+	response.Diagnostics.Append(response.Identity.Set(ctx, models.SmbShareIdentityModel{ID: shareState.ID, Zone: shareState.Zone})...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -651,14 +674,22 @@ func (r SmbShareResource) Delete(ctx context.Context, request resource.DeleteReq
 
 // ImportState imports the resource state.
 func (r SmbShareResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	var zoneName string
-	shareID := request.ID
-	// request.ID is form of zoneName:shareID
-	if strings.Contains(request.ID, ":") {
-		params := strings.Split(request.ID, ":")
-		shareID = strings.Trim(params[1], " ")
-		zoneName = strings.Trim(params[0], " ")
+	importID := request.ID
+	// an import by identity is converted to the zoneName:ID form
+	if importID == "" && request.Identity != nil {
+		var identity models.SmbShareIdentityModel
+		response.Diagnostics.Append(request.Identity.Get(ctx, &identity)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		importID = identity.Zone.ValueString() + ":" + identity.ID.ValueString()
+	}
+	parsedID, diags := helper.ParseImportID(importID, helper.ImportIDFormat{ID: "id", Zoned: true})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
 	}
+	shareID, zoneName := parsedID.ID, parsedID.Zone
 
 	readSmbShare, err := helper.GetSmbShare(ctx, r.client, shareID, &zoneName)
 	if err != nil {
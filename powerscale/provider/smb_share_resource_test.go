@@ -54,6 +54,24 @@ func TestAccSmbShareResource(t *testing.T) {
 					return nil
 				},
 			},
+			// ImportState testing with the zone qualifier
+			{
+				ResourceName:  "powerscale_smb_share.share_test",
+				ImportState:   true,
+				ImportStateId: "zone:System/" + shareName,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					assert.Equal(t, shareName, states[0].Attributes["id"])
+					assert.Equal(t, "System", states[0].Attributes["zone"])
+					return nil
+				},
+			},
+			// ImportState testing with an invalid import identifier
+			{
+				ResourceName:  "powerscale_smb_share.share_test",
+				ImportState:   true,
+				ImportStateId: "System:",
+				ExpectError:   regexp.MustCompile(`Unexpected Import Identifier(.|\n)*zone:<zone>/<id>`),
+			},
 			// Update
 			{
 				Config: ProviderConfig + SmbShareNameUpdatedResourceConfig,
@@ -516,7 +516,12 @@ func (r SmbShareSettingsResource) ImportState(ctx context.Context, request resou
 
 	tflog.Info(ctx, "Started importing smb share settings")
 
-	zone := request.ID
+	parsedID, diags := helper.ParseImportID(request.ID, helper.ImportIDFormat{Zoned: true})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	zone := parsedID.Zone
 
 	readSmbShareSettings, err := helper.GetSmbShareSettings(ctx, r.client, "", zone)
 	if err != nil {
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/powerscale/helper"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &SnapshotExpiryFunction{}
+
+// SnapshotExpiryFunction computes the expiry of a snapshot.
+type SnapshotExpiryFunction struct{}
+
+// NewSnapshotExpiryFunction creates a new snapshot_expiry function.
+func NewSnapshotExpiryFunction() function.Function {
+	return &SnapshotExpiryFunction{}
+}
+
+// Metadata describes the function.
+func (f *SnapshotExpiryFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "snapshot_expiry"
+}
+
+// Definition describes the parameters and return value of the function.
+func (f *SnapshotExpiryFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes the expiry of a snapshot as a Unix epoch.",
+		Description: "Computes the expiry of a snapshot as a Unix epoch, as the set_expires attribute of powerscale_snapshot does: " +
+			"1 day, 1 week or 1 month after the given time, or 0 for Never. Functions must return the same result on every run, " +
+			"so the start time is passed explicitly, e.g. with plantimestamp().",
+		MarkdownDescription: "Computes the expiry of a snapshot as a Unix epoch, as the `set_expires` attribute of `powerscale_snapshot` does: " +
+			"1 day, 1 week or 1 month after the given time, or 0 for `Never`. Functions must return the same result on every run, " +
+			"so the start time is passed explicitly, e.g. with `plantimestamp()`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "timestamp",
+				Description:         "The start time, in RFC 3339 format, e.g. 2024-05-01T10:00:00Z.",
+				MarkdownDescription: "The start time, in RFC 3339 format, e.g. `2024-05-01T10:00:00Z`.",
+			},
+			function.StringParameter{
+				Name:                "set_expires",
+				Description:         "The expiry period, one of Never, 1 Day, 1 Week or 1 Month.",
+				MarkdownDescription: "The expiry period, one of `Never`, `1 Day`, `1 Week` or `1 Month`.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run computes the expiry.
+func (f *SnapshotExpiryFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, setExpires string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &timestamp, &setExpires))
+	if resp.Error != nil {
+		return
+	}
+	from, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid timestamp %q, expected RFC 3339 format such as 2024-05-01T10:00:00Z", timestamp))
+		return
+	}
+	if !slices.Contains(helper.SnapshotExpireValues, setExpires) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid set_expires %q, expected one of %s", setExpires, strings.Join(helper.SnapshotExpireValues, ", ")))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, helper.CalculateExpireFrom(from, setExpires)))
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &SnapshotListResource{}
+	_ list.ListResourceWithConfigure = &SnapshotListResource{}
+)
+
+// NewSnapshotListResource returns the snapshot list resource object.
+func NewSnapshotListResource() list.ListResource {
+	return &SnapshotListResource{}
+}
+
+// SnapshotListResource defines the list resource implementation.
+type SnapshotListResource struct {
+	client *client.Client
+}
+
+// Configure configures the list resource.
+func (r *SnapshotListResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the list resource arguments.
+func (r *SnapshotListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot"
+}
+
+// ListResourceConfigSchema describes the list resource arguments.
+func (r *SnapshotListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource is used to discover the snapshots of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Description:         "This list resource is used to discover the snapshots of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Attributes: map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the snapshots of this path.",
+				Description:         "Only list the snapshots of this path.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the snapshot with this name.",
+				Description:         "Only list the snapshot with this name.",
+			},
+			"schedule": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the snapshots created by this schedule.",
+				Description:         "Only list the snapshots created by this schedule.",
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the snapshots in this state.",
+				Description:         "Only list the snapshots in this state.",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list the snapshots of this type.",
+				Description:         "Only list the snapshots of this type.",
+			},
+		},
+	}
+}
+
+// List lists the snapshots.
+func (r *SnapshotListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.SnapshotListModel
+	var diags diag.Diagnostics
+	// the config block is optional
+	if !req.Config.Raw.IsNull() {
+		diags = req.Config.Get(ctx, &config)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	// schedule, state and type are filtered by the API, path and name locally
+	snapshots, err := helper.GetAllSnapshots(ctx, r.client, &models.SnapshotDataSourceModel{
+		SnapshotFilter: &models.SnapshotFilterType{
+			Path:     types.StringNull(),
+			Name:     types.StringNull(),
+			Sort:     types.StringNull(),
+			Schedule: config.Schedule,
+			State:    config.State,
+			Limit:    types.Int64Null(),
+			Type:     config.Type,
+			Dir:      types.StringNull(),
+		},
+	})
+	if err != nil {
+		diags.AddError("Error reading snapshots", helper.GetErrorString(err, constants.ReadSnapshotErrorMessage+"with error: "))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, snapshot := range snapshots {
+			if config.Path.ValueString() != "" && snapshot.Path != config.Path.ValueString() {
+				continue
+			}
+			if config.Name.ValueString() != "" && snapshot.Name != config.Name.ValueString() {
+				continue
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = snapshot.Name
+
+			model, err := helper.SnapshotDetailMapper(ctx, snapshot)
+			if err != nil {
+				result.Diagnostics.AddError("Error reading snapshots",
+					fmt.Sprintf("Could not read snapshot %s with error: %s", snapshot.Name, err.Error()))
+				push(result)
+				return
+			}
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.SnapshotIdentityModel{ID: model.ID})...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
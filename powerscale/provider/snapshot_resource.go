@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -39,6 +40,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SnapshotResource{}
 var _ resource.ResourceWithImportState = &SnapshotResource{}
+var _ resource.ResourceWithIdentity = &SnapshotResource{}
 
 // NewSnapshotResource creates a new resource.
 func NewSnapshotResource() resource.Resource {
@@ -55,6 +57,18 @@ func (r *SnapshotResource) Metadata(ctx context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_snapshot"
 }
 
+// IdentitySchema describes the attributes identifying the snapshot.
+func (r *SnapshotResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the snapshot.",
+			},
+		},
+	}
+}
+
 // Schema describes the resource arguments.
 func (r *SnapshotResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -211,6 +225,7 @@ func (r *SnapshotResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	state.SetExpires = plan.SetExpires
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.SnapshotIdentityModel{ID: state.ID})...)
 }
 
 // Read reads the resource state.
@@ -243,6 +258,7 @@ func (r *SnapshotResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 	state.SetExpires = plan.SetExpires
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.SnapshotIdentityModel{ID: state.ID})...)
 }
 
 // Update updates the resource state Path, Name, AuthProviders.
@@ -294,6 +310,7 @@ func (r *SnapshotResource) Update(ctx context.Context, req resource.UpdateReques
 	state.SetExpires = plan.SetExpires
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.SnapshotIdentityModel{ID: state.ID})...)
 }
 
 // Delete deletes the resource.
@@ -322,5 +339,14 @@ func (r *SnapshotResource) Delete(ctx context.Context, req resource.DeleteReques
 
 // ImportState imports the resource state.
 func (r *SnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// an import by identity has no ID
+	if req.ID != "" {
+		parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		req.ID = parsedID.ID
+	}
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
 }
@@ -20,10 +20,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"terraform-provider-powerscale/client"
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -36,9 +38,13 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &SnapshotRestoreResource{}
+	_ resource.Resource                = &SnapshotRestoreResource{}
+	_ resource.ResourceWithImportState = &SnapshotRestoreResource{}
 )
 
+// snapshotRestoreTimeouts bounds the operations waiting on the snaprevert jobs.
+var snapshotRestoreTimeouts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
 // NewSnapshotRestoreResource returns the snapshot restore resource object.
 func NewSnapshotRestoreResource() resource.Resource {
 	return &SnapshotRestoreResource{}
@@ -85,9 +91,12 @@ func (r *SnapshotRestoreResource) ConfigValidators(ctx context.Context) []resour
 func (r *SnapshotRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		MarkdownDescription: "This resource is used to restore the data from the snapshot of PowerScale Array. The restore is done using copy/clone/snaprevert job. We can Create, Update and Delete using this resource.",
-		Description:         "This resource is used to restore the data from the snapshot of PowerScale Array. The restore is done using copy/clone/snaprevert job. We can Create, Update and Delete using this resource.",
+		MarkdownDescription: "This resource is used to restore the data from the snapshot of PowerScale Array. The restore is done using copy/clone/snaprevert job. We can Create, Update and Delete using this resource. Snapshot reverts can be imported by the ID of their succeeded SnapRevert job, copy and clone restores cannot be imported.",
+		Description:         "This resource is used to restore the data from the snapshot of PowerScale Array. The restore is done using copy/clone/snaprevert job. We can Create, Update and Delete using this resource. Snapshot reverts can be imported by the ID of their succeeded SnapRevert job, copy and clone restores cannot be imported.",
 		Attributes:          SnapshotRestoreResourceSchema(),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, snapshotRestoreTimeouts, snapshotRestoreTimeout),
+		},
 	}
 }
 
@@ -95,8 +104,8 @@ func (r *SnapshotRestoreResource) Schema(ctx context.Context, req resource.Schem
 func SnapshotRestoreResourceSchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"id": schema.StringAttribute{
-			Description:         "Placeholder ID",
-			MarkdownDescription: "Placeholder ID",
+			Description:         "ID of the SnapRevert job of a snapshot revert, placeholder ID for copy and clone operations.",
+			MarkdownDescription: "ID of the SnapRevert job of a snapshot revert, placeholder ID for copy and clone operations.",
 			Computed:            true,
 		},
 		"snaprevert_params": schema.SingleNestedAttribute{
@@ -237,6 +246,14 @@ func (r *SnapshotRestoreResource) Create(ctx context.Context, request resource.C
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, snapshotRestoreTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	state, diags := helper.ManageSnapshotRestore(ctx, r.client, plan)
 	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
@@ -276,7 +293,15 @@ func (r *SnapshotRestoreResource) Update(ctx context.Context, request resource.U
 		return
 	}
 
-	state, diags := helper.ManageSnapshotRestore(ctx, r.client, plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, snapshotRestoreTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	state, diags = helper.ManageSnapshotRestore(ctx, r.client, plan)
 	response.Diagnostics.Append(diags...)
 
 	// Save updated data into Terraform state
@@ -295,6 +320,14 @@ func (r *SnapshotRestoreResource) Delete(ctx context.Context, request resource.D
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, snapshotRestoreTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete snaprevert domain
 	if !state.SnapRevertParams.IsNull() {
 		diags := helper.DeleteSnaprevertDomain(ctx, r.client, state)
@@ -308,3 +341,31 @@ func (r *SnapshotRestoreResource) Delete(ctx context.Context, request resource.D
 	response.State.RemoveResource(ctx)
 	tflog.Info(ctx, "Done with deleting snapshot restore resource state")
 }
+
+// ImportState imports a snapshot revert by the ID of its succeeded SnapRevert job, copy and clone restores are rejected.
+func (r *SnapshotRestoreResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	tflog.Info(ctx, "Importing snapshot restore resource state")
+	parsedID, diags := helper.ParseImportID(request.ID, helper.ImportIDFormat{ID: "job_id"})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// copy and clone restores keep the "snapshot_restore" ID, as they leave no job behind
+	if _, err := strconv.Atoi(parsedID.ID); err != nil {
+		response.Diagnostics.AddError("Error importing snapshot restore",
+			fmt.Sprintf("%q is not the ID of a SnapRevert job. Only snapshot reverts can be imported, "+
+				"copy and clone restores leave no job behind and cannot be imported.", parsedID.ID))
+		return
+	}
+
+	params, diags := helper.GetSnapRevertParams(ctx, r.client, parsedID.ID)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), parsedID.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("snaprevert_params"), params)...)
+	tflog.Info(ctx, "Done with importing snapshot restore resource state")
+}
@@ -18,6 +18,7 @@ limitations under the License.
 package provider
 
 import (
+	powerscale "dell/powerscale-go-client"
 	"fmt"
 	"regexp"
 	"terraform-provider-powerscale/powerscale/helper"
@@ -28,6 +29,7 @@ import (
 )
 
 func TestAccSnapshotRestoreResource(t *testing.T) {
+	skipIfSimulated(t, "SnapRevert domains and restore jobs are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
@@ -46,6 +48,65 @@ func TestAccSnapshotRestoreResource(t *testing.T) {
 					resource.TestCheckResourceAttrPair("powerscale_snapshot_restore.snap_restore", "snaprevert_params.snapshot_id", "powerscale_snapshot.snap1", "id"),
 				),
 			},
+			// Import by the ID of the SnapRevert job
+			{
+				ResourceName:            "powerscale_snapshot_restore.snap_restore",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				ResourceName:  "powerscale_snapshot_restore.snap_restore",
+				ImportState:   true,
+				ImportStateId: "1",
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSnapshotRestoreJob).Return(&powerscale.V10JobJobExtended{
+						Id:    1,
+						Type:  "TreeDelete",
+						State: "succeeded",
+					}, nil).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*only SnapRevert jobs can be imported*.`),
+			},
+			{
+				ResourceName:  "powerscale_snapshot_restore.snap_restore",
+				ImportState:   true,
+				ImportStateId: "1",
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSnapshotRestoreJob).Return(&powerscale.V10JobJobExtended{
+						Id:               1,
+						Type:             "SnapRevert",
+						State:            "failed",
+						SnaprevertParams: &powerscale.V1JobJobSnaprevertParams{Snapid: 1},
+					}, nil).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*only succeeded SnapRevert jobs can be imported*.`),
+			},
+			// copy and clone restores cannot be imported
+			{
+				ResourceName:  "powerscale_snapshot_restore.snap_restore",
+				ImportState:   true,
+				ImportStateId: "snapshot_restore",
+				ExpectError:   regexp.MustCompile(`.*copy and clone restores leave no job behind and cannot be imported*.`),
+			},
+			{
+				ResourceName:  "powerscale_snapshot_restore.snap_restore",
+				ImportState:   true,
+				ImportStateId: "1",
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSnapshotRestoreJob).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				ExpectError: regexp.MustCompile(`.*mock error*.`),
+			},
 			{
 				PreConfig: func() {
 					if FunctionMocker != nil {
@@ -80,6 +141,40 @@ func TestAccSnapshotRestoreResource(t *testing.T) {
 	})
 }
 
+func TestAccSnapshotRestoreResourceTimeout(t *testing.T) {
+	skipIfSimulated(t, "SnapRevert domains and restore jobs are not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// a job that never ends is reported with its last state once the create timeout expires
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+					FunctionMocker = mockey.Mock(helper.GetSnapshotRestoreJob).Return(&powerscale.V10JobJobExtended{
+						Id:    1,
+						Type:  "DomainMark",
+						State: "paused_user",
+					}, nil).Build()
+				},
+				Config:      ProviderConfig + snapRevertResourceTimeoutConfig,
+				ExpectError: regexp.MustCompile(`.*timed out waiting for the operation to complete, last observed status: job 1 \(DomainMark\) is paused_user*.`),
+			},
+			{
+				PreConfig: func() {
+					if FunctionMocker != nil {
+						FunctionMocker.Release()
+					}
+				},
+				Config:      ProviderConfig + snapRevertResourceInvalidTimeoutConfig,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Value*.`),
+			},
+		},
+	})
+}
+
 var syncIQPre = `
 resource "powerscale_synciq_global_settings" "test" {
    service              = "on"
@@ -149,3 +244,25 @@ resource "powerscale_snapshot_restore" "snap_restore_copy" {
 	}
 }
 `
+
+var snapRevertResourceTimeoutConfig = syncIQPre + FileSystemResourceConfig + snapshotPre + `
+resource "powerscale_snapshot_restore" "snap_restore" {
+	snaprevert_params = {
+		snapshot_id = powerscale_snapshot.snap.id
+	}
+	timeouts {
+		create = "5s"
+	}
+}
+`
+
+var snapRevertResourceInvalidTimeoutConfig = `
+resource "powerscale_snapshot_restore" "snap_restore" {
+	snaprevert_params = {
+		snapshot_id = 1
+	}
+	timeouts {
+		create = "five minutes"
+	}
+}
+`
@@ -341,5 +341,10 @@ func (r SnapshotScheduleResource) Delete(ctx context.Context, request resource.D
 
 // ImportState imports the resource state.
 func (r SnapshotScheduleResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), request, response)
+	parsedID, diags := helper.ParseImportID(request.ID, helper.ImportIDFormat{ID: "id"})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), parsedID.ID)...)
 }
@@ -26,6 +26,7 @@ import (
 )
 
 func TestAccSubnetDatasourceGetAll(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -42,6 +43,7 @@ func TestAccSubnetDatasourceGetAll(t *testing.T) {
 }
 
 func TestAccSubnetDatasourceGetFilter(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -65,6 +67,7 @@ func TestAccSubnetDatasourceGetFilter(t *testing.T) {
 }
 
 func TestAccSubnetDatasourceGetFilterError(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -79,6 +82,7 @@ func TestAccSubnetDatasourceGetFilterError(t *testing.T) {
 }
 
 func TestAccSubnetDatasourceGetPaginationError(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
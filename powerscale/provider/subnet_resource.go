@@ -408,16 +408,13 @@ func (r SubnetResource) Delete(ctx context.Context, request resource.DeleteReque
 // ImportState imports the resource state.
 func (r SubnetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	tflog.Info(ctx, "importing subnet")
-	idParts := strings.Split(request.ID, ".")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		response.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: groupnet_name.subnet_name. Got: %q", request.ID),
-		)
+	parsedID, diags := helper.ParseImportID(request.ID, helper.ImportIDFormat{ID: "name", Parents: []string{"groupnet"}})
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
 		return
 	}
 	var subnetState models.V12GroupnetSubnetExtended
-	subnet, err := helper.GetSubnet(ctx, r.client, idParts[1], idParts[0])
+	subnet, err := helper.GetSubnet(ctx, r.client, parsedID.ID, parsedID.Parents[0])
 	if err != nil {
 		errStr := constants.GetSubnetErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
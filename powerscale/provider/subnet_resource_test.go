@@ -34,6 +34,7 @@ import (
 var subnetMocker *mockey.Mocker
 
 func TestAccSubnetResource(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -72,6 +73,7 @@ func TestAccSubnetResource(t *testing.T) {
 }
 
 func TestAccSubnetResourceErrorImport(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -92,6 +94,7 @@ func TestAccSubnetResourceErrorImport(t *testing.T) {
 }
 
 func TestAccSubnetResourceErrorRead(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -127,6 +130,7 @@ func TestAccSubnetResourceErrorRead(t *testing.T) {
 }
 
 func TestAccSubnetResourceErrorUpdate(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -172,6 +176,7 @@ func TestAccSubnetResourceErrorUpdate(t *testing.T) {
 }
 
 func TestAccSubnetResourceErrorCreate(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -185,6 +190,7 @@ func TestAccSubnetResourceErrorCreate(t *testing.T) {
 }
 
 func TestAccSubnetResourceErrorDelete(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -218,6 +224,7 @@ func TestAccSubnetResourceErrorDelete(t *testing.T) {
 }
 
 func TestAccSubnetResourceErrorCopyField(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -252,6 +259,7 @@ func TestAccSubnetResourceErrorCopyField(t *testing.T) {
 }
 
 func TestAccSubnetResourceErrorReadState(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -269,6 +277,7 @@ func TestAccSubnetResourceErrorReadState(t *testing.T) {
 }
 
 func TestAccSubnetResourceReleaseMock(t *testing.T) {
+	skipIfSimulated(t, "the network configuration is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
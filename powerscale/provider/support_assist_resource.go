@@ -25,6 +25,7 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -42,9 +43,18 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource = &SupportAssistResource{}
+	_ resource.Resource               = &SupportAssistResource{}
+	_ resource.ResourceWithModifyPlan = &SupportAssistResource{}
 )
 
+// supportAssistVersionRequirements gates the resource, SupportAssist was introduced in OneFS 9.5.0.
+var supportAssistVersionRequirements = []helper.VersionRequirement{
+	{MinVersion: "9.5.0"},
+}
+
+// supportAssistTimeouts bounds the operations waiting on the provisioning task.
+var supportAssistTimeouts = timeouts.Opts{Create: true, Update: true}
+
 // NewSupportAssistResource returns the Support Assist resource object.
 func NewSupportAssistResource() resource.Resource {
 	return &SupportAssistResource{}
@@ -71,6 +81,14 @@ func (r *SupportAssistResource) Configure(ctx context.Context, req resource.Conf
 	r.client = c
 }
 
+// ModifyPlan validates the configuration against the OneFS version of the cluster.
+func (r *SupportAssistResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(helper.CheckVersionRequirements(ctx, r.client, req.Config, supportAssistVersionRequirements)...)
+}
+
 // Metadata describes the resource arguments.
 func (r *SupportAssistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_support_assist"
@@ -208,6 +226,9 @@ func (r *SupportAssistResource) Schema(ctx context.Context, req resource.SchemaR
 		MarkdownDescription: "This resource is used to manage the Support Assist settings of PowerScale Array. We can Create, Update and Delete the Support Assist settings using this resource. Note that, Support Assist settings is the native functionality of PowerScale.",
 		Description:         "This resource is used to manage the Support Assist settings of PowerScale Array. We can Create, Update and Delete the Support Assist settings using this resource. Note that, Support Assist settings is the native functionality of PowerScale.",
 		Attributes:          SupportAssistResourceSchema(),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, supportAssistTimeouts, supportAssistTimeout),
+		},
 	}
 }
 
@@ -557,8 +578,17 @@ func (r *SupportAssistResource) Create(ctx context.Context, request resource.Cre
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, supportAssistTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	state, diags := helper.ManageSupportAssist(ctx, r.client, plan)
 	response.Diagnostics.Append(diags...)
+	state.Timeouts = plan.Timeouts
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -574,8 +604,10 @@ func (r *SupportAssistResource) Read(ctx context.Context, request resource.ReadR
 		return
 	}
 
+	priorTimeouts := state.Timeouts
 	state, dig := helper.ReadSupportAssistDetails(ctx, r.client, state)
 	response.Diagnostics.Append(dig...)
+	state.Timeouts = priorTimeouts
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -598,8 +630,17 @@ func (r *SupportAssistResource) Update(ctx context.Context, request resource.Upd
 		return
 	}
 
-	state, diags := helper.ManageSupportAssist(ctx, r.client, plan)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, supportAssistTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	state, diags = helper.ManageSupportAssist(ctx, r.client, plan)
 	response.Diagnostics.Append(diags...)
+	state.Timeouts = plan.Timeouts
 
 	// Save updated data into Terraform state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
@@ -31,6 +31,7 @@ import (
 )
 
 func TestAccSupportAssistResource(t *testing.T) {
+	skipIfSimulated(t, "SupportAssist is not simulated")
 	supportAssistResourceName := "powerscale_support_assist.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -87,6 +88,7 @@ func TestAccSupportAssistResource(t *testing.T) {
 }
 
 func TestAccSupportAssistResourceMockError(t *testing.T) {
+	skipIfSimulated(t, "SupportAssist is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
@@ -147,6 +149,7 @@ func TestAccSupportAssistResourceMockError(t *testing.T) {
 }
 
 func TestAccSupportAssistResourceValidation(t *testing.T) {
+	skipIfSimulated(t, "SupportAssist is not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
 			testAccPreCheck(t)
@@ -246,11 +246,12 @@ func (r *SyncIQPeerCertificateResource) Delete(ctx context.Context, req resource
 
 // ImportState imports the resource.
 func (r *SyncIQPeerCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	name := req.ID
-	if name == "" {
-		resp.Diagnostics.AddError("Cannot import syncIQ peer certificate with empty name", "SyncIQ peer certificates do not have empty names.")
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	name := parsedID.ID
 	config, err := helper.ListPeerCerts(ctx, r.client)
 	if err != nil {
 		message := helper.GetErrorString(err, "")
@@ -73,6 +73,7 @@ func getPeerCertProvisionerConfig() string {
 
 // TestAccSyncIQPeerCertificateResource - Tests syncIQ peer certificate resource.
 func TestAccSyncIQPeerCertificateResource(t *testing.T) {
+	skipIfSimulated(t, "the certificate is generated on the cluster over SSH")
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
@@ -130,7 +131,7 @@ func TestAccSyncIQPeerCertificateResource(t *testing.T) {
 				ImportStateIdFunc: func(*terraform.State) (string, error) {
 					return "", nil
 				},
-				ExpectError: regexp.MustCompile(`.*Cannot import syncIQ peer certificate with empty name.*`),
+				ExpectError: regexp.MustCompile(`Unexpected Import Identifier(.|\n)*the name is empty`),
 			},
 			// mock import with name error
 			{
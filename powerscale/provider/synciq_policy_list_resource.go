@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResource              = &SynciqPolicyListResource{}
+	_ list.ListResourceWithConfigure = &SynciqPolicyListResource{}
+)
+
+// NewSynciqPolicyListResource returns the SyncIQ policy list resource object.
+func NewSynciqPolicyListResource() list.ListResource {
+	return &SynciqPolicyListResource{}
+}
+
+// SynciqPolicyListResource defines the list resource implementation.
+type SynciqPolicyListResource struct {
+	client *client.Client
+}
+
+// Configure configures the list resource.
+func (r *SynciqPolicyListResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *c.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = c
+}
+
+// Metadata describes the list resource arguments.
+func (r *SynciqPolicyListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_synciq_policy"
+}
+
+// ListResourceConfigSchema describes the list resource arguments.
+func (r *SynciqPolicyListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This list resource is used to discover the SyncIQ policies of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Description:         "This list resource is used to discover the SyncIQ policies of the PowerScale array with terraform query, and to generate the configuration to import them.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the SyncIQ policies to list. All the SyncIQ policies are listed by default.",
+				Description:         "Names of the SyncIQ policies to list. All the SyncIQ policies are listed by default.",
+			},
+		},
+	}
+}
+
+// List lists the SyncIQ policies.
+func (r *SynciqPolicyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config models.SynciqpolicyListModel
+	var diags diag.Diagnostics
+	// the config block is optional
+	if !req.Config.Raw.IsNull() {
+		diags = req.Config.Get(ctx, &config)
+	}
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	policies, err := helper.GetAllSyncIQPolicies(ctx, r.client)
+	if err != nil {
+		diags.AddError("Error getting the list of SyncIQ policies", helper.GetErrorString(err, constants.ListSynciqPoliciesMsg+"with error: "))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	names := make(map[string]bool)
+	for _, name := range config.Names {
+		names[name.ValueString()] = true
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, policy := range policies.Policies {
+			if len(names) > 0 && !names[policy.Name] {
+				continue
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = policy.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, models.SynciqpolicyIdentityModel{ID: types.StringValue(policy.Id)})...)
+			// the full policy is only read when the resource is requested
+			if req.IncludeResource {
+				policyResponse, err := helper.GetSyncIQPolicyByID(ctx, r.client, policy.Id)
+				if err != nil {
+					result.Diagnostics.AddError("Error reading syncIQ Policy", helper.GetErrorString(err, "Could not get syncIQ Policy with error: "))
+					push(result)
+					return
+				}
+				model, dgs := helper.NewSynciqpolicyResourceModel(ctx, policyResponse)
+				result.Diagnostics.Append(dgs...)
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
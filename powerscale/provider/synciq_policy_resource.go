@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -35,6 +36,7 @@ var (
 	_ resource.Resource                = &synciqPolicyResource{}
 	_ resource.ResourceWithConfigure   = &synciqPolicyResource{}
 	_ resource.ResourceWithImportState = &synciqPolicyResource{}
+	_ resource.ResourceWithIdentity    = &synciqPolicyResource{}
 )
 
 // NewSynciqPolicyResource creates a new resource.
@@ -73,6 +75,18 @@ func (s *synciqPolicyResource) Metadata(ctx context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_synciq_policy"
 }
 
+// IdentitySchema describes the attributes identifying the SyncIQ policy.
+func (s *synciqPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the SyncIQ policy.",
+			},
+		},
+	}
+}
+
 // Create - The function to be called when a resource is created.
 func (s *synciqPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Read Terraform plan into the model
@@ -113,6 +127,7 @@ func (s *synciqPolicyResource) Create(ctx context.Context, req resource.CreateRe
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.SynciqpolicyIdentityModel{ID: state.ID})...)
 }
 
 // GetStateByID - returns the state by id.
@@ -151,6 +166,7 @@ func (s *synciqPolicyResource) Read(ctx context.Context, req resource.ReadReques
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.SynciqpolicyIdentityModel{ID: state.ID})...)
 }
 
 // Update - The function to be called when a resource is updated.
@@ -205,6 +221,7 @@ func (s *synciqPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, models.SynciqpolicyIdentityModel{ID: state.ID})...)
 }
 
 // Delete - The function to be called when a resource is deleted.
@@ -232,7 +249,17 @@ func (s *synciqPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 
 // ImportState implements resource.ResourceWithImportState.
 func (s *synciqPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := helper.GetSyncIQPolicyIDByName(ctx, s.client, req.ID)
+	// the identity holds the ID of the policy, while the import ID is its name
+	if req.ID == "" {
+		resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+		return
+	}
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, err := helper.GetSyncIQPolicyIDByName(ctx, s.client, parsedID.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting SyncIQ Policy", err.Error())
 		return
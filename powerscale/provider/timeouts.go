@@ -0,0 +1,62 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Default timeouts of the resources waiting on cluster jobs or tasks.
+const (
+	snapshotRestoreTimeout  = 60 * time.Minute
+	supportAssistTimeout    = 20 * time.Minute
+	writableSnapshotTimeout = 10 * time.Minute
+	fileSystemDeleteTimeout = 20 * time.Minute
+	wormDomainDeleteTimeout = 20 * time.Minute
+	quotaReportTimeout      = 5 * time.Minute
+)
+
+// timeoutsBlock returns the timeouts block of a resource for the operations set in opts, all with the same default.
+func timeoutsBlock(ctx context.Context, opts timeouts.Opts, defaultTimeout time.Duration) schema.Block {
+	description := func(operation string) string {
+		return fmt.Sprintf("Time to wait for the %s to complete on the cluster, as a duration such as \"30s\" or \"2h45m\". Defaults to \"%dm\".",
+			operation, int(defaultTimeout.Minutes()))
+	}
+	opts.CreateDescription = description("creation")
+	opts.UpdateDescription = description("update")
+	opts.DeleteDescription = description("deletion")
+	return timeouts.Block(ctx, opts)
+}
+
+// nullTimeouts returns the timeouts of a resource state built without a plan, e.g. on import, for the operations set in opts.
+func nullTimeouts(opts timeouts.Opts) timeouts.Value {
+	attrTypes := map[string]attr.Type{}
+	for name, set := range map[string]bool{"create": opts.Create, "read": opts.Read, "update": opts.Update, "delete": opts.Delete} {
+		if set {
+			attrTypes[name] = types.StringType
+		}
+	}
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
@@ -25,6 +25,7 @@ import (
 )
 
 func TestAccUserDataSourceFilter(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userTerraformName = "data.powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -42,6 +43,7 @@ func TestAccUserDataSourceFilter(t *testing.T) {
 }
 
 func TestAccUserDataSourceNames(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userTerraformName = "data.powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -59,6 +61,7 @@ func TestAccUserDataSourceNames(t *testing.T) {
 }
 
 func TestAccUserDataSourceFilterNames(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userTerraformName = "data.powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -79,6 +82,7 @@ func TestAccUserDataSourceFilterNames(t *testing.T) {
 }
 
 func TestAccUserDataSourceInvalidFilter(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -93,6 +97,7 @@ func TestAccUserDataSourceInvalidFilter(t *testing.T) {
 }
 
 func TestAccUserDataSourceInvalidNames(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -107,6 +112,7 @@ func TestAccUserDataSourceInvalidNames(t *testing.T) {
 }
 
 func TestAccUserDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userTerraformName = "data.powerscale_user.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
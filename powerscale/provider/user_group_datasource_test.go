@@ -25,6 +25,7 @@ import (
 )
 
 func TestAccUserGroupDataSourceFilter(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupTerraformName = "data.powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -42,6 +43,7 @@ func TestAccUserGroupDataSourceFilter(t *testing.T) {
 }
 
 func TestAccUserGroupDataSourceNames(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupTerraformName = "data.powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -59,6 +61,7 @@ func TestAccUserGroupDataSourceNames(t *testing.T) {
 }
 
 func TestAccUserGroupDataSourceFilterNames(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userTerraformName = "data.powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -80,6 +83,7 @@ func TestAccUserGroupDataSourceFilterNames(t *testing.T) {
 }
 
 func TestAccUserGroupDataSourceInvalidFilter(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -94,6 +98,7 @@ func TestAccUserGroupDataSourceInvalidFilter(t *testing.T) {
 }
 
 func TestAccUserGroupDataSourceAll(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupTerraformName = "data.powerscale_user_group.all"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -355,14 +355,12 @@ func (r *UserGroupResource) ImportState(ctx context.Context, req resource.Import
 	tflog.Info(ctx, "Importing User Group resource")
 	var state models.UserGroupResourceModel
 
-	var zoneID string
-	groupName := req.ID
-	//requestID format is zoneID:groupName
-	if strings.Contains(groupName, ":") {
-		params := strings.Split(groupName, ":")
-		groupName = strings.Trim(params[1], " ")
-		zoneID = strings.Trim(params[0], " ")
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name", Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	groupName, zoneID := parsedID.ID, parsedID.Zone
 
 	var roles []powerscale.V1AuthRoleExtended
 	var roleErr error
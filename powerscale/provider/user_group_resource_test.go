@@ -36,6 +36,7 @@ var userGroupMocker *mockey.Mocker
 var userGroupCreateMocker *mockey.Mocker
 
 func TestAccUserGroupResourceCreate(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupResourceName = "powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -85,6 +86,7 @@ func TestAccUserGroupResourceCreate(t *testing.T) {
 }
 
 func TestAccUserGroupResourceCreateErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -99,6 +101,7 @@ func TestAccUserGroupResourceCreateErr(t *testing.T) {
 }
 
 func TestAccUserGroupResourceAddRoleErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -113,6 +116,7 @@ func TestAccUserGroupResourceAddRoleErr(t *testing.T) {
 }
 
 func TestAccUserGroupResourceImport(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupResourceName = "powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -142,6 +146,7 @@ func TestAccUserGroupResourceImport(t *testing.T) {
 }
 
 func TestAccUserGroupResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -166,6 +171,7 @@ func TestAccUserGroupResourceCreateMockErr(t *testing.T) {
 }
 
 func TestAccUserGroupRolesResourceImportRolesErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupResourceName = "powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -204,6 +210,7 @@ func TestAccUserGroupRolesResourceImportRolesErr(t *testing.T) {
 }
 
 func TestAccUserGroupRolesResourceImportGetErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupResourceName = "powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -241,6 +248,7 @@ func TestAccUserGroupRolesResourceImportGetErr(t *testing.T) {
 }
 
 func TestAccUserGroupResourceDeleteMockErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -278,6 +286,7 @@ func TestAccUserGroupResourceDeleteMockErr(t *testing.T) {
 }
 
 func TestAccUserGroupResourceHelperMockErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -366,6 +375,7 @@ func TestAccUserGroupResourceHelperMockErr(t *testing.T) {
 }
 
 func TestAccUserGroupReleaseMockResource(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userGroupResourceName = "powerscale_user_group.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
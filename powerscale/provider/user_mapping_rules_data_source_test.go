@@ -25,6 +25,7 @@ import (
 )
 
 func TestAccUserMappingRulesDataSource(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userMappingRulesDataSourceName = "data.powerscale_user_mapping_rules.mapping_rule_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -59,6 +60,7 @@ func TestAccUserMappingRulesDataSource(t *testing.T) {
 }
 
 func TestAccUserMappingRulesDataSourceInvalidConfig(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
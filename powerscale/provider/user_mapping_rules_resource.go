@@ -491,7 +491,12 @@ func (r *UserMappingRulesResource) ImportState(ctx context.Context, req resource
 	tflog.Info(ctx, "Importing User Mapping Rules resource state")
 	var state models.UserMappingRulesResourceModel
 
-	zone := req.ID
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	zone := parsedID.Zone
 	rulesResponse, err := helper.GetUserMappingRulesByZone(ctx, r.client, zone)
 	if err != nil {
 		resp.Diagnostics.AddError("error getting user mapping rules", err.Error())
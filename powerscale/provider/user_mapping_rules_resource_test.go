@@ -33,6 +33,7 @@ import (
 var userMappingRulesMocker *mockey.Mocker
 
 func TestAccUserMappingRuleResource(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var mappingRuleResourceName = "powerscale_user_mapping_rules.mapping_rule_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -99,6 +100,7 @@ func TestAccUserMappingRuleResource(t *testing.T) {
 }
 
 func TestAccUserMappingRuleResourceEmpty(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var mappingRuleResourceName = "powerscale_user_mapping_rules.mapping_rule_test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -127,6 +129,7 @@ func TestAccUserMappingRuleResourceEmpty(t *testing.T) {
 }
 
 func TestAccUserMappingRuleResourceErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -162,6 +165,7 @@ func TestAccUserMappingRuleResourceErr(t *testing.T) {
 }
 
 func TestAccUserMappingRuleResourceMockErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	diags := diag.Diagnostics{}
 	diags.AddError("mock err", "mock err")
 	resource.Test(t, resource.TestCase{
@@ -361,6 +365,7 @@ func TestAccUserMappingRuleResourceMockErr(t *testing.T) {
 }
 
 func TestAccUserMappingRuleReleaseMockResource(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -27,8 +27,10 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -134,11 +136,27 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 			},
 			"password": schema.StringAttribute{
-				Description:         "Sets or Changes the password for the user.",
-				MarkdownDescription: "Sets or Changes the password for the user.",
+				Description:         "Sets or Changes the password for the user. Conflicts with password_wo.",
+				MarkdownDescription: "Sets or Changes the password for the user. Conflicts with `password_wo`.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "Sets or Changes the password for the user, without storing it in the Terraform state. Requires Terraform 1.11 or later." +
+					" The password is sent on creation and whenever password_wo_version changes. Conflicts with password.",
+				MarkdownDescription: "Sets or Changes the password for the user, without storing it in the Terraform state. Requires Terraform 1.11 or later." +
+					" The password is sent on creation and whenever `password_wo_version` changes. Conflicts with `password`.",
+				Optional:   true,
+				Sensitive:  true,
+				WriteOnly:  true,
+				Validators: []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("password"))},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Description:         "The version of password_wo. Change it to send password_wo to the cluster again, e.g. to rotate the password.",
+				MarkdownDescription: "The version of `password_wo`. Change it to send `password_wo` to the cluster again, e.g. to rotate the password.",
+				Optional:            true,
+				Validators:          []validator.Int64{int64validator.AlsoRequires(path.MatchRoot("password_wo"))},
+			},
 			"password_expires": schema.BoolAttribute{
 				Description:         "If true, the password is allowed to expire.",
 				MarkdownDescription: "If true, the password is allowed to expire.",
@@ -323,6 +341,12 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	password, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, nil, "password_wo", "password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.PasswordWO = password
 
 	var roleList []string
 	if !plan.Roles.IsNull() && !plan.Roles.IsUnknown() {
@@ -421,6 +445,12 @@ func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	password, diags := helper.GetWriteOnlySecret(ctx, req.Config, req.Plan, &req.State, "password_wo", "password_wo_version")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.PasswordWO = password
 
 	userName := state.Name.ValueString()
 	if err := helper.UpdateUser(ctx, r.client, &state, &plan); err != nil {
@@ -501,14 +531,12 @@ func (r *UserResource) ImportState(ctx context.Context, req resource.ImportState
 	tflog.Info(ctx, "Importing User resource")
 	var state models.UserResourceModel
 
-	var zoneID string
-	userName := req.ID
-	//requestID format is zoneID:userName
-	if strings.Contains(userName, ":") {
-		params := strings.Split(userName, ":")
-		userName = strings.Trim(params[1], " ")
-		zoneID = strings.Trim(params[0], " ")
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "name", Zoned: true})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	userName, zoneID := parsedID.ID, parsedID.Zone
 
 	var roles []powerscale.V1AuthRoleExtended
 	var roleErr error
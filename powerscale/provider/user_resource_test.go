@@ -25,8 +25,10 @@ import (
 	"testing"
 
 	"github.com/bytedance/mockey"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -34,6 +36,7 @@ var userMocker *mockey.Mocker
 var userCreateMocker *mockey.Mocker
 
 func TestAccUserResourceCreate(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userResourceName = "powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -75,6 +78,7 @@ func TestAccUserResourceCreate(t *testing.T) {
 }
 
 func TestAccUserResourceResetPassword(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userResourceName = "powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -113,7 +117,45 @@ func TestAccUserResourceResetPassword(t *testing.T) {
 	})
 }
 
+func TestAccUserResourceWriteOnlyPassword(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
+	var userResourceName = "powerscale_user.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// write-only attributes are supported from Terraform 1.11
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			// Conflicting passwords testing
+			{
+				Config:      ProviderConfig + userResourceConfigPasswordConflict,
+				ExpectError: regexp.MustCompile(`.*Invalid Attribute Combination*.`),
+			},
+			// Create and Read testing
+			{
+				Config: ProviderConfig + fmt.Sprintf(userResourceConfigWriteOnlyPassword, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userResourceName, "name", "tfaccUserCreation"),
+					resource.TestCheckResourceAttr(userResourceName, "password_wo_version", "1"),
+					resource.TestCheckNoResourceAttr(userResourceName, "password_wo"),
+				),
+			},
+			// Update and Read testing - rotate password
+			{
+				Config: ProviderConfig + fmt.Sprintf(userResourceConfigWriteOnlyPassword, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userResourceName, "password_wo_version", "2"),
+					resource.TestCheckNoResourceAttr(userResourceName, "password_wo"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccUserResourceCreateErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -128,6 +170,7 @@ func TestAccUserResourceCreateErr(t *testing.T) {
 }
 
 func TestAccUserResourceAddRoleErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -142,6 +185,7 @@ func TestAccUserResourceAddRoleErr(t *testing.T) {
 }
 
 func TestAccUserResourceImport(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userResourceName = "powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -169,6 +213,7 @@ func TestAccUserResourceImport(t *testing.T) {
 }
 
 func TestAccUserResourceCreateMockErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -193,6 +238,7 @@ func TestAccUserResourceCreateMockErr(t *testing.T) {
 }
 
 func TestAccUserRolesResourceImportRolesErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userResourceName = "powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -230,6 +276,7 @@ func TestAccUserRolesResourceImportRolesErr(t *testing.T) {
 }
 
 func TestAccUserRolesResourceImportGetErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userResourceName = "powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -267,6 +314,7 @@ func TestAccUserRolesResourceImportGetErr(t *testing.T) {
 }
 
 func TestAccUserRolesResourceDeleteMockErr(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -303,6 +351,7 @@ func TestAccUserRolesResourceDeleteMockErr(t *testing.T) {
 }
 
 func TestAccUserReleaseMockResource(t *testing.T) {
+	skipIfSimulated(t, "auth provider lookups of users and groups are not simulated")
 	var userResourceName = "powerscale_user.test"
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -408,3 +457,21 @@ resource "powerscale_user" "test" {
 	roles = ["tfaccUserRole"]
   }
 `
+
+var userResourceConfigWriteOnlyPassword = `
+resource "powerscale_user" "test" {
+	name = "tfaccUserCreation"
+	password_wo = "testPasswordWriteOnly"
+	password_wo_version = %d
+	roles = ["tfaccUserRole"]
+  }
+`
+
+var userResourceConfigPasswordConflict = `
+resource "powerscale_user" "test" {
+	name = "tfaccUserCreation"
+	password = "testPassword"
+	password_wo = "testPasswordWriteOnly"
+	roles = ["tfaccUserRole"]
+  }
+`
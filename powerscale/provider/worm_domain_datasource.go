@@ -0,0 +1,244 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &WormDomainDataSource{}
+	_ datasource.DataSourceWithConfigure = &WormDomainDataSource{}
+)
+
+// NewWormDomainDataSource returns the WormDomain data source object.
+func NewWormDomainDataSource() datasource.DataSource {
+	return &WormDomainDataSource{}
+}
+
+// WormDomainDataSource defines the data source implementation.
+type WormDomainDataSource struct {
+	client *client.Client
+}
+
+// Metadata describes the data source arguments.
+func (d *WormDomainDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_domain"
+}
+
+// Schema describes the data source arguments.
+func (d *WormDomainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This datasource is used to query the SmartLock (WORM) domains of PowerScale Array with their retention settings, and the state of the compliance clock of the cluster. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Description: "This datasource is used to query the SmartLock (WORM) domains of PowerScale Array with their retention settings, and the state of the compliance clock of the cluster. " +
+			"The information fetched from this datasource can be used for getting the details or for further processing in resource block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:         "Identifier",
+				MarkdownDescription: "Identifier",
+				Computed:            true,
+			},
+			"worm_domains": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "List of SmartLock domains",
+				MarkdownDescription: "List of SmartLock domains",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description:         "ID of the SmartLock domain.",
+							MarkdownDescription: "ID of the SmartLock domain.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							Description:         "Root directory of the SmartLock domain.",
+							MarkdownDescription: "Root directory of the SmartLock domain.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							Description:         "Type of the SmartLock domain: enterprise or compliance.",
+							MarkdownDescription: "Type of the SmartLock domain: `enterprise` or `compliance`.",
+							Computed:            true,
+						},
+						"default_retention": schema.StringAttribute{
+							Description:         "Retention period applied to the files committed without a retention date, as an ISO 8601 duration or a keyword.",
+							MarkdownDescription: "Retention period applied to the files committed without a retention date, as an ISO 8601 duration or a keyword.",
+							Computed:            true,
+						},
+						"min_retention": schema.StringAttribute{
+							Description:         "Minimum retention period of the committed files, as an ISO 8601 duration or a keyword.",
+							MarkdownDescription: "Minimum retention period of the committed files, as an ISO 8601 duration or a keyword.",
+							Computed:            true,
+						},
+						"max_retention": schema.StringAttribute{
+							Description:         "Maximum retention period of the committed files, as an ISO 8601 duration or a keyword.",
+							MarkdownDescription: "Maximum retention period of the committed files, as an ISO 8601 duration or a keyword.",
+							Computed:            true,
+						},
+						"autocommit_offset": schema.Int64Attribute{
+							Description:         "Seconds after the last modification of a file before it is committed automatically.",
+							MarkdownDescription: "Seconds after the last modification of a file before it is committed automatically.",
+							Computed:            true,
+						},
+						"privileged_delete": schema.StringAttribute{
+							Description:         "Whether the root user can delete the committed files before their retention date: on, off or disabled.",
+							MarkdownDescription: "Whether the root user can delete the committed files before their retention date: `on`, `off` or `disabled`.",
+							Computed:            true,
+						},
+						"override_date": schema.Int64Attribute{
+							Description:         "Unix Epoch time until which all the committed files of the domain are protected.",
+							MarkdownDescription: "Unix Epoch time until which all the committed files of the domain are protected.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"compliance_clock": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "State of the compliance clock of the cluster, which dates the retention of the compliance domains.",
+				MarkdownDescription: "State of the compliance clock of the cluster, which dates the retention of the compliance domains.",
+				Attributes: map[string]schema.Attribute{
+					"set": schema.BoolAttribute{
+						Description:         "Whether the compliance clock is set.",
+						MarkdownDescription: "Whether the compliance clock is set.",
+						Computed:            true,
+					},
+					"date": schema.Int64Attribute{
+						Description:         "Unix Epoch time of the compliance clock, null when it is not set.",
+						MarkdownDescription: "Unix Epoch time of the compliance clock, null when it is not set.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"ids": schema.SetAttribute{
+						Description:         "IDs of the SmartLock domains to list.",
+						MarkdownDescription: "IDs of the SmartLock domains to list.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"paths": schema.SetAttribute{
+						Description:         "Root directories of the SmartLock domains to list.",
+						MarkdownDescription: "Root directories of the SmartLock domains to list.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"type": schema.StringAttribute{
+						Description:         "Type of the SmartLock domains to list: enterprise or compliance.",
+						MarkdownDescription: "Type of the SmartLock domains to list: `enterprise` or `compliance`.",
+						Optional:            true,
+						Validators:          []validator.String{stringvalidator.OneOf(helper.WormDomainTypeEnterprise, helper.WormDomainTypeCompliance)},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure configures the data source.
+func (d *WormDomainDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = pscaleClient
+}
+
+// Read reads data from the data source.
+func (d *WormDomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "Reading SmartLock domain data source")
+	var config models.WormDomainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids, paths []string
+	domainType := ""
+	if config.Filter != nil {
+		for _, id := range config.Filter.IDs {
+			ids = append(ids, id.ValueString())
+		}
+		for _, path := range config.Filter.Paths {
+			paths = append(paths, "/"+strings.Trim(path.ValueString(), "/"))
+		}
+		domainType = config.Filter.Type.ValueString()
+	}
+	domains, err := helper.ListWormDomains(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SmartLock domains", helper.GetErrorString(err, constants.ReadWormDomainErrorMsg+"with error: "))
+		return
+	}
+	settings, err := helper.GetWormSettings(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading SmartLock domains", helper.GetErrorString(err, constants.ReadWormSettingsErrorMsg+"with error: "))
+		return
+	}
+
+	state := models.WormDomainDataSourceModel{
+		ID:              types.StringValue("worm_domain_datasource"),
+		WormDomains:     []models.WormDomainDataSourceEntity{},
+		ComplianceClock: helper.NewWormComplianceClockModel(settings),
+		Filter:          config.Filter,
+	}
+	for i := range domains {
+		domain := &domains[i]
+		if len(ids) > 0 && !slices.Contains(ids, fmt.Sprint(domain.GetId())) {
+			continue
+		}
+		if len(paths) > 0 && !slices.Contains(paths, domain.GetPath()) {
+			continue
+		}
+		if len(domainType) > 0 && domain.GetType() != domainType {
+			continue
+		}
+		state.WormDomains = append(state.WormDomains, helper.NewWormDomainDataSourceEntity(domain))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SmartLock domain data source")
+}
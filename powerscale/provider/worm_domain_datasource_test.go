@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWormDomainDatasource(t *testing.T) {
+	skipIfSimulated(t, "SmartLock domains are created on directories of the namespace API, which is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: ProviderConfig + WormDomainDatasourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerscale_worm_domain.all", "worm_domains.#"),
+					resource.TestCheckResourceAttrSet("data.powerscale_worm_domain.all", "compliance_clock.set"),
+					resource.TestCheckResourceAttr("data.powerscale_worm_domain.filtered", "worm_domains.#", "1"),
+					resource.TestCheckResourceAttr("data.powerscale_worm_domain.filtered", "worm_domains.0.path", "/ifs/tfacc_worm_domain_ds"),
+					resource.TestCheckResourceAttr("data.powerscale_worm_domain.filtered", "worm_domains.0.default_retention", "P30D"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWormDomainDatasourceErrors(t *testing.T) {
+	skipIfSimulated(t, "SmartLock domains are created on directories of the namespace API, which is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.ListWormDomains).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainDatasourceAllConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.GetWormSettings).Return(nil, fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainDatasourceAllConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + WormDomainDatasourceAllConfig,
+			},
+		},
+	})
+}
+
+var WormDomainDatasourceAllConfig = `
+data "powerscale_worm_domain" "all" {
+}
+`
+
+var WormDomainDatasourceConfig = `
+resource "powerscale_worm_domain" "test" {
+	path              = "/ifs/tfacc_worm_domain_ds"
+	type              = "enterprise"
+	default_retention = "P30D"
+}
+
+data "powerscale_worm_domain" "all" {
+	depends_on = [powerscale_worm_domain.test]
+}
+
+data "powerscale_worm_domain" "filtered" {
+	filter {
+		paths = [powerscale_worm_domain.test.path]
+		type  = "enterprise"
+	}
+}
+`
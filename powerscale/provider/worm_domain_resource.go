@@ -0,0 +1,392 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/client"
+	"terraform-provider-powerscale/powerscale/constants"
+	"terraform-provider-powerscale/powerscale/helper"
+	"terraform-provider-powerscale/powerscale/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &WormDomainResource{}
+	_ resource.ResourceWithConfigure   = &WormDomainResource{}
+	_ resource.ResourceWithImportState = &WormDomainResource{}
+)
+
+// wormDomainTimeouts bounds the deletion, which walks the directory tree of the domain for committed files before removing it.
+var wormDomainTimeouts = timeouts.Opts{Delete: true}
+
+// wormDomainPathPattern matches the paths of the SmartLock domains, which are rooted under /ifs.
+var wormDomainPathPattern = regexp.MustCompile("^/ifs/")
+
+// NewWormDomainResource creates a new resource.
+func NewWormDomainResource() resource.Resource {
+	return &WormDomainResource{}
+}
+
+// WormDomainResource defines the resource implementation.
+type WormDomainResource struct {
+	client *client.Client
+}
+
+// Metadata describes the resource arguments.
+func (r *WormDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worm_domain"
+}
+
+// Schema describes the resource arguments.
+func (r *WormDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := wormDomainSettingsAttributes()
+	attributes["path"] = schema.StringAttribute{
+		Description: "Root directory of the SmartLock domain, such as /ifs/archive. The directory is created when it does not exist, and must be empty. " +
+			"Destroying the resource deletes the directory tree when the resource created it, which is refused when the domain contains committed files.",
+		MarkdownDescription: "Root directory of the SmartLock domain, such as `/ifs/archive`. The directory is created when it does not exist, and must be empty. " +
+			"Destroying the resource deletes the directory tree when the resource created it, which is refused when the domain contains committed files.",
+		Required:   true,
+		Validators: []validator.String{stringvalidator.RegexMatches(wormDomainPathPattern, "must be an absolute path under /ifs")},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["directory_created"] = schema.BoolAttribute{
+		Description: "Whether the resource created the root directory of the SmartLock domain, which is then deleted on destroy. " +
+			"False when the directory existed or the domain was imported, the directory is then left on the cluster.",
+		MarkdownDescription: "Whether the resource created the root directory of the SmartLock domain, which is then deleted on destroy. " +
+			"False when the directory existed or the domain was imported, the directory is then left on the cluster.",
+		Computed: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource is used to manage the SmartLock (WORM) domains of PowerScale Array, which protect the committed files from changes until their retention date. " +
+			"We can Create, Update and Delete the SmartLock domains using this resource. We can also import an existing SmartLock domain by its ID or path.",
+		Description: "This resource is used to manage the SmartLock (WORM) domains of PowerScale Array, which protect the committed files from changes until their retention date. " +
+			"We can Create, Update and Delete the SmartLock domains using this resource. We can also import an existing SmartLock domain by its ID or path.",
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, wormDomainTimeouts, wormDomainDeleteTimeout),
+		},
+	}
+}
+
+// wormDomainSettingsAttributes returns the attributes of a SmartLock domain, shared by the SmartLock domain resource and the file system resource.
+func wormDomainSettingsAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description:         "ID of the SmartLock domain.",
+			MarkdownDescription: "ID of the SmartLock domain.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of the SmartLock domain: enterprise or compliance. The committed files of a compliance domain cannot be deleted before their retention date, " +
+				"even by privileged delete, and the compliance domains require a cluster in compliance mode.",
+			MarkdownDescription: "Type of the SmartLock domain: `enterprise` or `compliance`. The committed files of a compliance domain cannot be deleted before their retention date, " +
+				"even by privileged delete, and the compliance domains require a cluster in compliance mode.",
+			Required:   true,
+			Validators: []validator.String{stringvalidator.OneOf(helper.WormDomainTypeEnterprise, helper.WormDomainTypeCompliance)},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"default_retention": schema.StringAttribute{
+			Description: "Retention period applied to the files committed without a retention date, as an ISO 8601 duration such as P1Y6M, " +
+				"or forever, use_min or use_max.",
+			MarkdownDescription: "Retention period applied to the files committed without a retention date, as an ISO 8601 duration such as `P1Y6M`, " +
+				"or `forever`, `use_min` or `use_max`.",
+			Optional:   true,
+			Computed:   true,
+			Validators: []validator.String{wormRetentionValidator()},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"min_retention": schema.StringAttribute{
+			Description:         "Minimum retention period of the committed files, as an ISO 8601 duration such as P30D, or forever.",
+			MarkdownDescription: "Minimum retention period of the committed files, as an ISO 8601 duration such as `P30D`, or `forever`.",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.String{wormRetentionValidator(), stringvalidator.NoneOf(helper.WormRetentionUseMin, helper.WormRetentionUseMax)},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"max_retention": schema.StringAttribute{
+			Description:         "Maximum retention period of the committed files, as an ISO 8601 duration such as P7Y, or forever.",
+			MarkdownDescription: "Maximum retention period of the committed files, as an ISO 8601 duration such as `P7Y`, or `forever`.",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.String{wormRetentionValidator(), stringvalidator.NoneOf(helper.WormRetentionUseMin, helper.WormRetentionUseMax)},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"autocommit_offset": schema.Int64Attribute{
+			Description:         "Seconds after the last modification of a file before it is committed automatically. Null when the files are committed manually.",
+			MarkdownDescription: "Seconds after the last modification of a file before it is committed automatically. Null when the files are committed manually.",
+			Optional:            true,
+			Computed:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(0)},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"privileged_delete": schema.StringAttribute{
+			Description: "Whether the root user can delete the committed files of an enterprise domain before their retention date: on, off or disabled. " +
+				"Disabled is permanent and cannot be changed back.",
+			MarkdownDescription: "Whether the root user can delete the committed files of an enterprise domain before their retention date: `on`, `off` or `disabled`. " +
+				"`disabled` is permanent and cannot be changed back.",
+			Optional:   true,
+			Computed:   true,
+			Validators: []validator.String{stringvalidator.OneOf("on", "off", "disabled")},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"override_date": schema.Int64Attribute{
+			Description:         "Unix Epoch time until which all the committed files of the domain are protected, whatever their retention date.",
+			MarkdownDescription: "Unix Epoch time until which all the committed files of the domain are protected, whatever their retention date.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func wormRetentionValidator() validator.String {
+	return stringvalidator.RegexMatches(helper.WormRetentionPattern, "must be an ISO 8601 duration such as P1Y6M, or forever, use_min or use_max")
+}
+
+// Configure configures the resource.
+func (r *WormDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	pscaleClient, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = pscaleClient
+}
+
+// Create allocates the resource.
+func (r *WormDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "Creating SmartLock domain")
+	var plan models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainPath := plan.Path.ValueString()
+	created, err := helper.CreateWormDomainDirectory(ctx, r.client, domainPath)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SmartLock domain", helper.GetErrorString(err, constants.CreateWormDomainErrorMsg+"with error: "))
+		return
+	}
+	domainID, err := helper.CreateWormDomain(ctx, r.client, domainPath, helper.WormDomainResourceSettings(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SmartLock domain", helper.GetErrorString(err, constants.CreateWormDomainErrorMsg+"with error: "))
+		// if err, revert the creation of the directory
+		if created {
+			if err := helper.DeleteFileSystem(ctx, r.client, helper.GetDirectoryPath(domainPath, "")); err != nil {
+				tflog.Error(ctx, fmt.Sprintf("Error deleting directory when reverting creation - %s", err.Error()))
+			}
+		}
+		return
+	}
+	tflog.Debug(ctx, "SmartLock domain created", map[string]interface{}{"domainID": domainID})
+	plan.DirectoryCreated = types.BoolValue(created)
+
+	state, err := r.read(ctx, domainID, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating SmartLock domain", helper.GetErrorString(err, constants.ReadWormDomainErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with creating SmartLock domain")
+}
+
+// Read reads the resource state.
+func (r *WormDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "Reading SmartLock domain")
+	var state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := state.ID.ValueString()
+	state, err := r.read(ctx, domainID, state)
+	if err != nil {
+		if helper.IsPAPINotFound(err) {
+			tflog.Warn(ctx, "SmartLock domain not found, removing it from the state", map[string]interface{}{"domainID": domainID})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error reading SmartLock domain", helper.GetErrorString(err, constants.ReadWormDomainErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with reading SmartLock domain")
+}
+
+// Update updates the resource state.
+func (r *WormDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "Updating SmartLock domain")
+	var plan, state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helper.UpdateWormDomain(ctx, r.client, state.ID.ValueString(), helper.WormDomainResourceSettings(plan)); err != nil {
+		resp.Diagnostics.AddError("Error updating SmartLock domain", helper.GetErrorString(err, constants.UpdateWormDomainErrorMsg+"with error: "))
+		return
+	}
+
+	state, err := r.read(ctx, state.ID.ValueString(), plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating SmartLock domain", helper.GetErrorString(err, constants.ReadWormDomainErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Info(ctx, "Done with updating SmartLock domain")
+}
+
+// Delete deletes the resource.
+// A SmartLock domain is removed with its root directory when the resource created it, which is refused when the domain contains committed files.
+// Otherwise the directory and its domain are left on the cluster.
+func (r *WormDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Deleting SmartLock domain")
+	var state models.WormDomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainPath := state.Path.ValueString()
+	if !state.DirectoryCreated.ValueBool() {
+		tflog.Warn(ctx, "SmartLock domain directory was not created by the resource, leaving it on the cluster", map[string]interface{}{"path": domainPath})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, wormDomainDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := helper.CheckWormDomainDeletable(ctx, r.client, domainPath)
+	if err == nil {
+		err = helper.DeleteFileSystem(ctx, r.client, helper.GetDirectoryPath(domainPath, ""))
+	}
+	if err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			resp.Diagnostics.AddError("Error deleting SmartLock domain",
+				fmt.Sprintf("Timed out after %s deleting SmartLock domain %s, increase the delete timeout for large directory trees", deleteTimeout, domainPath))
+			return
+		}
+		resp.Diagnostics.AddError("Error deleting SmartLock domain", helper.GetErrorString(err, constants.DeleteWormDomainErrorMsg+"with error: "))
+		return
+	}
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "Done with deleting SmartLock domain")
+}
+
+// ImportState imports a SmartLock domain by its ID or the path of its root directory.
+func (r *WormDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "id"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainID := parsedID.ID
+	if wormDomainPathPattern.MatchString(domainID) {
+		domain, err := helper.FindWormDomainByPath(ctx, r.client, domainID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error importing SmartLock domain", helper.GetErrorString(err, constants.ReadWormDomainErrorMsg+"with error: "))
+			return
+		}
+		if domain == nil {
+			resp.Diagnostics.AddError("Error importing SmartLock domain", fmt.Sprintf("There is no SmartLock domain rooted at %s", domainID))
+			return
+		}
+		domainID = fmt.Sprint(domain.GetId())
+	}
+	state, err := r.read(ctx, domainID, models.WormDomainResourceModel{
+		DirectoryCreated: types.BoolValue(false),
+		Timeouts:         nullTimeouts(wormDomainTimeouts),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing SmartLock domain", helper.GetErrorString(err, constants.ReadWormDomainErrorMsg+"with error: "))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// read returns the state of the SmartLock domain with the given ID, keeping the retention periods of the prior model that are equivalent.
+func (r *WormDomainResource) read(ctx context.Context, domainID string, prior models.WormDomainResourceModel) (models.WormDomainResourceModel, error) {
+	domain, err := helper.GetWormDomain(ctx, r.client, domainID)
+	if err != nil {
+		return prior, err
+	}
+	state := prior
+	helper.UpdateWormDomainResourceState(&state, domain)
+	return state, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-powerscale/powerscale/helper"
+	"testing"
+
+	"github.com/bytedance/mockey"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWormDomainResource(t *testing.T) {
+	skipIfSimulated(t, "SmartLock domains are created on directories of the namespace API, which is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: ProviderConfig + WormDomainResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerscale_worm_domain.test", "id"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "path", "/ifs/tfacc_worm_domain"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "type", "enterprise"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "default_retention", "P1Y"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "min_retention", "P1D"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "max_retention", "forever"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "autocommit_offset", "3600"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "privileged_delete", "off"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "directory_created", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "powerscale_worm_domain.test",
+				ImportState:             true,
+				ImportStateId:           "/ifs/tfacc_worm_domain",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"directory_created"},
+			},
+			// Update testing
+			{
+				Config: ProviderConfig + WormDomainResourceUpdateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "default_retention", "P2Y6M"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "autocommit_offset", "7200"),
+					resource.TestCheckResourceAttr("powerscale_worm_domain.test", "privileged_delete", "on"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWormDomainResourceErrors(t *testing.T) {
+	skipIfSimulated(t, "SmartLock domains are created on directories of the namespace API, which is not simulated")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// invalid retention
+			{
+				Config:      ProviderConfig + WormDomainResourceInvalidRetentionConfig,
+				ExpectError: regexp.MustCompile("must be an ISO 8601 duration"),
+			},
+			// create error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.CreateWormDomain).Return("", fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainResourceConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + WormDomainResourceConfig,
+			},
+			// update error
+			{
+				PreConfig: func() {
+					FunctionMocker = mockey.Mock(helper.UpdateWormDomain).Return(fmt.Errorf("mock error")).Build()
+				},
+				Config:      ProviderConfig + WormDomainResourceUpdateConfig,
+				ExpectError: regexp.MustCompile("mock error"),
+			},
+			// destroy is refused with committed files
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+					FunctionMocker = mockey.Mock(helper.FindWormCommittedFile).Return("/ifs/tfacc_worm_domain/report.pdf", nil).Build()
+				},
+				Config:      ProviderConfig + WormDomainResourceConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("contains committed files"),
+			},
+			// destroy times out walking the directory tree
+			{
+				PreConfig: func() {
+					FunctionMocker.Release()
+				},
+				Config: ProviderConfig + WormDomainResourceDeleteTimeoutConfig,
+			},
+			{
+				Config:      ProviderConfig + WormDomainResourceDeleteTimeoutConfig,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Timed out"),
+			},
+			{
+				Config: ProviderConfig + WormDomainResourceConfig,
+			},
+			// import error
+			{
+				ResourceName:  "powerscale_worm_domain.test",
+				ImportState:   true,
+				ImportStateId: "/ifs/tfacc_unknown_worm_domain",
+				ExpectError:   regexp.MustCompile("There is no SmartLock domain"),
+			},
+		},
+	})
+}
+
+var WormDomainResourceConfig = `
+resource "powerscale_worm_domain" "test" {
+	path              = "/ifs/tfacc_worm_domain"
+	type              = "enterprise"
+	default_retention = "P1Y"
+	min_retention     = "P1D"
+	max_retention     = "forever"
+	autocommit_offset = 3600
+	privileged_delete = "off"
+}
+`
+
+var WormDomainResourceUpdateConfig = `
+resource "powerscale_worm_domain" "test" {
+	path              = "/ifs/tfacc_worm_domain"
+	type              = "enterprise"
+	default_retention = "P2Y6M"
+	min_retention     = "P1D"
+	max_retention     = "forever"
+	autocommit_offset = 7200
+	privileged_delete = "on"
+}
+`
+
+var WormDomainResourceDeleteTimeoutConfig = `
+resource "powerscale_worm_domain" "test" {
+	path              = "/ifs/tfacc_worm_domain"
+	type              = "enterprise"
+	default_retention = "P1Y"
+	min_retention     = "P1D"
+	max_retention     = "forever"
+	autocommit_offset = 3600
+	privileged_delete = "off"
+	timeouts {
+		delete = "1ns"
+	}
+}
+`
+
+var WormDomainResourceInvalidRetentionConfig = `
+resource "powerscale_worm_domain" "test" {
+	path              = "/ifs/tfacc_worm_domain"
+	type              = "enterprise"
+	default_retention = "1 year"
+}
+`
@@ -27,6 +27,7 @@ import (
 	"terraform-provider-powerscale/powerscale/helper"
 	"terraform-provider-powerscale/powerscale/models"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,9 +35,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// writableSnapshotTimeouts bounds the creation and the deletion, which completes asynchronously on the cluster.
+var writableSnapshotTimeouts = timeouts.Opts{Create: true, Delete: true}
+
 // NewWriteableSnapshotResource creates a new resource.
 func NewWriteableSnapshotResource() resource.Resource {
 	return &WritableSnapshotResource{}
@@ -132,6 +137,9 @@ func (r *WritableSnapshotResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, writableSnapshotTimeouts, writableSnapshotTimeout),
+		},
 	}
 }
 
@@ -178,6 +186,14 @@ func (r *WritableSnapshotResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, writableSnapshotTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Update writable snapshot settings
 	toUpdate := powerscale.V14SnapshotWritableItem{
 		DstPath: plan.DstPath.ValueString(),
@@ -196,8 +212,23 @@ func (r *WritableSnapshotResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// wait for the writable snapshot to be usable
+	if writableSnapshotResponse.State != "active" {
+		writableSnapshotResponse, err = helper.WaitForWritableSnapshot(ctx, r.client, plan.DstPath.ValueString())
+		if err != nil {
+			errStr := constants.ReadWritableSnapshotErrorMsg + " with error: "
+			message := helper.GetErrorString(err, errStr)
+			resp.Diagnostics.AddError(
+				"Error waiting for writable snapshot",
+				message,
+			)
+			return
+		}
+	}
+
 	var state models.WritableSnapshot
 	helper.UpdateWritableSnapshotState(&state, writableSnapshotResponse)
+	state.Timeouts = plan.Timeouts
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 	tflog.Info(ctx, "Done with Create Writable Snapshot resource state")
@@ -223,6 +254,14 @@ func (r *WritableSnapshotResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, writableSnapshotTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the writable snapshot
 	err := helper.DeleteWritableSnapshot(ctx, r.client, state.DstPath.ValueString())
 	if err != nil {
@@ -234,6 +273,15 @@ func (r *WritableSnapshotResource) Delete(ctx context.Context, req resource.Dele
 		)
 		return
 	}
+	if err := helper.WaitForWritableSnapshotDeletion(ctx, r.client, state.DstPath.ValueString()); err != nil {
+		errStr := constants.DeleteWritableSnapshotErrorMsg + " with error: "
+		message := helper.GetErrorString(err, errStr)
+		resp.Diagnostics.AddError(
+			"Error waiting for writable snapshot deletion",
+			message,
+		)
+		return
+	}
 
 	// Remove the resource from the state
 	resp.State.RemoveResource(ctx)
@@ -245,10 +293,15 @@ func (r *WritableSnapshotResource) Delete(ctx context.Context, req resource.Dele
 func (r *WritableSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Info(ctx, "Importing Writable Snapshot resource state")
 
-	// Read the resource state
 	var state models.WritableSnapshot
-	// Update the writable snapshot settings
-	writableSnapshotResponse, err := helper.GetWritableSnapshot(ctx, r.client, state.DstPath.ValueString())
+	parsedID, diags := helper.ParseImportID(req.ID, helper.ImportIDFormat{ID: "dst_path"})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.DstPath = types.StringValue(parsedID.ID)
+
+	writableSnapshotResponse, err := helper.GetWritableSnapshot(ctx, r.client, parsedID.ID)
 	if err != nil {
 		errStr := constants.ReadWritableSnapshotErrorMsg + "with error: "
 		message := helper.GetErrorString(err, errStr)
@@ -259,9 +312,15 @@ func (r *WritableSnapshotResource) ImportState(ctx context.Context, req resource
 		return
 	}
 
-	if len(writableSnapshotResponse.Writable) > 0 {
-		helper.UpdateWritableSnapshotState(&state, &writableSnapshotResponse.Writable[0])
+	if len(writableSnapshotResponse.Writable) == 0 {
+		resp.Diagnostics.AddError(
+			"Error reading writable snapshot",
+			fmt.Sprintf("Writable snapshot with destination path %s not found", parsedID.ID),
+		)
+		return
 	}
+	helper.UpdateWritableSnapshotState(&state, &writableSnapshotResponse.Writable[0])
+	state.Timeouts = nullTimeouts(writableSnapshotTimeouts)
 
 	// Save the updated resource state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -27,7 +27,6 @@ import (
 
 	"github.com/bytedance/mockey"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // TestAccwritableSnapshotResourceImport - Tests the import of the writable snapshot resource.
@@ -55,26 +54,29 @@ func TestAccwritableSnapshotResourceImport(t *testing.T) {
 					}
 					FunctionMocker = mockey.Mock(helper.GetWritableSnapshot).Return(nil, fmt.Errorf("mock error")).Build()
 				},
-				Config:       ProviderConfig + writableSnapshotResourceConfig,
-				ResourceName: writableSnapshotResourceName,
-				ImportState:  true,
-				ExpectError:  regexp.MustCompile(`.*mock error*.`),
+				Config:        ProviderConfig + writableSnapshotResourceConfig,
+				ResourceName:  writableSnapshotResourceName,
+				ImportState:   true,
+				ImportStateId: "/ifs/abcd",
+				ExpectError:   regexp.MustCompile(`.*mock error*.`),
 			},
 			// Import testing
 			{
 				PreConfig: func() {
 					FunctionMocker.Release()
 				},
-				Config:       ProviderConfig + writableSnapshotResourceConfig,
-				ResourceName: writableSnapshotResourceName,
-				ImportState:  true,
-				ExpectError:  nil,
-				ImportStateCheck: func(s []*terraform.InstanceState) error {
-					resource.TestCheckResourceAttrSet(writableSnapshotResourceName, "dst_path")
-					resource.TestCheckResourceAttrSet(writableSnapshotResourceName, "src_path")
-					resource.TestCheckResourceAttrSet(writableSnapshotResourceName, "snap_id")
-					return nil
-				},
+				Config:            ProviderConfig + writableSnapshotResourceConfig,
+				ResourceName:      writableSnapshotResourceName,
+				ImportState:       true,
+				ImportStateId:     "/ifs/abcd",
+				ImportStateVerify: true,
+			},
+			{
+				Config:        ProviderConfig + writableSnapshotResourceConfig,
+				ResourceName:  writableSnapshotResourceName,
+				ImportState:   true,
+				ImportStateId: "/ifs/tfacc_writable_snapshot_missing",
+				ExpectError:   regexp.MustCompile(`.*Error reading writable snapshot*.`),
 			},
 		},
 	})
@@ -100,6 +102,7 @@ func TestAccwritableSnapshotResourceImportMockErr(t *testing.T) {
 				Config:            ProviderConfig + writableSnapshotResourceConfig,
 				ResourceName:      "powerscale_writable_snapshot.test",
 				ImportState:       true,
+				ImportStateId:     "/ifs/abcd",
 				ExpectError:       regexp.MustCompile(`.*mock error*.`),
 				ImportStateVerify: true,
 			},
@@ -0,0 +1,1047 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// registerCatalog registers the simulated PAPI collections and settings, and seeds the objects of a new cluster.
+func registerCatalog(s *Server) {
+	registerZones(s)
+	registerProtocols(s)
+	registerQuotas(s)
+	registerSnapshots(s)
+	registerAuth(s)
+	registerSyncIQ(s)
+	registerJobs(s)
+	registerWorm(s)
+	registerAudit(s)
+	registerEvents(s)
+}
+
+func registerZones(s *Server) {
+	s.register("zones", &collection{
+		key:      "zones",
+		lookup:   []string{"zone_id"},
+		nameIsID: true,
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			name := fmt.Sprint(object["name"])
+			setDefaults(object, map[string]interface{}{
+				"alternate_system_provider":   "lsa-file-provider:System",
+				"auth_providers":              []interface{}{"lsa-local-provider:" + name},
+				"cache_entry_expiry":          14400,
+				"create_path":                 false,
+				"force_overlap":               false,
+				"groupnet":                    "groupnet0",
+				"home_directory_umask":        63,
+				"ifs_restricted":              []interface{}{},
+				"map_untrusted":               "",
+				"negative_cache_entry_expiry": 60,
+				"netbios_name":                "",
+				"path":                        "/ifs/" + name,
+				"skeleton_directory":          "/usr/share/skel",
+				"system":                      false,
+				"system_provider":             "lsa-file-provider:System",
+				"user_mapping_rules":          []interface{}{},
+				"zone_id":                     s.nextID(),
+			})
+		},
+	})
+	_ = s.Seed("zones", "", map[string]interface{}{"name": systemZone, "path": "/ifs", "system": true, "zone_id": 1})
+}
+
+func registerProtocols(s *Server) {
+	s.register("protocols/smb/shares", &collection{
+		key:      "shares",
+		lookup:   []string{"name"},
+		nameIsID: true,
+		zoned:    true,
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"access_based_enumeration":           false,
+				"access_based_enumeration_root_only": false,
+				"allow_delete_readonly":              false,
+				"allow_execute_always":               false,
+				"ca_timeout":                         120,
+				"ca_write_integrity":                 "write-read-coherent",
+				"change_notify":                      "norecurse",
+				"continuously_available":             false,
+				"create_permissions":                 "default acl",
+				"csc_policy":                         "manual",
+				"description":                        "",
+				"directory_create_mask":              448,
+				"directory_create_mode":              0,
+				"file_create_mask":                   448,
+				"file_create_mode":                   64,
+				"file_filter_extensions":             []interface{}{},
+				"file_filter_type":                   "deny",
+				"file_filtering_enabled":             false,
+				"hide_dot_files":                     false,
+				"host_acl":                           []interface{}{},
+				"impersonate_guest":                  "never",
+				"impersonate_user":                   "",
+				"inheritable_path_acl":               false,
+				"mangle_byte_start":                  60672,
+				"mangle_map":                         []interface{}{"0x01-0x1F:-1", "0x22:-1", "0x2A:-1", "0x3A:-1", "0x3C:-1", "0x3E:-1", "0x3F:-1", "0x5C:-1"},
+				"ntfs_acl_support":                   true,
+				"oplocks":                            true,
+				"permissions":                        []interface{}{everyoneRead()},
+				"run_as_root":                        []interface{}{},
+				"smb3_encryption_enabled":            false,
+				"sparse_file":                        false,
+				"strict_flush":                       true,
+				"strict_locking":                     false,
+				"zid":                                1,
+			})
+		},
+	})
+	s.register("protocols/nfs/exports", &collection{
+		key:   "exports",
+		zoned: true,
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return s.nextID()
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"all_dirs":                false,
+				"block_size":              8192,
+				"can_set_time":            true,
+				"case_insensitive":        false,
+				"case_preserving":         true,
+				"chown_restricted":        false,
+				"clients":                 []interface{}{},
+				"commit_asynchronous":     false,
+				"conflicting_paths":       []interface{}{},
+				"description":             "",
+				"directory_transfer_size": 131072,
+				"encoding":                "DEFAULT",
+				"link_max":                32767,
+				"map_all":                 mapping(false),
+				"map_failure":             mapping(false),
+				"map_full":                true,
+				"map_lookup_uid":          false,
+				"map_non_root":            mapping(false),
+				"map_retry":               true,
+				"map_root":                mapping(true),
+				"max_file_size":           int64(9223372036854775807),
+				"name_max_size":           255,
+				"no_truncate":             false,
+				"read_only":               false,
+				"read_only_clients":       []interface{}{},
+				"read_transfer_max_size":  1048576,
+				"read_transfer_multiple":  512,
+				"read_transfer_size":      131072,
+				"read_write_clients":      []interface{}{},
+				"readdirplus":             true,
+				"readdirplus_prefetch":    10,
+				"return_32bit_file_ids":   false,
+				"root_clients":            []interface{}{},
+				"security_flavors":        []interface{}{"unix"},
+				"setattr_asynchronous":    false,
+				"snapshot":                "-",
+				"symlinks":                true,
+				"time_delta":              1e-9,
+				"unresolved_clients":      []interface{}{},
+				"write_datasync_action":   "DATASYNC",
+				"write_datasync_reply":    "DATASYNC",
+				"write_filesync_action":   "FILESYNC",
+				"write_filesync_reply":    "FILESYNC",
+				"write_transfer_max_size": 1048576,
+				"write_transfer_multiple": 512,
+				"write_transfer_size":     524288,
+				"write_unstable_action":   "UNSTABLE",
+				"write_unstable_reply":    "UNSTABLE",
+			})
+		},
+	})
+	s.register("protocols/nfs/aliases", &collection{
+		key:      "aliases",
+		lookup:   []string{"name"},
+		nameIsID: true,
+		zoned:    true,
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{"health": "good"})
+		},
+	})
+	s.register("protocols/s3/buckets", &collection{
+		key:   "buckets",
+		zoned: true,
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"acl":               []interface{}{},
+				"description":       "",
+				"object_acl_policy": "replace",
+				"owner":             DefaultUsername,
+			})
+		},
+	})
+
+	s.registerSettings("protocols/smb/settings/global", false, map[string]interface{}{
+		"access_based_share_enum":     false,
+		"dot_snap_accessible_child":   true,
+		"dot_snap_accessible_root":    true,
+		"dot_snap_visible_child":      false,
+		"dot_snap_visible_root":       true,
+		"enable_security_signatures":  false,
+		"guest_user":                  "nobody",
+		"ignore_eas":                  false,
+		"onefs_cpu_multiplier":        4,
+		"onefs_num_workers":           0,
+		"require_security_signatures": false,
+		"server_side_copy":            true,
+		"server_string":               "PowerScale Server",
+		"service":                     true,
+		"support_multichannel":        true,
+		"support_netbios":             false,
+		"support_smb2":                true,
+		"support_smb3_encryption":     false,
+	})
+	s.registerSettings("protocols/smb/settings/share", true, map[string]interface{}{
+		"access_based_enumeration":           false,
+		"access_based_enumeration_root_only": false,
+		"allow_delete_readonly":              false,
+		"ca_timeout":                         120,
+		"change_notify":                      "norecurse",
+		"create_permissions":                 "default acl",
+		"csc_policy":                         "manual",
+		"directory_create_mask":              448,
+		"file_create_mask":                   448,
+		"hide_dot_files":                     false,
+		"host_acl":                           []interface{}{},
+		"impersonate_guest":                  "never",
+		"ntfs_acl_support":                   true,
+		"oplocks":                            true,
+		"strict_flush":                       true,
+		"strict_locking":                     false,
+	})
+	s.registerSettings("protocols/nfs/settings/global", false, map[string]interface{}{
+		"nfsv3_enabled":      true,
+		"nfsv3_rdma_enabled": false,
+		"nfsv40_enabled":     false,
+		"nfsv41_enabled":     false,
+		"nfsv42_enabled":     false,
+		"nfsv4_enabled":      false,
+		"rpc_maxthreads":     16,
+		"rpc_minthreads":     16,
+		"rquota_enabled":     false,
+		"service":            true,
+	})
+	s.registerSettings("protocols/nfs/settings/export", true, map[string]interface{}{
+		"all_dirs":              false,
+		"block_size":            8192,
+		"can_set_time":          true,
+		"case_insensitive":      false,
+		"case_preserving":       true,
+		"chown_restricted":      false,
+		"commit_asynchronous":   false,
+		"encoding":              "DEFAULT",
+		"link_max":              32767,
+		"map_all":               mapping(false),
+		"map_failure":           mapping(false),
+		"map_lookup_uid":        false,
+		"map_non_root":          mapping(false),
+		"map_retry":             true,
+		"map_root":              mapping(true),
+		"max_file_size":         int64(9223372036854775807),
+		"name_max_size":         255,
+		"readdirplus":           true,
+		"return_32bit_file_ids": false,
+		"security_flavors":      []interface{}{"unix"},
+		"setattr_asynchronous":  false,
+		"snapshot":              "-",
+		"symlinks":              true,
+		"time_delta":            1e-9,
+	})
+	s.registerSettings("protocols/nfs/settings/zone", true, map[string]interface{}{
+		"nfsv4_allow_numeric_ids": true,
+		"nfsv4_domain":            "localhost",
+		"nfsv4_no_domain":         false,
+		"nfsv4_no_domain_uids":    true,
+		"nfsv4_no_names":          false,
+		"nfsv4_replace_domain":    true,
+	})
+	s.registerSettings("protocols/s3/settings/global", false, map[string]interface{}{
+		"https_only": false,
+		"http_port":  9020,
+		"https_port": 9021,
+		"service":    false,
+	})
+	s.registerSettings("protocols/s3/settings/zone", true, map[string]interface{}{
+		"base_domain":                  "",
+		"bucket_directory_create_mode": 511,
+		"object_acl_policy":            "replace",
+		"root_path":                    "/ifs",
+		"use_md5_for_etag":             false,
+		"validate_content_md5":         false,
+	})
+}
+
+func registerQuotas(s *Server) {
+	s.register("quota/quotas", &collection{
+		key: "quotas",
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return randomHex(11)
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"container":           false,
+				"description":         "",
+				"efficiency_ratio":    nil,
+				"enforced":            false,
+				"ignore_limit_checks": false,
+				"include_snapshots":   false,
+				"linked":              false,
+				"notifications":       "default",
+				"persona":             nil,
+				"ready":               true,
+				"reduction_ratio":     nil,
+				"thresholds": map[string]interface{}{
+					"advisory":               nil,
+					"advisory_exceeded":      false,
+					"advisory_last_exceeded": nil,
+					"hard":                   nil,
+					"hard_exceeded":          false,
+					"hard_last_exceeded":     nil,
+					"percent_advisory":       nil,
+					"percent_soft":           nil,
+					"soft":                   nil,
+					"soft_exceeded":          false,
+					"soft_grace":             nil,
+					"soft_last_exceeded":     nil,
+				},
+				"thresholds_on": "applogicalsize",
+				"usage": map[string]interface{}{
+					"applogical":                0,
+					"applogical_ready":          true,
+					"fslogical":                 0,
+					"fslogical_ready":           true,
+					"fsphysical":                0,
+					"fsphysical_ready":          true,
+					"inodes":                    0,
+					"inodes_ready":              true,
+					"physical":                  0,
+					"physical_data":             0,
+					"physical_data_ready":       true,
+					"physical_protection":       0,
+					"physical_protection_ready": true,
+					"physical_ready":            true,
+					"shadow_refs":               0,
+					"shadow_refs_ready":         true,
+				},
+			})
+			if thresholds, ok := object["thresholds"].(map[string]interface{}); ok {
+				object["enforced"] = thresholds["hard"] != nil || thresholds["soft"] != nil || thresholds["advisory"] != nil
+			}
+		},
+	})
+	notificationDefaults := func(s *Server, _ string, object map[string]interface{}) {
+		setDefaults(object, map[string]interface{}{
+			"action_alert":         true,
+			"action_email_address": "",
+			"action_email_owner":   false,
+			"email_template":       "",
+			"holdoff":              0,
+			"schedule":             "",
+		})
+	}
+	s.register("quota/quotas/*/notifications", &collection{
+		key: "notifications",
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return randomHex(11)
+		},
+		defaults: notificationDefaults,
+		changed: func(s *Server, parents []string, _ string, rules []map[string]interface{}) {
+			// a quota with notification rules of its own no longer uses the default ones
+			for _, quota := range s.store[storeKeyOf("quota/quotas", nil, "")] {
+				if quota["id"] == parents[0] {
+					quota["notifications"] = "custom"
+					if len(rules) == 0 {
+						quota["notifications"] = "default"
+					}
+				}
+			}
+		},
+	})
+	s.register("quota/settings/notifications", &collection{
+		key: "notifications",
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return randomHex(11)
+		},
+		defaults: notificationDefaults,
+	})
+	s.register("quota/settings/mappings", &collection{key: "mappings", idField: "domain"})
+	s.registerSettings("quota/settings/reports", false, map[string]interface{}{
+		"live_dir":         "/ifs/.isilon/smartquotas/reports",
+		"live_retain":      5,
+		"manual_dir":       "/ifs/.isilon/smartquotas/reports",
+		"manual_retain":    5,
+		"schedule":         "",
+		"scheduled_dir":    "/ifs/.isilon/smartquotas/reports",
+		"scheduled_retain": 5,
+	})
+	s.register("quota/reports", &collection{
+		key: "reports",
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return fmt.Sprintf("manual_%d", s.nextID())
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"generated": "manual",
+				"time":      time.Now().Unix(),
+				"type":      "summary",
+			})
+		},
+	})
+}
+
+func registerSnapshots(s *Server) {
+	s.register("snapshot/snapshots", &collection{
+		key:    "snapshots",
+		lookup: []string{"name"},
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return s.nextID()
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"alias":          nil,
+				"created":        time.Now().Unix(),
+				"expires":        nil,
+				"has_locks":      false,
+				"name":           fmt.Sprintf("s%v", object["id"]),
+				"pct_filesystem": 0,
+				"pct_reserve":    0,
+				"schedule":       nil,
+				"shadow_bytes":   0,
+				"size":           0,
+				"state":          "active",
+				"target_id":      nil,
+				"target_name":    nil,
+			})
+		},
+		// PAPI answers a snapshot creation with the snapshot
+		created: func(object map[string]interface{}) interface{} {
+			return object
+		},
+	})
+	s.register("snapshot/schedules", &collection{
+		key:    "schedules",
+		lookup: []string{"name"},
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return s.nextID()
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"alias":         nil,
+				"duration":      nil,
+				"next_run":      time.Now().Add(time.Hour).Unix(),
+				"next_snapshot": object["pattern"],
+				"path":          "/ifs",
+				"pattern":       "ScheduleName_duration_%Y-%m-%d_%H:%M",
+				"schedule":      "every 1 days at 12:00 AM",
+			})
+		},
+	})
+	s.register("snapshot/writable", &collection{
+		key:    "writable",
+		lookup: []string{"dst_path"},
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return s.nextID()
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"created":   time.Now().Unix(),
+				"log_size":  0,
+				"phys_size": 0,
+				"snap_id":   0,
+				"snap_name": fmt.Sprint(object["src_snap"]),
+				"src_path":  "/ifs",
+				"state":     "active",
+			})
+		},
+		created: func(object map[string]interface{}) interface{} {
+			return object
+		},
+	})
+	s.registerSettings("snapshot/settings", false, map[string]interface{}{
+		"autocreate":                true,
+		"autodelete":                true,
+		"global_visible_accessible": true,
+		"local_root_accessible":     true,
+		"local_root_visible":        true,
+		"local_subdir_accessible":   true,
+		"nfs_root_accessible":       true,
+		"nfs_root_visible":          true,
+		"nfs_subdir_accessible":     true,
+		"reserve":                   0,
+		"service":                   true,
+		"cifs_root_accessible":      true,
+		"cifs_root_visible":         true,
+		"cifs_subdir_accessible":    true,
+	})
+}
+
+func registerAuth(s *Server) {
+	s.register("auth/users", &collection{
+		key:      "users",
+		lookup:   []string{"name"},
+		nameIsID: true,
+		zoned:    true,
+		defaults: func(s *Server, zone string, object map[string]interface{}) {
+			name := fmt.Sprint(object["name"])
+			uid := personaID("UID", object["uid"], s.nextID())
+			object["uid"] = persona(uid, name, "user")
+			object["sid"] = persona(personaID("SID", object["sid"], "S-1-5-21-1000-1000-1000-"+strings.TrimPrefix(uid, "UID:")), name, "user")
+			gid := personaID("GID", primaryGroup(object), 1800)
+			object["gid"] = persona(gid, "Isilon Users", "group")
+			object["primary_group_sid"] = persona("SID:S-1-5-21-1000-1000-1000-"+strings.TrimPrefix(gid, "GID:"), "Isilon Users", "group")
+			object["on_disk_user_identity"] = object["uid"]
+			delete(object, "primary_group")
+			delete(object, "password")
+			setDefaults(object, map[string]interface{}{
+				"dn":                       fmt.Sprintf("CN=%s,CN=Users,DC=SIMULATOR", name),
+				"dns_domain":               nil,
+				"domain":                   "SIMULATOR",
+				"email":                    nil,
+				"enabled":                  true,
+				"expired":                  false,
+				"expiry":                   nil,
+				"generated_gid":            false,
+				"generated_uid":            false,
+				"generated_upn":            true,
+				"home_directory":           "/ifs/home/" + name,
+				"locked":                   false,
+				"max_password_age":         nil,
+				"member_of":                nil,
+				"object_history":           []interface{}{},
+				"password_expired":         false,
+				"password_expires":         false,
+				"password_expiry":          nil,
+				"password_last_set":        time.Now().Unix(),
+				"prompt_password_change":   false,
+				"provider":                 "lsa-local-provider:" + zone,
+				"sam_account_name":         name,
+				"shell":                    "/bin/zsh",
+				"type":                     "user",
+				"upn":                      fmt.Sprintf("%s@SIMULATOR", name),
+				"user_can_change_password": true,
+			})
+		},
+		created: func(object map[string]interface{}) interface{} {
+			return map[string]interface{}{"id": personaOf(object, "sid")}
+		},
+	})
+	s.register("auth/groups", &collection{
+		key:      "groups",
+		lookup:   []string{"name"},
+		nameIsID: true,
+		zoned:    true,
+		defaults: func(s *Server, zone string, object map[string]interface{}) {
+			name := fmt.Sprint(object["name"])
+			gid := personaID("GID", object["gid"], s.nextID())
+			object["gid"] = persona(gid, name, "group")
+			object["sid"] = persona(personaID("SID", object["sid"], "S-1-5-21-1000-1000-1000-"+strings.TrimPrefix(gid, "GID:")), name, "group")
+			delete(object, "members")
+			setDefaults(object, map[string]interface{}{
+				"dn":               fmt.Sprintf("CN=%s,CN=Users,DC=SIMULATOR", name),
+				"dns_domain":       nil,
+				"domain":           "SIMULATOR",
+				"generated_gid":    false,
+				"member_of":        nil,
+				"object_history":   []interface{}{},
+				"provider":         "lsa-local-provider:" + zone,
+				"sam_account_name": name,
+				"type":             "group",
+			})
+		},
+		created: func(object map[string]interface{}) interface{} {
+			return map[string]interface{}{"id": personaOf(object, "sid")}
+		},
+	})
+	s.register("auth/groups/*/members", &collection{
+		key:   "members",
+		zoned: true,
+		newID: func(s *Server, object map[string]interface{}) interface{} {
+			return s.memberID(object)
+		},
+	})
+	s.register("auth/roles", &collection{
+		key:      "roles",
+		lookup:   []string{"name"},
+		nameIsID: true,
+		zoned:    true,
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"description": "",
+				"members":     []interface{}{},
+				"privileges":  []interface{}{},
+			})
+		},
+	})
+	s.register("auth/roles/*/members", &collection{
+		key:   "members",
+		zoned: true,
+		newID: func(s *Server, object map[string]interface{}) interface{} {
+			return s.memberID(object)
+		},
+		changed: func(s *Server, parents []string, zone string, members []map[string]interface{}) {
+			// the members of a role are also listed in the role
+			roles := s.store[storeKeyOf("auth/roles", nil, zone)]
+			for _, role := range roles {
+				if role["id"] == parents[0] || role["name"] == parents[0] {
+					list := make([]interface{}, 0, len(members))
+					for _, member := range members {
+						list = append(list, member)
+					}
+					role["members"] = list
+				}
+			}
+		},
+	})
+	for _, role := range []string{"SystemAdmin", "SecurityAdmin", "AuditAdmin", "BackupAdmin", "VMwareAdmin"} {
+		_ = s.Seed("auth/roles", "", map[string]interface{}{"name": role, "description": role + " role"})
+	}
+	_ = s.Seed("auth/groups", "", map[string]interface{}{"name": "Isilon Users", "gid": 1800})
+	_ = s.Seed("auth/users", "", map[string]interface{}{"name": DefaultUsername, "uid": 10})
+	_ = s.Seed("auth/users", "", map[string]interface{}{"name": "root", "uid": 0})
+}
+
+func registerSyncIQ(s *Server) {
+	s.register("sync/policies", &collection{
+		key:    "policies",
+		lookup: []string{"name"},
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return randomHex(16)
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"accelerated_failback":        false,
+				"action":                      "sync",
+				"allow_copy_fb":               false,
+				"bandwidth_reservation":       nil,
+				"changelist":                  false,
+				"check_integrity":             true,
+				"cloud_deep_copy":             "deny",
+				"conflicted":                  false,
+				"delete_quotas":               true,
+				"description":                 "",
+				"disable_file_split":          false,
+				"disable_fofb":                false,
+				"disable_quota_tmp_dir":       false,
+				"disable_stf":                 false,
+				"enable_hash_tmpdir":          false,
+				"enabled":                     true,
+				"encrypted":                   false,
+				"expected_dataloss":           false,
+				"file_matching_pattern":       map[string]interface{}{"or_criteria": nil},
+				"force_interface":             false,
+				"ignore_recursive_quota":      false,
+				"job_delay":                   nil,
+				"last_job_state":              nil,
+				"last_started":                nil,
+				"last_success":                nil,
+				"log_level":                   "notice",
+				"log_removed_files":           false,
+				"next_run":                    nil,
+				"password_set":                false,
+				"priority":                    0,
+				"report_max_age":              31536000,
+				"report_max_count":            2000,
+				"restrict_target_network":     false,
+				"rpo_alert":                   0,
+				"schedule":                    "",
+				"skip_lookup":                 false,
+				"skip_when_source_unmodified": false,
+				"snapshot_sync_existing":      false,
+				"snapshot_sync_pattern":       "*",
+				"source_exclude_directories":  []interface{}{},
+				"source_include_directories":  []interface{}{},
+				"source_network":              nil,
+				"source_snapshot_archive":     false,
+				"source_snapshot_expiration":  0,
+				"source_snapshot_pattern":     "",
+				"target_compare_initial_sync": false,
+				"target_detect_modifications": true,
+				"target_snapshot_alias":       "SIQ-%{SrcCluster}-%{PolicyName}-latest",
+				"target_snapshot_archive":     false,
+				"target_snapshot_expiration":  0,
+				"target_snapshot_pattern":     "SIQ-%{SrcCluster}-%{PolicyName}-%Y-%m-%d_%H-%M-%S",
+				"workers_per_node":            3,
+			})
+		},
+	})
+	s.register("sync/rules", &collection{
+		key: "rules",
+		newID: func(s *Server, object map[string]interface{}) interface{} {
+			prefix := map[string]string{"bandwidth": "bw", "file_count": "fc", "cpu": "cpu", "worker": "work"}[fmt.Sprint(object["type"])]
+			return fmt.Sprintf("%s-%d", prefix, s.nextID())
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"description": "",
+				"enabled":     true,
+				"schedule":    nil,
+			})
+		},
+	})
+	s.register("sync/jobs", &collection{key: "jobs"})
+	s.register("sync/reports", &collection{key: "reports"})
+	s.register("sync/certificates/peer", &collection{
+		key:    "certificates",
+		lookup: []string{"name"},
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return randomHex(32)
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"description": "",
+				"fingerprint": randomHex(32),
+				"issuer":      "C=US, ST=California, O=Dell, CN=simulator",
+				"not_after":   time.Now().AddDate(1, 0, 0).Unix(),
+				"not_before":  time.Now().Unix(),
+				"status":      "valid",
+				"subject":     "C=US, ST=California, O=Dell, CN=simulator",
+			})
+			delete(object, "certificate_path")
+		},
+	})
+	s.registerSettings("sync/settings", false, map[string]interface{}{
+		"bandwidth_reservation_reserve_absolute":   nil,
+		"bandwidth_reservation_reserve_percentage": 1,
+		"cluster_certificate_id":                   "",
+		"encryption_cipher_list":                   "",
+		"encryption_required":                      false,
+		"force_interface":                          false,
+		"max_concurrent_jobs":                      16,
+		"ocsp_address":                             "",
+		"ocsp_issuer_certificate_id":               "",
+		"preferred_rpo_alert":                      0,
+		"renegotiation_period":                     28800,
+		"report_email":                             []interface{}{},
+		"report_max_age":                           31536000,
+		"report_max_count":                         2000,
+		"restrict_target_network":                  false,
+		"rpo_alerts":                               true,
+		"service":                                  "on",
+		"service_history_max_age":                  31536000,
+		"service_history_max_count":                2000,
+		"source_network":                           nil,
+		"tw_chkpt_interval":                        nil,
+		"use_workers_per_node":                     false,
+	})
+}
+
+func registerJobs(s *Server) {
+	// jobs end as soon as they start, with a report of their single phase
+	s.register("job/jobs", &collection{
+		key: "jobs",
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return s.nextID()
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			now := time.Now().Unix()
+			setDefaults(object, map[string]interface{}{
+				"control_state": "succeeded",
+				"create_time":   now,
+				"current_phase": 1,
+				"description":   "",
+				"end_time":      now,
+				"impact":        "Low",
+				"policy":        "LOW",
+				"priority":      6,
+				"progress":      "Completed",
+				"start_time":    now,
+				"state":         "succeeded",
+				"total_phases":  1,
+			})
+		},
+		changed: func(s *Server, _ []string, _ string, jobs []map[string]interface{}) {
+			key := storeKeyOf("job/reports", nil, "")
+			reported := map[string]bool{}
+			for _, report := range s.store[key] {
+				reported[fmt.Sprint(report["job_id"])] = true
+			}
+			for _, job := range jobs {
+				if reported[fmt.Sprint(job["id"])] {
+					continue
+				}
+				s.store[key] = append(s.store[key], map[string]interface{}{
+					"id":       fmt.Sprintf("%v-1", job["id"]),
+					"job_id":   job["id"],
+					"job_type": job["type"],
+					"phase":    1,
+					"results":  []interface{}{map[string]interface{}{"name": "Elapsed time", "value": "1 second"}},
+					"time":     job["end_time"],
+				})
+			}
+		},
+	})
+	s.register("job/reports", &collection{key: "reports"})
+	s.register("job/policies", &collection{
+		key: "policies",
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"description": "",
+				"intervals":   []interface{}{},
+				"system":      false,
+			})
+		},
+	})
+	for _, policy := range []string{"LOW", "MEDIUM", "HIGH", "OFF_HOURS"} {
+		impact := strings.ToUpper(policy[:1]) + strings.ToLower(policy[1:])
+		if policy == "OFF_HOURS" {
+			impact = "Paused"
+		}
+		_ = s.Seed("job/policies", "", map[string]interface{}{
+			"name":        policy,
+			"description": "System " + policy + " impact policy",
+			"intervals":   []interface{}{map[string]interface{}{"begin": "Sunday 00:00", "end": "Sunday 00:00", "impact": impact}},
+			"system":      true,
+		})
+	}
+	s.register("job/types", &collection{key: "types"})
+	for _, jobType := range []struct {
+		id, exclusionSet, policy, schedule string
+		priority                           int
+	}{
+		{"AutoBalance", "restripe", "LOW", "", 4},
+		{"Collect", "marking", "LOW", "", 4},
+		{"FSAnalyze", "", "LOW", "every day at 22:00", 1},
+		{"MultiScan", "restripe,marking", "LOW", "", 4},
+		{"PermissionRepair", "", "LOW", "", 5},
+		{"SmartPools", "restripe", "LOW", "every day at 22:00", 6},
+		{"SnapRevert", "", "MEDIUM", "", 5},
+		{"TreeDelete", "", "MEDIUM", "", 4},
+	} {
+		var schedule interface{}
+		if len(jobType.schedule) > 0 {
+			schedule = jobType.schedule
+		}
+		_ = s.Seed("job/types", "", map[string]interface{}{
+			"id":                       jobType.id,
+			"allow_multiple_instances": jobType.id == "TreeDelete",
+			"description":              jobType.id + " job",
+			"enabled":                  true,
+			"exclusion_set":            jobType.exclusionSet,
+			"hidden":                   false,
+			"policy":                   jobType.policy,
+			"priority":                 jobType.priority,
+			"schedule":                 schedule,
+		})
+	}
+}
+
+func registerWorm(s *Server) {
+	s.register("worm/domains", &collection{
+		key: "domains",
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return s.nextID()
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"autocommit_offset": nil,
+				"default_retention": nil,
+				"max_retention":     nil,
+				"min_retention":     nil,
+				"override_date":     nil,
+				"privileged_delete": "off",
+				"type":              "enterprise",
+			})
+		},
+	})
+	// the compliance clock is not set on a cluster in enterprise mode
+	s.registerSettings("worm/settings", false, map[string]interface{}{
+		"cdate": nil,
+	})
+}
+
+func registerAudit(s *Server) {
+	s.registerSettings("audit/settings/global", false, map[string]interface{}{
+		"audited_zones":             []interface{}{},
+		"auto_purging_enabled":      false,
+		"cee_server_uris":           []interface{}{},
+		"config_auditing_enabled":   false,
+		"config_syslog_enabled":     false,
+		"hostname":                  "",
+		"protocol_auditing_enabled": false,
+		"retention_period":          180,
+	})
+	s.registerSettings("audit/settings", true, map[string]interface{}{
+		"audit_failure":             []interface{}{"create", "delete", "rename", "set_security"},
+		"audit_success":             []interface{}{"create", "delete", "rename", "set_security"},
+		"syslog_audit_events":       []interface{}{"create", "delete", "rename", "set_security"},
+		"syslog_forwarding_enabled": false,
+	})
+	s.register("audit/topics", &collection{key: "topics"})
+	for _, topic := range []string{"protocol", "config"} {
+		_ = s.Seed("audit/topics", "", map[string]interface{}{
+			"id":                  topic,
+			"max_cached_messages": 2048,
+			"name":                topic,
+		})
+	}
+}
+
+func registerEvents(s *Server) {
+	s.register("event/channels", &collection{
+		key:    "channels",
+		lookup: []string{"name"},
+		newID: func(s *Server, _ map[string]interface{}) interface{} {
+			return s.nextID()
+		},
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"allowed_nodes":  []interface{}{},
+				"enabled":        true,
+				"excluded_nodes": []interface{}{},
+				"parameters":     map[string]interface{}{},
+				"system":         false,
+			})
+		},
+	})
+	s.register("event/alert-conditions", &collection{
+		key: "alert-conditions",
+		defaults: func(s *Server, _ string, object map[string]interface{}) {
+			setDefaults(object, map[string]interface{}{
+				"categories": []interface{}{},
+				"channels":   []interface{}{},
+				"interval":   0,
+				"limit":      0,
+				"severities": []interface{}{},
+			})
+		},
+	})
+	// a resolved and an ongoing event group, noticed an hour ago
+	noticed := time.Now().Unix() - 3600
+	s.register("event/eventgroup-occurrences", &collection{key: "eventgroups"})
+	_ = s.Seed("event/eventgroup-occurrences", "",
+		map[string]interface{}{
+			"causes":       []interface{}{[]interface{}{"External network link ext-1 (igb0) down"}},
+			"channels":     []interface{}{"RemoteSupport"},
+			"events":       2,
+			"id":           "1",
+			"ignore":       false,
+			"last_event":   noticed + 60,
+			"lnn":          1,
+			"resolve_time": noticed + 60,
+			"resolved":     true,
+			"severity":     "critical",
+			"time_noticed": noticed,
+		},
+		map[string]interface{}{
+			"causes":       []interface{}{[]interface{}{"The SmartQuotas notification rules are not set"}},
+			"channels":     []interface{}{},
+			"events":       1,
+			"id":           "2",
+			"ignore":       false,
+			"last_event":   noticed,
+			"lnn":          nil,
+			"resolve_time": nil,
+			"resolved":     false,
+			"severity":     "information",
+			"time_noticed": noticed,
+		},
+	)
+}
+
+// memberID returns the identifier of a group or role member, given by name or identifier.
+// The caller must hold the lock.
+func (s *Server) memberID(member map[string]interface{}) interface{} {
+	if id, ok := member["id"]; ok {
+		return id
+	}
+	name := fmt.Sprint(member["name"])
+	for key, objects := range s.store {
+		if !strings.HasPrefix(key, "auth/users|") && !strings.HasPrefix(key, "auth/groups|") {
+			continue
+		}
+		for _, object := range objects {
+			if object["name"] == name {
+				if member["type"] == "group" {
+					return personaOf(object, "gid")
+				}
+				return personaOf(object, "uid")
+			}
+		}
+	}
+	return "UID:" + name
+}
+
+// persona returns an identity as PAPI reports it, e.g. {"id": "UID:2000", "name": "user", "type": "user"}.
+func persona(id, name, kind string) map[string]interface{} {
+	return map[string]interface{}{"id": id, "name": name, "type": kind}
+}
+
+// personaID returns the identifier of an identity given as a number, a prefixed string or a persona object.
+func personaID(prefix string, value interface{}, fallback interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		value = fallback
+	case map[string]interface{}:
+		if id, ok := v["id"]; ok && id != nil {
+			return fmt.Sprint(id)
+		}
+		value = fallback
+	case json.Number:
+		value = v.String()
+	}
+	id := fmt.Sprint(value)
+	if strings.HasPrefix(id, prefix+":") {
+		return id
+	}
+	return prefix + ":" + id
+}
+
+func personaOf(object map[string]interface{}, field string) interface{} {
+	if p, ok := object[field].(map[string]interface{}); ok {
+		return p["id"]
+	}
+	return nil
+}
+
+// primaryGroup returns the primary group of a user creation request.
+func primaryGroup(object map[string]interface{}) interface{} {
+	if group, ok := object["primary_group"].(map[string]interface{}); ok {
+		if id, ok := group["id"]; ok {
+			return id
+		}
+	}
+	return nil
+}
+
+// mapping returns an NFS user mapping to nobody.
+func mapping(enabled bool) map[string]interface{} {
+	return map[string]interface{}{
+		"enabled":          enabled,
+		"primary_group":    map[string]interface{}{"id": nil},
+		"secondary_groups": []interface{}{},
+		"user":             map[string]interface{}{"id": "USER:nobody"},
+	}
+}
+
+// everyoneRead returns the default SMB share permission, read access for Everyone.
+func everyoneRead() map[string]interface{} {
+	return map[string]interface{}{
+		"permission":      "read",
+		"permission_type": "allow",
+		"trustee":         persona("SID:S-1-1-0", "Everyone", "wellknown"),
+	}
+}
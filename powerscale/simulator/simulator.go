@@ -0,0 +1,295 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator implements a stateful fake of the PowerScale Platform API (PAPI),
+// so that the acceptance tests can run without a cluster.
+//
+// Objects are kept in memory per collection and per access zone. Requests are routed
+// regardless of the API version in their path, so /platform/1/... and /platform/12/...
+// address the same collection.
+package simulator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// DefaultUsername and DefaultPassword are the credentials accepted by a simulator created with New.
+	DefaultUsername = "admin"
+	DefaultPassword = "password"
+	// DefaultRelease is the OneFS release reported by the simulated cluster.
+	DefaultRelease = "9.5.0.0"
+
+	sessionPath = "/session/1/session"
+)
+
+// Server is a simulated PowerScale cluster serving PAPI over HTTPS.
+type Server struct {
+	*httptest.Server
+
+	username string
+	password string
+	guid     string
+
+	mu          sync.Mutex
+	collections []*collection
+	singletons  map[string]*singleton
+	store       map[string][]map[string]interface{}
+	sessions    map[string]string
+	sequence    int64
+	unhandled   map[string]int
+}
+
+// New starts a simulator accepting the default credentials, seeded with the default objects of a cluster.
+func New() *Server {
+	return NewWithCredentials(DefaultUsername, DefaultPassword)
+}
+
+// NewWithCredentials starts a simulator accepting the given credentials.
+func NewWithCredentials(username, password string) *Server {
+	s := &Server{
+		username:   username,
+		password:   password,
+		guid:       randomHex(16),
+		singletons: map[string]*singleton{},
+		store:      map[string][]map[string]interface{}{},
+		sessions:   map[string]string{},
+		sequence:   1000,
+		unhandled:  map[string]int{},
+	}
+	registerCatalog(s)
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Endpoint returns the endpoint to configure in the provider.
+func (s *Server) Endpoint() string {
+	return s.URL
+}
+
+// Unhandled returns the requests, as "METHOD path", that did not match any simulated API.
+// Tests use it to find out which APIs the simulator is missing.
+func (s *Server) Unhandled() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	requests := make([]string, 0, len(s.unhandled))
+	for request := range s.unhandled {
+		requests = append(requests, request)
+	}
+	sort.Strings(requests)
+	return requests
+}
+
+// Seed adds objects to the collection at the given path, e.g. "protocols/smb/shares", in the given access zone.
+// An empty zone stands for the System zone.
+func (s *Server) Seed(path, zone string, objects ...map[string]interface{}) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, rt := s.match(strings.Split(strings.Trim(path, "/"), "/"))
+	if c == nil || rt.item {
+		return fmt.Errorf("no simulated collection at %s", path)
+	}
+	for _, object := range objects {
+		if _, err := s.create(c, rt.parents, zone, object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == sessionPath {
+		s.serveSession(w, r)
+		return
+	}
+	if !s.authenticated(r) {
+		writeError(w, http.StatusUnauthorized, "AEC_UNAUTHORIZED", "Authorization required")
+		return
+	}
+
+	segments, err := splitPath(r.URL.EscapedPath())
+	if err != nil || len(segments) < 3 || segments[0] != "platform" {
+		s.notHandled(w, r)
+		return
+	}
+	// the API version is ignored, every version of a collection shares the same objects
+	segments = segments[2:]
+
+	if len(segments) == 2 && segments[0] == "cluster" && segments[1] == "config" && r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, s.clusterConfig())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if settings, ok := s.singletons[strings.Join(segments, "/")]; ok {
+		s.serveSingleton(w, r, settings)
+		return
+	}
+	c, params := s.match(segments)
+	if c == nil {
+		s.notHandled(w, r)
+		return
+	}
+	s.serveCollection(w, r, c, params)
+}
+
+// serveSession implements the session API used by session based authentication.
+func (s *Server) serveSession(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPost:
+		var credentials struct {
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
+			writeError(w, http.StatusBadRequest, "AEC_BAD_REQUEST", err.Error())
+			return
+		}
+		if credentials.Username != s.username || credentials.Password != s.password {
+			writeError(w, http.StatusUnauthorized, "AEC_UNAUTHORIZED", "Username or password is incorrect.")
+			return
+		}
+		id, csrf := randomHex(16), randomHex(16)
+		s.sessions[id] = csrf
+		http.SetCookie(w, &http.Cookie{Name: "isisessid", Value: id, Path: "/", Secure: true, HttpOnly: true})
+		http.SetCookie(w, &http.Cookie{Name: "isicsrf", Value: csrf, Path: "/", Secure: true})
+		writeJSON(w, http.StatusCreated, map[string]interface{}{
+			"services":         []string{"platform", "namespace"},
+			"timeout_absolute": 14400,
+			"timeout_inactive": 900,
+			"username":         credentials.Username,
+		})
+	case http.MethodGet:
+		if !s.validSession(r) {
+			writeError(w, http.StatusUnauthorized, "AEC_UNAUTHORIZED", "Authorization required")
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"username": s.username, "services": []string{"platform", "namespace"}})
+	case http.MethodDelete:
+		if cookie, err := r.Cookie("isisessid"); err == nil {
+			delete(s.sessions, cookie.Value)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "AEC_BAD_REQUEST", "Method not allowed")
+	}
+}
+
+// authenticated accepts requests with valid basic credentials or a valid session cookie and CSRF token.
+func (s *Server) authenticated(r *http.Request) bool {
+	if username, password, ok := r.BasicAuth(); ok {
+		return username == s.username && password == s.password
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.validSession(r)
+}
+
+func (s *Server) validSession(r *http.Request) bool {
+	cookie, err := r.Cookie("isisessid")
+	if err != nil {
+		return false
+	}
+	csrf, ok := s.sessions[cookie.Value]
+	return ok && r.Header.Get("X-CSRF-Token") == csrf
+}
+
+func (s *Server) clusterConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"description":   "PowerScale simulator",
+		"guid":          s.guid,
+		"has_quorum":    true,
+		"is_compliance": false,
+		"is_virtual":    true,
+		"is_vonefs":     false,
+		"join_mode":     "Manual",
+		"local_devid":   1,
+		"local_lnn":     1,
+		"local_serial":  "SIMULATOR-1",
+		"name":          "simulator",
+		"onefs_version": map[string]interface{}{
+			"build":     "B_9_5_0_000(RELEASE)",
+			"copyright": "Copyright (c) 2001-2024 Dell Inc. All Rights Reserved.",
+			"reldate":   1700000000,
+			"release":   DefaultRelease,
+			"revision":  "0",
+			"type":      "Isilon OneFS",
+			"version":   "Isilon OneFS " + DefaultRelease,
+		},
+		"timezone": map[string]interface{}{
+			"abbreviation": "UTC",
+			"custom":       "",
+			"name":         "Coordinated Universal Time",
+			"path":         "UTC",
+		},
+		"upgrade_type": nil,
+	}
+}
+
+func (s *Server) notHandled(w http.ResponseWriter, r *http.Request) {
+	s.unhandled[fmt.Sprintf("%s %s", r.Method, r.URL.Path)]++
+	writeError(w, http.StatusNotFound, "AEC_NOT_FOUND", fmt.Sprintf("Path %s is not simulated", r.URL.Path))
+}
+
+// splitPath splits an escaped path in unescaped segments, so that identifiers may contain slashes, e.g. NFS aliases.
+func splitPath(path string) ([]string, error) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, err
+		}
+		segments[i] = unescaped
+	}
+	return segments, nil
+}
+
+func (s *Server) nextID() int64 {
+	s.sequence++
+	return s.sequence
+}
+
+// writeError writes an error in the PAPI error format.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]interface{}{{"code": code, "message": message}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func randomHex(size int) string {
+	buf := make([]byte, size)
+	_, _ = rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
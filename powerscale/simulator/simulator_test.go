@@ -0,0 +1,293 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// call sends a request with basic authentication and decodes the JSON response, if any.
+func call(t *testing.T, s *Server, method, path, body string) (int, map[string]interface{}) {
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+	assert.Nil(t, err)
+	req.SetBasicAuth(DefaultUsername, DefaultPassword)
+	resp, err := s.Client().Do(req)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	decoded := map[string]interface{}{}
+	_ = json.NewDecoder(resp.Body).Decode(&decoded)
+	return resp.StatusCode, decoded
+}
+
+// first returns the first object of a PAPI response.
+func first(t *testing.T, body map[string]interface{}, key string) map[string]interface{} {
+	objects, ok := body[key].([]interface{})
+	if !ok || len(objects) == 0 {
+		t.Fatalf("no %s in response %v", key, body)
+	}
+	object, ok := objects[0].(map[string]interface{})
+	if !ok {
+		t.Fatalf("invalid %s in response %v", key, body)
+	}
+	return object
+}
+
+// field returns a nested object.
+func field(t *testing.T, object map[string]interface{}, key string) map[string]interface{} {
+	nested, ok := object[key].(map[string]interface{})
+	if !ok {
+		t.Fatalf("no %s in %v", key, object)
+	}
+	return nested
+}
+
+func TestSimulatorSession(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	resp, err := s.Client().Post(s.URL+sessionPath, "application/json", strings.NewReader(`{"username":"admin","password":"wrong"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, err = s.Client().Post(s.URL+sessionPath, "application/json", strings.NewReader(`{"username":"admin","password":"password"}`))
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	req, _ := http.NewRequest(http.MethodGet, s.URL+"/platform/3/cluster/config", nil)
+	for _, cookie := range resp.Cookies() {
+		if cookie.Name == "isicsrf" {
+			req.Header.Set("X-CSRF-Token", cookie.Value)
+		} else {
+			req.AddCookie(cookie)
+		}
+	}
+	resp, err = s.Client().Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// without the CSRF token the session is rejected
+	req.Header.Del("X-CSRF-Token")
+	resp, err = s.Client().Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestSimulatorCollection(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := call(t, s, http.MethodPost, "/platform/3/zones", `{"name":"tfacc","path":"/ifs/tfacc"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "tfacc", body["id"])
+
+	// every API version addresses the same objects
+	status, body = call(t, s, http.MethodGet, "/platform/1/zones/tfacc", "")
+	assert.Equal(t, http.StatusOK, status)
+	zone := first(t, body, "zones")
+	assert.Equal(t, "/ifs/tfacc", zone["path"])
+	assert.Equal(t, "groupnet0", zone["groupnet"])
+
+	status, _ = call(t, s, http.MethodPost, "/platform/3/zones", `{"name":"tfacc","path":"/ifs/tfacc"}`)
+	assert.Equal(t, http.StatusConflict, status)
+
+	status, _ = call(t, s, http.MethodPut, "/platform/3/zones/tfacc", `{"name":"renamed"}`)
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = call(t, s, http.MethodGet, "/platform/3/zones/tfacc", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	status, body = call(t, s, http.MethodGet, "/platform/3/zones", "")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, float64(2), body["total"])
+
+	status, _ = call(t, s, http.MethodDelete, "/platform/3/zones/renamed", "")
+	assert.Equal(t, http.StatusNoContent, status)
+	status, _ = call(t, s, http.MethodGet, "/platform/3/zones/renamed", "")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestSimulatorZonedCollection(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := call(t, s, http.MethodPost, "/platform/2/protocols/nfs/exports?zone=tfacc", `{"paths":["/ifs/tfacc"]}`)
+	assert.Equal(t, http.StatusCreated, status)
+	id, ok := body["id"].(float64)
+	assert.True(t, ok)
+
+	path := fmt.Sprintf("/platform/2/protocols/nfs/exports/%.0f", id)
+	status, _ = call(t, s, http.MethodGet, path, "")
+	assert.Equal(t, http.StatusNotFound, status)
+	status, body = call(t, s, http.MethodGet, path+"?zone=tfacc", "")
+	assert.Equal(t, http.StatusOK, status)
+	export := first(t, body, "exports")
+	assert.Equal(t, "tfacc", export["zone"])
+
+	// identifiers containing slashes are escaped in the path
+	status, _ = call(t, s, http.MethodPost, "/platform/2/protocols/nfs/aliases", `{"name":"/tfacc","path":"/ifs/tfacc"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	status, _ = call(t, s, http.MethodGet, "/platform/2/protocols/nfs/aliases/"+url.PathEscape("/tfacc"), "")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestSimulatorSettings(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, _ := call(t, s, http.MethodPut, "/platform/7/protocols/smb/settings/share?zone=tfacc", `{"oplocks":false}`)
+	assert.Equal(t, http.StatusNoContent, status)
+	_, body := call(t, s, http.MethodGet, "/platform/7/protocols/smb/settings/share?zone=tfacc", "")
+	assert.Equal(t, false, field(t, body, "settings")["oplocks"])
+	_, body = call(t, s, http.MethodGet, "/platform/7/protocols/smb/settings/share", "")
+	assert.Equal(t, true, field(t, body, "settings")["oplocks"])
+}
+
+func TestSimulatorAuth(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := call(t, s, http.MethodPost, "/platform/1/auth/users", `{"name":"tfacc","uid":3000,"password":"secret"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "SID:S-1-5-21-1000-1000-1000-3000", body["id"])
+	_, body = call(t, s, http.MethodGet, "/platform/1/auth/users/tfacc", "")
+	user := first(t, body, "users")
+	assert.Equal(t, "UID:3000", field(t, user, "uid")["id"])
+	assert.Nil(t, user["password"])
+
+	status, _ = call(t, s, http.MethodPost, "/platform/7/auth/roles/AuditAdmin/members", `{"name":"tfacc","type":"user"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	_, body = call(t, s, http.MethodGet, "/platform/7/auth/roles/AuditAdmin", "")
+	member := first(t, first(t, body, "roles"), "members")
+	assert.Equal(t, "UID:3000", member["id"])
+}
+
+func TestSimulatorJobs(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := call(t, s, http.MethodPost, "/platform/10/job/jobs", `{"type":"TreeDelete","paths":["/ifs/tfacc"]}`)
+	assert.Equal(t, http.StatusCreated, status)
+	id := fmt.Sprint(body["id"])
+	_, body = call(t, s, http.MethodGet, "/platform/10/job/jobs/"+id, "")
+	assert.Equal(t, "succeeded", first(t, body, "jobs")["state"])
+	_, body = call(t, s, http.MethodGet, "/platform/1/job/reports?job_id="+id, "")
+	assert.Equal(t, "TreeDelete", first(t, body, "reports")["job_type"])
+
+	_, body = call(t, s, http.MethodGet, "/platform/1/job/policies/LOW", "")
+	assert.Equal(t, true, first(t, body, "policies")["system"])
+	_, body = call(t, s, http.MethodGet, "/platform/1/job/types/SmartPools", "")
+	assert.Equal(t, "restripe", first(t, body, "types")["exclusion_set"])
+}
+
+func TestSimulatorWorm(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := call(t, s, http.MethodPost, "/platform/1/worm/domains", `{"path":"/ifs/tfacc_worm","default_retention":{"years":1}}`)
+	assert.Equal(t, http.StatusCreated, status)
+	id := fmt.Sprint(body["id"])
+	_, body = call(t, s, http.MethodGet, "/platform/1/worm/domains/"+id, "")
+	domain := first(t, body, "domains")
+	assert.Equal(t, "enterprise", domain["type"])
+	assert.Equal(t, "off", domain["privileged_delete"])
+
+	_, body = call(t, s, http.MethodGet, "/platform/1/worm/settings", "")
+	assert.Nil(t, body["settings"].(map[string]interface{})["cdate"])
+}
+
+func TestSimulatorAudit(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, _ := call(t, s, http.MethodPut, "/platform/12/audit/settings/global", `{"protocol_auditing_enabled":true,"audited_zones":["System"]}`)
+	assert.Equal(t, http.StatusNoContent, status)
+	_, body := call(t, s, http.MethodGet, "/platform/12/audit/settings/global", "")
+	assert.Equal(t, true, body["settings"].(map[string]interface{})["protocol_auditing_enabled"])
+
+	_, body = call(t, s, http.MethodGet, "/platform/1/audit/settings?zone=System", "")
+	assert.Equal(t, false, body["settings"].(map[string]interface{})["syslog_forwarding_enabled"])
+	_, body = call(t, s, http.MethodGet, "/platform/1/audit/topics/protocol", "")
+	assert.Equal(t, float64(2048), first(t, body, "topics")["max_cached_messages"])
+}
+
+func TestSimulatorEvents(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := call(t, s, http.MethodPost, "/platform/16/event/channels", `{"name":"tfacc_channel","type":"smtp","parameters":{"address":["admin@example.com"]}}`)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.NotNil(t, body["id"])
+	_, body = call(t, s, http.MethodGet, "/platform/16/event/channels/tfacc_channel", "")
+	assert.Equal(t, true, first(t, body, "channels")["enabled"])
+
+	status, body = call(t, s, http.MethodPost, "/platform/3/event/alert-conditions", `{"name":"tfacc_condition","condition":"NEW","channels":["tfacc_channel"]}`)
+	assert.Equal(t, http.StatusCreated, status)
+	assert.Equal(t, "tfacc_condition", body["id"])
+	_, body = call(t, s, http.MethodGet, "/platform/3/event/alert-conditions/tfacc_condition", "")
+	assert.Equal(t, float64(0), first(t, body, "alert-conditions")["interval"])
+
+	_, body = call(t, s, http.MethodGet, "/platform/3/event/eventgroup-occurrences", "")
+	assert.Len(t, body["eventgroups"], 2)
+}
+
+func TestSimulatorQuotaNotifications(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	_, body := call(t, s, http.MethodPost, "/platform/1/quota/quotas", `{"path":"/ifs/tfacc_quota","type":"directory"}`)
+	quotaID := body["id"].(string)
+	status, body := call(t, s, http.MethodPost, "/platform/1/quota/quotas/"+quotaID+"/notifications", `{"threshold":"hard","condition":"exceeded"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	notificationID := body["id"].(string)
+	_, body = call(t, s, http.MethodGet, "/platform/1/quota/quotas/"+quotaID+"/notifications/"+notificationID, "")
+	assert.Equal(t, true, first(t, body, "notifications")["action_alert"])
+	_, body = call(t, s, http.MethodGet, "/platform/1/quota/quotas/"+quotaID, "")
+	assert.Equal(t, "custom", first(t, body, "quotas")["notifications"])
+
+	status, _ = call(t, s, http.MethodDelete, "/platform/1/quota/quotas/"+quotaID+"/notifications/"+notificationID, "")
+	assert.Equal(t, http.StatusNoContent, status)
+	_, body = call(t, s, http.MethodGet, "/platform/1/quota/quotas/"+quotaID, "")
+	assert.Equal(t, "default", first(t, body, "quotas")["notifications"])
+
+	status, _ = call(t, s, http.MethodPost, "/platform/1/quota/settings/mappings", `{"domain":"EXAMPLE.COM","mapping":"example.com"}`)
+	assert.Equal(t, http.StatusCreated, status)
+	_, body = call(t, s, http.MethodGet, "/platform/1/quota/settings/reports", "")
+	assert.Equal(t, float64(5), body["settings"].(map[string]interface{})["manual_retain"])
+
+	status, _ = call(t, s, http.MethodPost, "/platform/1/quota/reports", `{}`)
+	assert.Equal(t, http.StatusCreated, status)
+	_, body = call(t, s, http.MethodGet, "/platform/1/quota/reports", "")
+	assert.Equal(t, "manual", first(t, body, "reports")["generated"])
+}
+
+func TestSimulatorUnhandled(t *testing.T) {
+	s := New()
+	defer s.Close()
+
+	status, body := call(t, s, http.MethodGet, "/platform/1/unknown/api", "")
+	assert.Equal(t, http.StatusNotFound, status)
+	assert.NotNil(t, body["errors"])
+	assert.Equal(t, []string{"GET /platform/1/unknown/api"}, s.Unhandled())
+
+	assert.NotNil(t, s.Seed("unknown", ""))
+}
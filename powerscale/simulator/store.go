@@ -0,0 +1,321 @@
+/*
+Copyright (c) 2024 Dell Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const systemZone = "System"
+
+// collection describes a PAPI collection, e.g. protocols/smb/shares, served with the usual PAPI semantics:
+// GET lists or reads, POST creates, PUT updates the given fields and DELETE removes.
+type collection struct {
+	// pattern is the versionless path of the collection, "*" matches the identifier of a parent object.
+	pattern []string
+	// key holds the objects in responses, e.g. "shares".
+	key string
+	// idField is the field identifying an object in its path, lookup lists other fields accepted in the path.
+	idField string
+	lookup  []string
+	// nameIsID renames the object when its name is updated, as for SMB shares.
+	nameIsID bool
+	// zoned collections hold separate objects per access zone, selected with the zone query parameter.
+	zoned bool
+	// newID generates the identifier of a created object, the name is used when not set.
+	newID func(s *Server, object map[string]interface{}) interface{}
+	// defaults fills the fields computed by the cluster on creation.
+	defaults func(s *Server, zone string, object map[string]interface{})
+	// created returns the body of the creation response, {"id": ...} when not set.
+	created func(object map[string]interface{}) interface{}
+	// changed is called after an object is created or deleted, with the objects left in the collection.
+	changed func(s *Server, parents []string, zone string, objects []map[string]interface{})
+}
+
+// singleton describes a settings object, read with GET and updated with PUT.
+type singleton struct {
+	defaults map[string]interface{}
+	zoned    bool
+	values   map[string]map[string]interface{}
+}
+
+// route is a request matched to a collection.
+type route struct {
+	parents []string
+	id      string
+	item    bool
+}
+
+// register adds a collection at the given versionless path.
+func (s *Server) register(path string, c *collection) {
+	c.pattern = strings.Split(path, "/")
+	if len(c.idField) == 0 {
+		c.idField = "id"
+	}
+	s.collections = append(s.collections, c)
+}
+
+// registerSettings adds a settings object at the given versionless path.
+func (s *Server) registerSettings(path string, zoned bool, defaults map[string]interface{}) {
+	s.singletons[path] = &singleton{defaults: defaults, zoned: zoned, values: map[string]map[string]interface{}{}}
+}
+
+// match finds the collection of a versionless path.
+func (s *Server) match(segments []string) (*collection, route) {
+	for _, c := range s.collections {
+		if len(segments) != len(c.pattern) && len(segments) != len(c.pattern)+1 {
+			continue
+		}
+		r := route{item: len(segments) > len(c.pattern)}
+		matched := true
+		for i, part := range c.pattern {
+			if part == "*" {
+				r.parents = append(r.parents, segments[i])
+			} else if part != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if r.item {
+			r.id = segments[len(segments)-1]
+		}
+		return c, r
+	}
+	return nil, route{}
+}
+
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, c *collection, rt route) {
+	zone := zoneOf(c.zoned, r.URL.Query())
+	key := storeKey(c, rt.parents, zone)
+
+	if !rt.item {
+		switch r.Method {
+		case http.MethodGet:
+			objects := filter(s.store[key], r.URL.Query())
+			writeJSON(w, http.StatusOK, map[string]interface{}{c.key: objects, "total": len(objects), "resume": nil})
+		case http.MethodPost:
+			object := map[string]interface{}{}
+			if err := decode(r.Body, &object); err != nil {
+				writeError(w, http.StatusBadRequest, "AEC_BAD_REQUEST", err.Error())
+				return
+			}
+			created, err := s.create(c, rt.parents, zone, object)
+			if err != nil {
+				writeError(w, http.StatusConflict, "AEC_EXISTS", err.Error())
+				return
+			}
+			if c.created != nil {
+				writeJSON(w, http.StatusCreated, c.created(created))
+				return
+			}
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"id": created[c.idField]})
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "AEC_BAD_REQUEST", "Method not allowed")
+		}
+		return
+	}
+
+	index := find(c, s.store[key], rt.id)
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "AEC_NOT_FOUND", fmt.Sprintf("%s %s not found", strings.TrimSuffix(c.key, "s"), rt.id))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{c.key: []interface{}{s.store[key][index]}})
+	case http.MethodPut:
+		update := map[string]interface{}{}
+		if err := decode(r.Body, &update); err != nil {
+			writeError(w, http.StatusBadRequest, "AEC_BAD_REQUEST", err.Error())
+			return
+		}
+		object := s.store[key][index]
+		for field, value := range update {
+			object[field] = value
+		}
+		if name, ok := update["name"]; ok && c.nameIsID {
+			object[c.idField] = name
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		s.store[key] = append(s.store[key][:index], s.store[key][index+1:]...)
+		if c.changed != nil {
+			c.changed(s, rt.parents, zone, s.store[key])
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "AEC_BAD_REQUEST", "Method not allowed")
+	}
+}
+
+func (s *Server) serveSingleton(w http.ResponseWriter, r *http.Request, settings *singleton) {
+	zone := zoneOf(settings.zoned, r.URL.Query())
+	values, ok := settings.values[zone]
+	if !ok {
+		values = clone(settings.defaults)
+		if settings.zoned {
+			values["zone"] = zone
+		}
+		settings.values[zone] = values
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"settings": values})
+	case http.MethodPut:
+		update := map[string]interface{}{}
+		if err := decode(r.Body, &update); err != nil {
+			writeError(w, http.StatusBadRequest, "AEC_BAD_REQUEST", err.Error())
+			return
+		}
+		for field, value := range update {
+			values[field] = value
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "AEC_BAD_REQUEST", "Method not allowed")
+	}
+}
+
+// create stores a new object, filling its identifier and computed fields. The caller must hold the lock.
+func (s *Server) create(c *collection, parents []string, zone string, object map[string]interface{}) (map[string]interface{}, error) {
+	if c.zoned && len(zone) == 0 {
+		zone = systemZone
+	}
+	object = clone(object)
+	if _, ok := object[c.idField]; !ok {
+		if c.newID != nil {
+			object[c.idField] = c.newID(s, object)
+		} else {
+			object[c.idField] = object["name"]
+		}
+	}
+	if c.zoned {
+		if _, ok := object["zone"]; !ok {
+			object["zone"] = zone
+		}
+	}
+	if c.defaults != nil {
+		c.defaults(s, zone, object)
+	}
+	key := storeKey(c, parents, zone)
+	if find(c, s.store[key], fmt.Sprint(object[c.idField])) >= 0 {
+		return nil, fmt.Errorf("%s %v already exists", strings.TrimSuffix(c.key, "s"), object[c.idField])
+	}
+	s.store[key] = append(s.store[key], object)
+	if c.changed != nil {
+		c.changed(s, parents, zone, s.store[key])
+	}
+	return object, nil
+}
+
+// find returns the index of the object with the given identifier, or -1.
+func find(c *collection, objects []map[string]interface{}, id string) int {
+	for i, object := range objects {
+		if fmt.Sprint(object[c.idField]) == id {
+			return i
+		}
+		for _, field := range c.lookup {
+			if value, ok := object[field]; ok && fmt.Sprint(value) == id {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// filter applies the query parameters naming a field of the objects as equality filters.
+func filter(objects []map[string]interface{}, query url.Values) []map[string]interface{} {
+	filtered := make([]map[string]interface{}, 0, len(objects))
+	for _, object := range objects {
+		matched := true
+		for param, values := range query {
+			if param == "zone" {
+				continue
+			}
+			if value, ok := object[param]; ok && !isComposite(value) && fmt.Sprint(value) != values[0] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			filtered = append(filtered, object)
+		}
+	}
+	return filtered
+}
+
+func isComposite(value interface{}) bool {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+func zoneOf(zoned bool, query url.Values) string {
+	if !zoned {
+		return ""
+	}
+	if zone := query.Get("zone"); len(zone) > 0 {
+		return zone
+	}
+	return systemZone
+}
+
+func storeKey(c *collection, parents []string, zone string) string {
+	return storeKeyOf(strings.Join(c.pattern, "/"), parents, zone)
+}
+
+// storeKeyOf returns the key of the objects of a collection path, for the given parents and access zone.
+func storeKeyOf(path string, parents []string, zone string) string {
+	return path + "|" + strings.Join(parents, "/") + "|" + zone
+}
+
+// decode reads a JSON object, keeping numbers as written so that large identifiers compare as sent.
+func decode(body io.Reader, object *map[string]interface{}) error {
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+	return decoder.Decode(object)
+}
+
+// clone deep copies a JSON object.
+func clone(object map[string]interface{}) map[string]interface{} {
+	copied := map[string]interface{}{}
+	if raw, err := json.Marshal(object); err == nil {
+		_ = decode(bytes.NewReader(raw), &copied)
+	}
+	return copied
+}
+
+// setDefaults sets the fields of the object that are not set yet.
+func setDefaults(object map[string]interface{}, defaults map[string]interface{}) {
+	for field, value := range defaults {
+		if _, ok := object[field]; !ok {
+			object[field] = value
+		}
+	}
+}